@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/joho/godotenv"
@@ -13,8 +17,13 @@ import (
 	"github.com/tikfack/server/internal/di"
 	auth "github.com/tikfack/server/internal/middleware/auth"
 	"github.com/tikfack/server/internal/middleware/logger"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
+// shutdownTimeout は停止シグナルを受けてから処理中のリクエストの完了を待つ上限。
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	// 環境変数の読み込み
 	if err := godotenv.Load(); err != nil {
@@ -42,8 +51,11 @@ func main() {
 
 	gocloakClient := gocloak.NewClient(keycloakBaseURL)
 
+	// SIGINT / SIGTERM でバックグラウンド処理を止め、処理中のリクエストを完了させてから終了する
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	//ユーザーIDを取得するためにOIDCを使用
-	ctx := context.Background()
 	verifier, err := auth.NewVerifier(ctx, issuerURL, clientID)
 	if err != nil {
 		slog.Error("OIDC verifier init failed", "error", err)
@@ -88,14 +100,68 @@ func main() {
 		os.Exit(1)
 	}
 
+	playlistHandler, err := di.InitializePlaylistHandler([]connect.HandlerOption{
+		connect.WithInterceptors(
+			introspectionInterceptor,
+			logger.LoggingInterceptor(),
+		),
+	})
+	if err != nil {
+		slog.Error("failed to initialize playlist handler", "error", err)
+		os.Exit(1)
+	}
+
+	watchHistoryHandler, err := di.InitializeWatchHistoryHandler([]connect.HandlerOption{
+		connect.WithInterceptors(
+			introspectionInterceptor,
+			logger.LoggingInterceptor(),
+		),
+	})
+	if err != nil {
+		slog.Error("failed to initialize watch history handler", "error", err)
+		os.Exit(1)
+	}
+
+	actressHandler, err := di.InitializeActressHandler([]connect.HandlerOption{
+		connect.WithInterceptors(
+			introspectionInterceptor,
+			logger.LoggingInterceptor(),
+		),
+	})
+	if err != nil {
+		slog.Error("failed to initialize actress handler", "error", err)
+		os.Exit(1)
+	}
+
+	taxonomyHandler, err := di.InitializeTaxonomyHandler([]connect.HandlerOption{
+		connect.WithInterceptors(
+			introspectionInterceptor,
+			logger.LoggingInterceptor(),
+		),
+	})
+	if err != nil {
+		slog.Error("failed to initialize taxonomy handler", "error", err)
+		os.Exit(1)
+	}
+
 	mux := http.NewServeMux()
 	pattern, handler := videoHandler.GetHandler()
 	mux.Handle(pattern, handler)
 	fpattern, fhandler := favoriteHandler.GetHandler()
 	mux.Handle(fpattern, fhandler)
+	ppattern, phandler := playlistHandler.GetHandler()
+	mux.Handle(ppattern, phandler)
+	wpattern, whandler := watchHistoryHandler.GetHandler()
+	mux.Handle(wpattern, whandler)
+	apattern, ahandler := actressHandler.GetHandler()
+	mux.Handle(apattern, ahandler)
+	tpattern, thandler := taxonomyHandler.GetHandler()
+	mux.Handle(tpattern, thandler)
 
+	// トークンがあれば検証して記録者を決める（匿名の場合は device_id で記録する）
 	eventHandler, err := di.InitializeEventLogHandler([]connect.HandlerOption{
 		connect.WithInterceptors(
+			introspectionInterceptor,
 			logger.LoggingInterceptor(),
 		),
 	})
@@ -106,15 +172,68 @@ func main() {
 	epattern, ehandler := eventHandler.GetHandler()
 	mux.Handle(epattern, ehandler)
 
+	// Outbox に保存したイベントをバックグラウンドで Kafka へ中継する
+	outboxRelay, err := di.InitializeOutboxRelay()
+	if err != nil {
+		slog.Error("failed to initialize outbox relay", "error", err)
+		os.Exit(1)
+	}
+	relayDone := make(chan struct{})
+	if outboxRelay != nil {
+		go func() {
+			defer close(relayDone)
+			if err := outboxRelay.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("outbox relay stopped", "error", err)
+			}
+		}()
+	} else {
+		close(relayDone)
+	}
+
+	// DMM API のレートリミッターなどの統計を定期的にログへ記録する
+	statsReporter, err := di.InitializeStatsReporter()
+	if err != nil {
+		slog.Error("failed to initialize stats reporter", "error", err)
+		os.Exit(1)
+	}
+	statsDone := make(chan struct{})
+	if statsReporter != nil {
+		go func() {
+			defer close(statsDone)
+			_ = statsReporter.Run(ctx)
+		}()
+	} else {
+		close(statsDone)
+	}
+
 	// ミドルウェアチェイン
 	loggedHandler := loggingMiddleware(mux)
 	handlerWithCORS := cors.AllowAll().Handler(loggedHandler)
 
+	// 双方向ストリーミング（RecordStreamWithAcks）は HTTP/2 が必要なため、TLS なしの HTTP/2 (h2c) も受け付ける
+	server := &http.Server{Addr: ":" + port, Handler: h2c.NewHandler(handlerWithCORS, &http2.Server{})}
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("サーバーの停止に失敗しました", "error", err)
+		}
+	}()
+
 	slog.Info("サーバーを起動しています", "port", port)
-	if err := http.ListenAndServe(":"+port, handlerWithCORS); err != nil {
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		slog.Error("サーバー起動に失敗しました", "error", err)
 		os.Exit(1)
 	}
+	// ListenAndServe は Shutdown の開始時点で戻るため、処理中のリクエストの完了を待つ。
+	// 未送信の Outbox 行は次回起動時（または他のインスタンス）が中継する
+	<-shutdownDone
+	<-relayDone
+	<-statsDone
+	slog.Info("サーバーを停止しました")
 }
 
 // setupLogger configures the global slog logger based on the environment
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/tikfack/server/internal/di"
+)
+
+// worker は Kafka の event-logs トピックを購読し、視聴履歴などの集計を更新する。
+func main() {
+	// 環境変数の読み込み
+	if err := godotenv.Load(); err != nil {
+		// .envファイルがなくてもエラーではない（本番環境では環境変数で設定する場合がある）
+		slog.Info("環境変数を.envから読み込めませんでした", "error", err)
+	}
+
+	setupLogger(os.Getenv("LOG_LEVEL"))
+
+	consumer, err := di.InitializeEventConsumer()
+	if err != nil {
+		slog.Error("failed to initialize event consumer", "error", err)
+		os.Exit(1)
+	}
+
+	// SIGINT / SIGTERM で受信を止め、処理中のメッセージを完了させてから終了する
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	slog.Info("ワーカーを起動しています")
+	if err := consumer.Run(ctx); err != nil {
+		slog.Error("ワーカーが異常終了しました", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("ワーカーを停止しました")
+}
+
+// setupLogger configures the global slog logger based on the environment
+func setupLogger(level string) {
+	var logLevel slog.Level
+	switch level {
+	case "debug":
+		logLevel = slog.LevelDebug
+	case "warn":
+		logLevel = slog.LevelWarn
+	case "error":
+		logLevel = slog.LevelError
+	default:
+		logLevel = slog.LevelInfo // デフォルトはInfo
+	}
+
+	// JSONハンドラーを使用
+	opts := &slog.HandlerOptions{
+		Level: logLevel,
+	}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, opts)))
+}
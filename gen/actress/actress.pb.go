@@ -0,0 +1,773 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: actress/actress.proto
+
+package actress
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 女優の画像URL
+type ActressImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Small         string                 `protobuf:"bytes,1,opt,name=small,proto3" json:"small,omitempty"`
+	Large         string                 `protobuf:"bytes,2,opt,name=large,proto3" json:"large,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActressImage) Reset() {
+	*x = ActressImage{}
+	mi := &file_actress_actress_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActressImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActressImage) ProtoMessage() {}
+
+func (x *ActressImage) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActressImage.ProtoReflect.Descriptor instead.
+func (*ActressImage) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActressImage) GetSmall() string {
+	if x != nil {
+		return x.Small
+	}
+	return ""
+}
+
+func (x *ActressImage) GetLarge() string {
+	if x != nil {
+		return x.Large
+	}
+	return ""
+}
+
+// 女優の出演作品一覧ページのURL
+type ActressListURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digital       string                 `protobuf:"bytes,1,opt,name=digital,proto3" json:"digital,omitempty"`
+	Monthly       string                 `protobuf:"bytes,2,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	Mono          string                 `protobuf:"bytes,3,opt,name=mono,proto3" json:"mono,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActressListURL) Reset() {
+	*x = ActressListURL{}
+	mi := &file_actress_actress_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActressListURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActressListURL) ProtoMessage() {}
+
+func (x *ActressListURL) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActressListURL.ProtoReflect.Descriptor instead.
+func (*ActressListURL) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActressListURL) GetDigital() string {
+	if x != nil {
+		return x.Digital
+	}
+	return ""
+}
+
+func (x *ActressListURL) GetMonthly() string {
+	if x != nil {
+		return x.Monthly
+	}
+	return ""
+}
+
+func (x *ActressListURL) GetMono() string {
+	if x != nil {
+		return x.Mono
+	}
+	return ""
+}
+
+// 女優のプロフィール（数値の項目は不明な場合 0、文字列の項目は空）
+type ActressProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ruby          string                 `protobuf:"bytes,3,opt,name=ruby,proto3" json:"ruby,omitempty"` // 読み仮名
+	Bust          int32                  `protobuf:"varint,4,opt,name=bust,proto3" json:"bust,omitempty"`
+	Cup           string                 `protobuf:"bytes,5,opt,name=cup,proto3" json:"cup,omitempty"`
+	Waist         int32                  `protobuf:"varint,6,opt,name=waist,proto3" json:"waist,omitempty"`
+	Hip           int32                  `protobuf:"varint,7,opt,name=hip,proto3" json:"hip,omitempty"`
+	Height        int32                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Birthday      string                 `protobuf:"bytes,9,opt,name=birthday,proto3" json:"birthday,omitempty"` // 生年月日（YYYY-MM-DD）
+	BloodType     string                 `protobuf:"bytes,10,opt,name=blood_type,json=bloodType,proto3" json:"blood_type,omitempty"`
+	Hobby         string                 `protobuf:"bytes,11,opt,name=hobby,proto3" json:"hobby,omitempty"`
+	Prefectures   string                 `protobuf:"bytes,12,opt,name=prefectures,proto3" json:"prefectures,omitempty"` // 出身地
+	ImageUrl      *ActressImage          `protobuf:"bytes,13,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ListUrl       *ActressListURL        `protobuf:"bytes,14,opt,name=list_url,json=listUrl,proto3" json:"list_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActressProfile) Reset() {
+	*x = ActressProfile{}
+	mi := &file_actress_actress_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActressProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActressProfile) ProtoMessage() {}
+
+func (x *ActressProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActressProfile.ProtoReflect.Descriptor instead.
+func (*ActressProfile) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActressProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetRuby() string {
+	if x != nil {
+		return x.Ruby
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetBust() int32 {
+	if x != nil {
+		return x.Bust
+	}
+	return 0
+}
+
+func (x *ActressProfile) GetCup() string {
+	if x != nil {
+		return x.Cup
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetWaist() int32 {
+	if x != nil {
+		return x.Waist
+	}
+	return 0
+}
+
+func (x *ActressProfile) GetHip() int32 {
+	if x != nil {
+		return x.Hip
+	}
+	return 0
+}
+
+func (x *ActressProfile) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ActressProfile) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetBloodType() string {
+	if x != nil {
+		return x.BloodType
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetHobby() string {
+	if x != nil {
+		return x.Hobby
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetPrefectures() string {
+	if x != nil {
+		return x.Prefectures
+	}
+	return ""
+}
+
+func (x *ActressProfile) GetImageUrl() *ActressImage {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return nil
+}
+
+func (x *ActressProfile) GetListUrl() *ActressListURL {
+	if x != nil {
+		return x.ListUrl
+	}
+	return nil
+}
+
+// 検索結果のメタデータ
+type SearchMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultCount   int32                  `protobuf:"varint,1,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`       // 取得件数
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`          // 全体件数
+	FirstPosition int32                  `protobuf:"varint,3,opt,name=first_position,json=firstPosition,proto3" json:"first_position,omitempty"` // 検索開始位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMetadata) Reset() {
+	*x = SearchMetadata{}
+	mi := &file_actress_actress_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMetadata) ProtoMessage() {}
+
+func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMetadata.ProtoReflect.Descriptor instead.
+func (*SearchMetadata) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchMetadata) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetFirstPosition() int32 {
+	if x != nil {
+		return x.FirstPosition
+	}
+	return 0
+}
+
+type GetActressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActressId     string                 `protobuf:"bytes,1,opt,name=actress_id,json=actressId,proto3" json:"actress_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActressRequest) Reset() {
+	*x = GetActressRequest{}
+	mi := &file_actress_actress_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActressRequest) ProtoMessage() {}
+
+func (x *GetActressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActressRequest.ProtoReflect.Descriptor instead.
+func (*GetActressRequest) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetActressRequest) GetActressId() string {
+	if x != nil {
+		return x.ActressId
+	}
+	return ""
+}
+
+type GetActressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actress       *ActressProfile        `protobuf:"bytes,1,opt,name=actress,proto3" json:"actress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActressResponse) Reset() {
+	*x = GetActressResponse{}
+	mi := &file_actress_actress_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActressResponse) ProtoMessage() {}
+
+func (x *GetActressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActressResponse.ProtoReflect.Descriptor instead.
+func (*GetActressResponse) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetActressResponse) GetActress() *ActressProfile {
+	if x != nil {
+		return x.Actress
+	}
+	return nil
+}
+
+// 女優検索用メッセージ（すべてのフィールドはoptional）
+type SearchActressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                             // 名前・読み仮名のキーワード（省略可）
+	Initial       string                 `protobuf:"bytes,2,opt,name=initial,proto3" json:"initial,omitempty"`                             // 名前の頭文字（ひらがな 1 文字、省略可）
+	GteBust       int32                  `protobuf:"varint,3,opt,name=gte_bust,json=gteBust,proto3" json:"gte_bust,omitempty"`             // バスト（この値以上、省略可）
+	LteBust       int32                  `protobuf:"varint,4,opt,name=lte_bust,json=lteBust,proto3" json:"lte_bust,omitempty"`             // バスト（この値以下、省略可）
+	GteWaist      int32                  `protobuf:"varint,5,opt,name=gte_waist,json=gteWaist,proto3" json:"gte_waist,omitempty"`          // ウエスト（この値以上、省略可）
+	LteWaist      int32                  `protobuf:"varint,6,opt,name=lte_waist,json=lteWaist,proto3" json:"lte_waist,omitempty"`          // ウエスト（この値以下、省略可）
+	GteHip        int32                  `protobuf:"varint,7,opt,name=gte_hip,json=gteHip,proto3" json:"gte_hip,omitempty"`                // ヒップ（この値以上、省略可）
+	LteHip        int32                  `protobuf:"varint,8,opt,name=lte_hip,json=lteHip,proto3" json:"lte_hip,omitempty"`                // ヒップ（この値以下、省略可）
+	GteHeight     int32                  `protobuf:"varint,9,opt,name=gte_height,json=gteHeight,proto3" json:"gte_height,omitempty"`       // 身長（この値以上、省略可）
+	LteHeight     int32                  `protobuf:"varint,10,opt,name=lte_height,json=lteHeight,proto3" json:"lte_height,omitempty"`      // 身長（この値以下、省略可）
+	GteBirthday   string                 `protobuf:"bytes,11,opt,name=gte_birthday,json=gteBirthday,proto3" json:"gte_birthday,omitempty"` // 生年月日（この日付以降、YYYY-MM-DD、省略可）
+	LteBirthday   string                 `protobuf:"bytes,12,opt,name=lte_birthday,json=lteBirthday,proto3" json:"lte_birthday,omitempty"` // 生年月日（この日付以前、YYYY-MM-DD、省略可）
+	Sort          string                 `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`                                  // ソート順（name・bust・waist・hip・height・birthday・id、先頭に - で降順、省略可）
+	Hits          int32                  `protobuf:"varint,14,opt,name=hits,proto3" json:"hits,omitempty"`                                 // 取得件数（初期値：20、最大：100、省略可）
+	Offset        int32                  `protobuf:"varint,15,opt,name=offset,proto3" json:"offset,omitempty"`                             // 検索開始位置（初期値：1、最大：50000、省略可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActressesRequest) Reset() {
+	*x = SearchActressesRequest{}
+	mi := &file_actress_actress_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActressesRequest) ProtoMessage() {}
+
+func (x *SearchActressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActressesRequest.ProtoReflect.Descriptor instead.
+func (*SearchActressesRequest) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchActressesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchActressesRequest) GetInitial() string {
+	if x != nil {
+		return x.Initial
+	}
+	return ""
+}
+
+func (x *SearchActressesRequest) GetGteBust() int32 {
+	if x != nil {
+		return x.GteBust
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetLteBust() int32 {
+	if x != nil {
+		return x.LteBust
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetGteWaist() int32 {
+	if x != nil {
+		return x.GteWaist
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetLteWaist() int32 {
+	if x != nil {
+		return x.LteWaist
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetGteHip() int32 {
+	if x != nil {
+		return x.GteHip
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetLteHip() int32 {
+	if x != nil {
+		return x.LteHip
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetGteHeight() int32 {
+	if x != nil {
+		return x.GteHeight
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetLteHeight() int32 {
+	if x != nil {
+		return x.LteHeight
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetGteBirthday() string {
+	if x != nil {
+		return x.GteBirthday
+	}
+	return ""
+}
+
+func (x *SearchActressesRequest) GetLteBirthday() string {
+	if x != nil {
+		return x.LteBirthday
+	}
+	return ""
+}
+
+func (x *SearchActressesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchActressesRequest) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *SearchActressesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchActressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actresses     []*ActressProfile      `protobuf:"bytes,1,rep,name=actresses,proto3" json:"actresses,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActressesResponse) Reset() {
+	*x = SearchActressesResponse{}
+	mi := &file_actress_actress_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActressesResponse) ProtoMessage() {}
+
+func (x *SearchActressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_actress_actress_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActressesResponse.ProtoReflect.Descriptor instead.
+func (*SearchActressesResponse) Descriptor() ([]byte, []int) {
+	return file_actress_actress_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchActressesResponse) GetActresses() []*ActressProfile {
+	if x != nil {
+		return x.Actresses
+	}
+	return nil
+}
+
+func (x *SearchActressesResponse) GetMetadata() *SearchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_actress_actress_proto protoreflect.FileDescriptor
+
+const file_actress_actress_proto_rawDesc = "" +
+	"\n" +
+	"\x15actress/actress.proto\x12\aactress\":\n" +
+	"\fActressImage\x12\x14\n" +
+	"\x05small\x18\x01 \x01(\tR\x05small\x12\x14\n" +
+	"\x05large\x18\x02 \x01(\tR\x05large\"X\n" +
+	"\x0eActressListURL\x12\x18\n" +
+	"\adigital\x18\x01 \x01(\tR\adigital\x12\x18\n" +
+	"\amonthly\x18\x02 \x01(\tR\amonthly\x12\x12\n" +
+	"\x04mono\x18\x03 \x01(\tR\x04mono\"\x89\x03\n" +
+	"\x0eActressProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04ruby\x18\x03 \x01(\tR\x04ruby\x12\x12\n" +
+	"\x04bust\x18\x04 \x01(\x05R\x04bust\x12\x10\n" +
+	"\x03cup\x18\x05 \x01(\tR\x03cup\x12\x14\n" +
+	"\x05waist\x18\x06 \x01(\x05R\x05waist\x12\x10\n" +
+	"\x03hip\x18\a \x01(\x05R\x03hip\x12\x16\n" +
+	"\x06height\x18\b \x01(\x05R\x06height\x12\x1a\n" +
+	"\bbirthday\x18\t \x01(\tR\bbirthday\x12\x1d\n" +
+	"\n" +
+	"blood_type\x18\n" +
+	" \x01(\tR\tbloodType\x12\x14\n" +
+	"\x05hobby\x18\v \x01(\tR\x05hobby\x12 \n" +
+	"\vprefectures\x18\f \x01(\tR\vprefectures\x122\n" +
+	"\timage_url\x18\r \x01(\v2\x15.actress.ActressImageR\bimageUrl\x122\n" +
+	"\blist_url\x18\x0e \x01(\v2\x17.actress.ActressListURLR\alistUrl\"{\n" +
+	"\x0eSearchMetadata\x12!\n" +
+	"\fresult_count\x18\x01 \x01(\x05R\vresultCount\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12%\n" +
+	"\x0efirst_position\x18\x03 \x01(\x05R\rfirstPosition\"2\n" +
+	"\x11GetActressRequest\x12\x1d\n" +
+	"\n" +
+	"actress_id\x18\x01 \x01(\tR\tactressId\"G\n" +
+	"\x12GetActressResponse\x121\n" +
+	"\aactress\x18\x01 \x01(\v2\x17.actress.ActressProfileR\aactress\"\xb2\x03\n" +
+	"\x16SearchActressesRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x18\n" +
+	"\ainitial\x18\x02 \x01(\tR\ainitial\x12\x19\n" +
+	"\bgte_bust\x18\x03 \x01(\x05R\agteBust\x12\x19\n" +
+	"\blte_bust\x18\x04 \x01(\x05R\alteBust\x12\x1b\n" +
+	"\tgte_waist\x18\x05 \x01(\x05R\bgteWaist\x12\x1b\n" +
+	"\tlte_waist\x18\x06 \x01(\x05R\blteWaist\x12\x17\n" +
+	"\agte_hip\x18\a \x01(\x05R\x06gteHip\x12\x17\n" +
+	"\alte_hip\x18\b \x01(\x05R\x06lteHip\x12\x1d\n" +
+	"\n" +
+	"gte_height\x18\t \x01(\x05R\tgteHeight\x12\x1d\n" +
+	"\n" +
+	"lte_height\x18\n" +
+	" \x01(\x05R\tlteHeight\x12!\n" +
+	"\fgte_birthday\x18\v \x01(\tR\vgteBirthday\x12!\n" +
+	"\flte_birthday\x18\f \x01(\tR\vlteBirthday\x12\x12\n" +
+	"\x04sort\x18\r \x01(\tR\x04sort\x12\x12\n" +
+	"\x04hits\x18\x0e \x01(\x05R\x04hits\x12\x16\n" +
+	"\x06offset\x18\x0f \x01(\x05R\x06offset\"\x85\x01\n" +
+	"\x17SearchActressesResponse\x125\n" +
+	"\tactresses\x18\x01 \x03(\v2\x17.actress.ActressProfileR\tactresses\x123\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x17.actress.SearchMetadataR\bmetadata2\xad\x01\n" +
+	"\x0eActressService\x12E\n" +
+	"\n" +
+	"GetActress\x12\x1a.actress.GetActressRequest\x1a\x1b.actress.GetActressResponse\x12T\n" +
+	"\x0fSearchActresses\x12\x1f.actress.SearchActressesRequest\x1a .actress.SearchActressesResponseB/Z-github.com/tikfack/server/gen/actress;actressb\x06proto3"
+
+var (
+	file_actress_actress_proto_rawDescOnce sync.Once
+	file_actress_actress_proto_rawDescData []byte
+)
+
+func file_actress_actress_proto_rawDescGZIP() []byte {
+	file_actress_actress_proto_rawDescOnce.Do(func() {
+		file_actress_actress_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_actress_actress_proto_rawDesc), len(file_actress_actress_proto_rawDesc)))
+	})
+	return file_actress_actress_proto_rawDescData
+}
+
+var file_actress_actress_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_actress_actress_proto_goTypes = []any{
+	(*ActressImage)(nil),            // 0: actress.ActressImage
+	(*ActressListURL)(nil),          // 1: actress.ActressListURL
+	(*ActressProfile)(nil),          // 2: actress.ActressProfile
+	(*SearchMetadata)(nil),          // 3: actress.SearchMetadata
+	(*GetActressRequest)(nil),       // 4: actress.GetActressRequest
+	(*GetActressResponse)(nil),      // 5: actress.GetActressResponse
+	(*SearchActressesRequest)(nil),  // 6: actress.SearchActressesRequest
+	(*SearchActressesResponse)(nil), // 7: actress.SearchActressesResponse
+}
+var file_actress_actress_proto_depIdxs = []int32{
+	0, // 0: actress.ActressProfile.image_url:type_name -> actress.ActressImage
+	1, // 1: actress.ActressProfile.list_url:type_name -> actress.ActressListURL
+	2, // 2: actress.GetActressResponse.actress:type_name -> actress.ActressProfile
+	2, // 3: actress.SearchActressesResponse.actresses:type_name -> actress.ActressProfile
+	3, // 4: actress.SearchActressesResponse.metadata:type_name -> actress.SearchMetadata
+	4, // 5: actress.ActressService.GetActress:input_type -> actress.GetActressRequest
+	6, // 6: actress.ActressService.SearchActresses:input_type -> actress.SearchActressesRequest
+	5, // 7: actress.ActressService.GetActress:output_type -> actress.GetActressResponse
+	7, // 8: actress.ActressService.SearchActresses:output_type -> actress.SearchActressesResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_actress_actress_proto_init() }
+func file_actress_actress_proto_init() {
+	if File_actress_actress_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_actress_actress_proto_rawDesc), len(file_actress_actress_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_actress_actress_proto_goTypes,
+		DependencyIndexes: file_actress_actress_proto_depIdxs,
+		MessageInfos:      file_actress_actress_proto_msgTypes,
+	}.Build()
+	File_actress_actress_proto = out.File
+	file_actress_actress_proto_goTypes = nil
+	file_actress_actress_proto_depIdxs = nil
+}
@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: actress/actress.proto
+
+package actressconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	actress "github.com/tikfack/server/gen/actress"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// ActressServiceName is the fully-qualified name of the ActressService service.
+	ActressServiceName = "actress.ActressService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ActressServiceGetActressProcedure is the fully-qualified name of the ActressService's GetActress
+	// RPC.
+	ActressServiceGetActressProcedure = "/actress.ActressService/GetActress"
+	// ActressServiceSearchActressesProcedure is the fully-qualified name of the ActressService's
+	// SearchActresses RPC.
+	ActressServiceSearchActressesProcedure = "/actress.ActressService/SearchActresses"
+)
+
+// ActressServiceClient is a client for the actress.ActressService service.
+type ActressServiceClient interface {
+	GetActress(context.Context, *connect_go.Request[actress.GetActressRequest]) (*connect_go.Response[actress.GetActressResponse], error)
+	SearchActresses(context.Context, *connect_go.Request[actress.SearchActressesRequest]) (*connect_go.Response[actress.SearchActressesResponse], error)
+}
+
+// NewActressServiceClient constructs a client for the actress.ActressService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewActressServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ActressServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &actressServiceClient{
+		getActress: connect_go.NewClient[actress.GetActressRequest, actress.GetActressResponse](
+			httpClient,
+			baseURL+ActressServiceGetActressProcedure,
+			opts...,
+		),
+		searchActresses: connect_go.NewClient[actress.SearchActressesRequest, actress.SearchActressesResponse](
+			httpClient,
+			baseURL+ActressServiceSearchActressesProcedure,
+			opts...,
+		),
+	}
+}
+
+// actressServiceClient implements ActressServiceClient.
+type actressServiceClient struct {
+	getActress      *connect_go.Client[actress.GetActressRequest, actress.GetActressResponse]
+	searchActresses *connect_go.Client[actress.SearchActressesRequest, actress.SearchActressesResponse]
+}
+
+// GetActress calls actress.ActressService.GetActress.
+func (c *actressServiceClient) GetActress(ctx context.Context, req *connect_go.Request[actress.GetActressRequest]) (*connect_go.Response[actress.GetActressResponse], error) {
+	return c.getActress.CallUnary(ctx, req)
+}
+
+// SearchActresses calls actress.ActressService.SearchActresses.
+func (c *actressServiceClient) SearchActresses(ctx context.Context, req *connect_go.Request[actress.SearchActressesRequest]) (*connect_go.Response[actress.SearchActressesResponse], error) {
+	return c.searchActresses.CallUnary(ctx, req)
+}
+
+// ActressServiceHandler is an implementation of the actress.ActressService service.
+type ActressServiceHandler interface {
+	GetActress(context.Context, *connect_go.Request[actress.GetActressRequest]) (*connect_go.Response[actress.GetActressResponse], error)
+	SearchActresses(context.Context, *connect_go.Request[actress.SearchActressesRequest]) (*connect_go.Response[actress.SearchActressesResponse], error)
+}
+
+// NewActressServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewActressServiceHandler(svc ActressServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	actressServiceGetActressHandler := connect_go.NewUnaryHandler(
+		ActressServiceGetActressProcedure,
+		svc.GetActress,
+		opts...,
+	)
+	actressServiceSearchActressesHandler := connect_go.NewUnaryHandler(
+		ActressServiceSearchActressesProcedure,
+		svc.SearchActresses,
+		opts...,
+	)
+	return "/actress.ActressService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ActressServiceGetActressProcedure:
+			actressServiceGetActressHandler.ServeHTTP(w, r)
+		case ActressServiceSearchActressesProcedure:
+			actressServiceSearchActressesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedActressServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedActressServiceHandler struct{}
+
+func (UnimplementedActressServiceHandler) GetActress(context.Context, *connect_go.Request[actress.GetActressRequest]) (*connect_go.Response[actress.GetActressResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("actress.ActressService.GetActress is not implemented"))
+}
+
+func (UnimplementedActressServiceHandler) SearchActresses(context.Context, *connect_go.Request[actress.SearchActressesRequest]) (*connect_go.Response[actress.SearchActressesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("actress.ActressService.SearchActresses is not implemented"))
+}
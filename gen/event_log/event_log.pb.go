@@ -25,21 +25,23 @@ const (
 
 // Event represents a single user action or playback event.
 type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                     // Unique backend-generated ID
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Keycloak user sub (UUID)
-	VideoDmmId    string                 `protobuf:"bytes,3,opt,name=video_dmm_id,json=videoDmmId,proto3" json:"video_dmm_id,omitempty"` // DMM video identifier
-	ActressIds    []string               `protobuf:"bytes,4,rep,name=actress_ids,json=actressIds,proto3" json:"actress_ids,omitempty"`
-	DirectorIds   []string               `protobuf:"bytes,5,rep,name=director_ids,json=directorIds,proto3" json:"director_ids,omitempty"`
-	GenreIds      []string               `protobuf:"bytes,6,rep,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
-	MakerIds      []string               `protobuf:"bytes,7,rep,name=maker_ids,json=makerIds,proto3" json:"maker_ids,omitempty"`
-	SeriesIds     []string               `protobuf:"bytes,8,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
-	SessionId     string                 `protobuf:"bytes,9,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`  // Frontend-generated session ID
-	EventType     string                 `protobuf:"bytes,10,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // start/pause/skip/complete/like/share
-	EventTime     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"` // Timestamp of the event
-	Props         *structpb.Struct       `protobuf:"bytes,12,opt,name=props,proto3" json:"props,omitempty"`                          // Arbitrary JSON properties (position, duration, etc.)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                     // Ignored; the backend assigns its own ID. Use idempotency_key to deduplicate retries
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Deprecated: the user is taken from the access token; when set it must match the token sub
+	VideoDmmId     string                 `protobuf:"bytes,3,opt,name=video_dmm_id,json=videoDmmId,proto3" json:"video_dmm_id,omitempty"` // DMM video identifier
+	ActressIds     []string               `protobuf:"bytes,4,rep,name=actress_ids,json=actressIds,proto3" json:"actress_ids,omitempty"`
+	DirectorIds    []string               `protobuf:"bytes,5,rep,name=director_ids,json=directorIds,proto3" json:"director_ids,omitempty"`
+	GenreIds       []string               `protobuf:"bytes,6,rep,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	MakerIds       []string               `protobuf:"bytes,7,rep,name=maker_ids,json=makerIds,proto3" json:"maker_ids,omitempty"`
+	SeriesIds      []string               `protobuf:"bytes,8,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	SessionId      string                 `protobuf:"bytes,9,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                 // Frontend-generated session ID
+	EventType      string                 `protobuf:"bytes,10,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                // start/pause/skip/complete/like/share; unknown types are rejected
+	EventTime      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`                // Timestamp of the event; required, at most 7 days old and 5 minutes ahead
+	Props          *structpb.Struct       `protobuf:"bytes,12,opt,name=props,proto3" json:"props,omitempty"`                                         // JSON properties validated per event_type (position, duration in seconds, etc.)
+	DeviceId       string                 `protobuf:"bytes,13,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`                   // Client-generated device identifier; required for requests without an access token
+	IdempotencyKey string                 `protobuf:"bytes,14,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Client-generated key (e.g. a UUID) kept across retries of the event, at most 128 bytes; events repeating a recorded key are not recorded again
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -156,6 +158,20 @@ func (x *Event) GetProps() *structpb.Struct {
 	return nil
 }
 
+func (x *Event) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Event) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // RecordRequest carries a single Event.
 type RecordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -246,16 +262,97 @@ func (x *RecordBatchRequest) GetEvents() []*Event {
 	return nil
 }
 
-// RecordResponse is returned after processing Record or RecordBatch.
-type RecordResponse struct {
+// EventRejection explains why an event of a batch was not recorded.
+type EventRejection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`  // position of the event in RecordBatchRequest.events, or in the stream for RecordStream
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // UNKNOWN_EVENT_TYPE, MISSING_FIELD, INVALID_PROPS, MISSING_EVENT_TIME, EVENT_TIME_OUT_OF_RANGE, MISSING_EVENT, INVALID_IDEMPOTENCY_KEY or USER_MISMATCH
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`   // offending field, e.g. "event_type" or "props.position"
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	AckId         string                 `protobuf:"bytes,5,opt,name=ack_id,json=ackId,proto3" json:"ack_id,omitempty"` // RecordStreamRequest.ack_id of the event; empty for RecordBatch
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *EventRejection) Reset() {
+	*x = EventRejection{}
+	mi := &file_event_log_event_log_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRejection) ProtoMessage() {}
+
+func (x *EventRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_event_log_event_log_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRejection.ProtoReflect.Descriptor instead.
+func (*EventRejection) Descriptor() ([]byte, []int) {
+	return file_event_log_event_log_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventRejection) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EventRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventRejection) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EventRejection) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EventRejection) GetAckId() string {
+	if x != nil {
+		return x.AckId
+	}
+	return ""
+}
+
+// RecordResponse is returned after processing Record or RecordBatch.
+// Record fails with INVALID_ARGUMENT for an invalid event; RecordBatch records the valid events and lists the others.
+type RecordResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AcceptedCount  int32                  `protobuf:"varint,1,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"` // events not rejected, including duplicates
+	Rejections     []*EventRejection      `protobuf:"bytes,2,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"` // accepted events whose idempotency_key had already been recorded; they are not recorded again
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *RecordResponse) Reset() {
 	*x = RecordResponse{}
-	mi := &file_event_log_event_log_proto_msgTypes[3]
+	mi := &file_event_log_event_log_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +364,7 @@ func (x *RecordResponse) String() string {
 func (*RecordResponse) ProtoMessage() {}
 
 func (x *RecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_log_event_log_proto_msgTypes[3]
+	mi := &file_event_log_event_log_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,14 +377,141 @@ func (x *RecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordResponse.ProtoReflect.Descriptor instead.
 func (*RecordResponse) Descriptor() ([]byte, []int) {
-	return file_event_log_event_log_proto_rawDescGZIP(), []int{3}
+	return file_event_log_event_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *RecordResponse) GetRejections() []*EventRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *RecordResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+// RecordStreamRequest carries one event of a stream.
+type RecordStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AckId         string                 `protobuf:"bytes,1,opt,name=ack_id,json=ackId,proto3" json:"ack_id,omitempty"` // client-chosen id echoed back once the event was recorded or rejected
+	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStreamRequest) Reset() {
+	*x = RecordStreamRequest{}
+	mi := &file_event_log_event_log_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStreamRequest) ProtoMessage() {}
+
+func (x *RecordStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_log_event_log_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStreamRequest.ProtoReflect.Descriptor instead.
+func (*RecordStreamRequest) Descriptor() ([]byte, []int) {
+	return file_event_log_event_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordStreamRequest) GetAckId() string {
+	if x != nil {
+		return x.AckId
+	}
+	return ""
+}
+
+func (x *RecordStreamRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// RecordStreamAck acknowledges events of a stream. Acknowledged events, recorded or rejected, must not be resent.
+type RecordStreamAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AckIds        []string               `protobuf:"bytes,1,rep,name=ack_ids,json=ackIds,proto3" json:"ack_ids,omitempty"` // ack ids of the recorded events, including duplicates
+	Rejections    []*EventRejection      `protobuf:"bytes,2,rep,name=rejections,proto3" json:"rejections,omitempty"`       // rejected events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStreamAck) Reset() {
+	*x = RecordStreamAck{}
+	mi := &file_event_log_event_log_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStreamAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStreamAck) ProtoMessage() {}
+
+func (x *RecordStreamAck) ProtoReflect() protoreflect.Message {
+	mi := &file_event_log_event_log_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStreamAck.ProtoReflect.Descriptor instead.
+func (*RecordStreamAck) Descriptor() ([]byte, []int) {
+	return file_event_log_event_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecordStreamAck) GetAckIds() []string {
+	if x != nil {
+		return x.AckIds
+	}
+	return nil
+}
+
+func (x *RecordStreamAck) GetRejections() []*EventRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
 }
 
 var File_event_log_event_log_proto protoreflect.FileDescriptor
 
 const file_event_log_event_log_proto_rawDesc = "" +
 	"\n" +
-	"\x19event_log/event_log.proto\x12\beventlog\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x03\n" +
+	"\x19event_log/event_log.proto\x12\beventlog\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x03\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12 \n" +
@@ -307,15 +531,38 @@ const file_event_log_event_log_proto_rawDesc = "" +
 	" \x01(\tR\teventType\x129\n" +
 	"\n" +
 	"event_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12-\n" +
-	"\x05props\x18\f \x01(\v2\x17.google.protobuf.StructR\x05props\"6\n" +
+	"\x05props\x18\f \x01(\v2\x17.google.protobuf.StructR\x05props\x12\x1b\n" +
+	"\tdevice_id\x18\r \x01(\tR\bdeviceId\x12'\n" +
+	"\x0fidempotency_key\x18\x0e \x01(\tR\x0eidempotencyKey\"6\n" +
 	"\rRecordRequest\x12%\n" +
 	"\x05event\x18\x01 \x01(\v2\x0f.eventlog.EventR\x05event\"=\n" +
 	"\x12RecordBatchRequest\x12'\n" +
-	"\x06events\x18\x01 \x03(\v2\x0f.eventlog.EventR\x06events\"\x10\n" +
-	"\x0eRecordResponse2\x95\x01\n" +
+	"\x06events\x18\x01 \x03(\v2\x0f.eventlog.EventR\x06events\"\x85\x01\n" +
+	"\x0eEventRejection\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x15\n" +
+	"\x06ack_id\x18\x05 \x01(\tR\x05ackId\"\x9a\x01\n" +
+	"\x0eRecordResponse\x12%\n" +
+	"\x0eaccepted_count\x18\x01 \x01(\x05R\racceptedCount\x128\n" +
+	"\n" +
+	"rejections\x18\x02 \x03(\v2\x18.eventlog.EventRejectionR\n" +
+	"rejections\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\"S\n" +
+	"\x13RecordStreamRequest\x12\x15\n" +
+	"\x06ack_id\x18\x01 \x01(\tR\x05ackId\x12%\n" +
+	"\x05event\x18\x02 \x01(\v2\x0f.eventlog.EventR\x05event\"d\n" +
+	"\x0fRecordStreamAck\x12\x17\n" +
+	"\aack_ids\x18\x01 \x03(\tR\x06ackIds\x128\n" +
+	"\n" +
+	"rejections\x18\x02 \x03(\v2\x18.eventlog.EventRejectionR\n" +
+	"rejections2\xb7\x02\n" +
 	"\x0fEventLogService\x12;\n" +
 	"\x06Record\x12\x17.eventlog.RecordRequest\x1a\x18.eventlog.RecordResponse\x12E\n" +
-	"\vRecordBatch\x12\x1c.eventlog.RecordBatchRequest\x1a\x18.eventlog.RecordResponseB3Z1github.com/tikfack/server/gen/event_log;event_logb\x06proto3"
+	"\vRecordBatch\x12\x1c.eventlog.RecordBatchRequest\x1a\x18.eventlog.RecordResponse\x12J\n" +
+	"\fRecordStream\x12\x1d.eventlog.RecordStreamRequest\x1a\x19.eventlog.RecordStreamAck(\x01\x12T\n" +
+	"\x14RecordStreamWithAcks\x12\x1d.eventlog.RecordStreamRequest\x1a\x19.eventlog.RecordStreamAck(\x010\x01B3Z1github.com/tikfack/server/gen/event_log;event_logb\x06proto3"
 
 var (
 	file_event_log_event_log_proto_rawDescOnce sync.Once
@@ -329,29 +576,39 @@ func file_event_log_event_log_proto_rawDescGZIP() []byte {
 	return file_event_log_event_log_proto_rawDescData
 }
 
-var file_event_log_event_log_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_event_log_event_log_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_event_log_event_log_proto_goTypes = []any{
 	(*Event)(nil),                 // 0: eventlog.Event
 	(*RecordRequest)(nil),         // 1: eventlog.RecordRequest
 	(*RecordBatchRequest)(nil),    // 2: eventlog.RecordBatchRequest
-	(*RecordResponse)(nil),        // 3: eventlog.RecordResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 5: google.protobuf.Struct
+	(*EventRejection)(nil),        // 3: eventlog.EventRejection
+	(*RecordResponse)(nil),        // 4: eventlog.RecordResponse
+	(*RecordStreamRequest)(nil),   // 5: eventlog.RecordStreamRequest
+	(*RecordStreamAck)(nil),       // 6: eventlog.RecordStreamAck
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 8: google.protobuf.Struct
 }
 var file_event_log_event_log_proto_depIdxs = []int32{
-	4, // 0: eventlog.Event.event_time:type_name -> google.protobuf.Timestamp
-	5, // 1: eventlog.Event.props:type_name -> google.protobuf.Struct
-	0, // 2: eventlog.RecordRequest.event:type_name -> eventlog.Event
-	0, // 3: eventlog.RecordBatchRequest.events:type_name -> eventlog.Event
-	1, // 4: eventlog.EventLogService.Record:input_type -> eventlog.RecordRequest
-	2, // 5: eventlog.EventLogService.RecordBatch:input_type -> eventlog.RecordBatchRequest
-	3, // 6: eventlog.EventLogService.Record:output_type -> eventlog.RecordResponse
-	3, // 7: eventlog.EventLogService.RecordBatch:output_type -> eventlog.RecordResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7,  // 0: eventlog.Event.event_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: eventlog.Event.props:type_name -> google.protobuf.Struct
+	0,  // 2: eventlog.RecordRequest.event:type_name -> eventlog.Event
+	0,  // 3: eventlog.RecordBatchRequest.events:type_name -> eventlog.Event
+	3,  // 4: eventlog.RecordResponse.rejections:type_name -> eventlog.EventRejection
+	0,  // 5: eventlog.RecordStreamRequest.event:type_name -> eventlog.Event
+	3,  // 6: eventlog.RecordStreamAck.rejections:type_name -> eventlog.EventRejection
+	1,  // 7: eventlog.EventLogService.Record:input_type -> eventlog.RecordRequest
+	2,  // 8: eventlog.EventLogService.RecordBatch:input_type -> eventlog.RecordBatchRequest
+	5,  // 9: eventlog.EventLogService.RecordStream:input_type -> eventlog.RecordStreamRequest
+	5,  // 10: eventlog.EventLogService.RecordStreamWithAcks:input_type -> eventlog.RecordStreamRequest
+	4,  // 11: eventlog.EventLogService.Record:output_type -> eventlog.RecordResponse
+	4,  // 12: eventlog.EventLogService.RecordBatch:output_type -> eventlog.RecordResponse
+	6,  // 13: eventlog.EventLogService.RecordStream:output_type -> eventlog.RecordStreamAck
+	6,  // 14: eventlog.EventLogService.RecordStreamWithAcks:output_type -> eventlog.RecordStreamAck
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_event_log_event_log_proto_init() }
@@ -365,7 +622,7 @@ func file_event_log_event_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_log_event_log_proto_rawDesc), len(file_event_log_event_log_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,12 @@ const (
 	// EventLogServiceRecordBatchProcedure is the fully-qualified name of the EventLogService's
 	// RecordBatch RPC.
 	EventLogServiceRecordBatchProcedure = "/eventlog.EventLogService/RecordBatch"
+	// EventLogServiceRecordStreamProcedure is the fully-qualified name of the EventLogService's
+	// RecordStream RPC.
+	EventLogServiceRecordStreamProcedure = "/eventlog.EventLogService/RecordStream"
+	// EventLogServiceRecordStreamWithAcksProcedure is the fully-qualified name of the EventLogService's
+	// RecordStreamWithAcks RPC.
+	EventLogServiceRecordStreamWithAcksProcedure = "/eventlog.EventLogService/RecordStreamWithAcks"
 )
 
 // EventLogServiceClient is a client for the eventlog.EventLogService service.
@@ -46,6 +52,13 @@ type EventLogServiceClient interface {
 	Record(context.Context, *connect_go.Request[event_log.RecordRequest]) (*connect_go.Response[event_log.RecordResponse], error)
 	// Record a batch of events.
 	RecordBatch(context.Context, *connect_go.Request[event_log.RecordBatchRequest]) (*connect_go.Response[event_log.RecordResponse], error)
+	// Record a stream of events, returning a summary once the client closes the stream.
+	// Events are recorded in micro-batches while the stream is open. If recording fails part way,
+	// the error carries a RecordStreamAck detail acknowledging the batches recorded before the failure.
+	RecordStream(context.Context) *connect_go.ClientStreamForClient[event_log.RecordStreamRequest, event_log.RecordStreamAck]
+	// Record a stream of events, acknowledging each micro-batch as soon as it is recorded.
+	// Events not acknowledged when the stream breaks should be resent on a new stream.
+	RecordStreamWithAcks(context.Context) *connect_go.BidiStreamForClient[event_log.RecordStreamRequest, event_log.RecordStreamAck]
 }
 
 // NewEventLogServiceClient constructs a client for the eventlog.EventLogService service. By
@@ -68,13 +81,25 @@ func NewEventLogServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+EventLogServiceRecordBatchProcedure,
 			opts...,
 		),
+		recordStream: connect_go.NewClient[event_log.RecordStreamRequest, event_log.RecordStreamAck](
+			httpClient,
+			baseURL+EventLogServiceRecordStreamProcedure,
+			opts...,
+		),
+		recordStreamWithAcks: connect_go.NewClient[event_log.RecordStreamRequest, event_log.RecordStreamAck](
+			httpClient,
+			baseURL+EventLogServiceRecordStreamWithAcksProcedure,
+			opts...,
+		),
 	}
 }
 
 // eventLogServiceClient implements EventLogServiceClient.
 type eventLogServiceClient struct {
-	record      *connect_go.Client[event_log.RecordRequest, event_log.RecordResponse]
-	recordBatch *connect_go.Client[event_log.RecordBatchRequest, event_log.RecordResponse]
+	record               *connect_go.Client[event_log.RecordRequest, event_log.RecordResponse]
+	recordBatch          *connect_go.Client[event_log.RecordBatchRequest, event_log.RecordResponse]
+	recordStream         *connect_go.Client[event_log.RecordStreamRequest, event_log.RecordStreamAck]
+	recordStreamWithAcks *connect_go.Client[event_log.RecordStreamRequest, event_log.RecordStreamAck]
 }
 
 // Record calls eventlog.EventLogService.Record.
@@ -87,12 +112,29 @@ func (c *eventLogServiceClient) RecordBatch(ctx context.Context, req *connect_go
 	return c.recordBatch.CallUnary(ctx, req)
 }
 
+// RecordStream calls eventlog.EventLogService.RecordStream.
+func (c *eventLogServiceClient) RecordStream(ctx context.Context) *connect_go.ClientStreamForClient[event_log.RecordStreamRequest, event_log.RecordStreamAck] {
+	return c.recordStream.CallClientStream(ctx)
+}
+
+// RecordStreamWithAcks calls eventlog.EventLogService.RecordStreamWithAcks.
+func (c *eventLogServiceClient) RecordStreamWithAcks(ctx context.Context) *connect_go.BidiStreamForClient[event_log.RecordStreamRequest, event_log.RecordStreamAck] {
+	return c.recordStreamWithAcks.CallBidiStream(ctx)
+}
+
 // EventLogServiceHandler is an implementation of the eventlog.EventLogService service.
 type EventLogServiceHandler interface {
 	// Record a single event.
 	Record(context.Context, *connect_go.Request[event_log.RecordRequest]) (*connect_go.Response[event_log.RecordResponse], error)
 	// Record a batch of events.
 	RecordBatch(context.Context, *connect_go.Request[event_log.RecordBatchRequest]) (*connect_go.Response[event_log.RecordResponse], error)
+	// Record a stream of events, returning a summary once the client closes the stream.
+	// Events are recorded in micro-batches while the stream is open. If recording fails part way,
+	// the error carries a RecordStreamAck detail acknowledging the batches recorded before the failure.
+	RecordStream(context.Context, *connect_go.ClientStream[event_log.RecordStreamRequest]) (*connect_go.Response[event_log.RecordStreamAck], error)
+	// Record a stream of events, acknowledging each micro-batch as soon as it is recorded.
+	// Events not acknowledged when the stream breaks should be resent on a new stream.
+	RecordStreamWithAcks(context.Context, *connect_go.BidiStream[event_log.RecordStreamRequest, event_log.RecordStreamAck]) error
 }
 
 // NewEventLogServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -111,12 +153,26 @@ func NewEventLogServiceHandler(svc EventLogServiceHandler, opts ...connect_go.Ha
 		svc.RecordBatch,
 		opts...,
 	)
+	eventLogServiceRecordStreamHandler := connect_go.NewClientStreamHandler(
+		EventLogServiceRecordStreamProcedure,
+		svc.RecordStream,
+		opts...,
+	)
+	eventLogServiceRecordStreamWithAcksHandler := connect_go.NewBidiStreamHandler(
+		EventLogServiceRecordStreamWithAcksProcedure,
+		svc.RecordStreamWithAcks,
+		opts...,
+	)
 	return "/eventlog.EventLogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EventLogServiceRecordProcedure:
 			eventLogServiceRecordHandler.ServeHTTP(w, r)
 		case EventLogServiceRecordBatchProcedure:
 			eventLogServiceRecordBatchHandler.ServeHTTP(w, r)
+		case EventLogServiceRecordStreamProcedure:
+			eventLogServiceRecordStreamHandler.ServeHTTP(w, r)
+		case EventLogServiceRecordStreamWithAcksProcedure:
+			eventLogServiceRecordStreamWithAcksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -133,3 +189,11 @@ func (UnimplementedEventLogServiceHandler) Record(context.Context, *connect_go.R
 func (UnimplementedEventLogServiceHandler) RecordBatch(context.Context, *connect_go.Request[event_log.RecordBatchRequest]) (*connect_go.Response[event_log.RecordResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("eventlog.EventLogService.RecordBatch is not implemented"))
 }
+
+func (UnimplementedEventLogServiceHandler) RecordStream(context.Context, *connect_go.ClientStream[event_log.RecordStreamRequest]) (*connect_go.Response[event_log.RecordStreamAck], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("eventlog.EventLogService.RecordStream is not implemented"))
+}
+
+func (UnimplementedEventLogServiceHandler) RecordStreamWithAcks(context.Context, *connect_go.BidiStream[event_log.RecordStreamRequest, event_log.RecordStreamAck]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("eventlog.EventLogService.RecordStreamWithAcks is not implemented"))
+}
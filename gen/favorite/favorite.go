@@ -1,18 +1,57 @@
 package favorite
 
+import (
+	actress "github.com/tikfack/server/gen/actress"
+	video "github.com/tikfack/server/gen/video"
+)
+
+// DetailStatus reports the outcome of looking up a favorite's details in the catalog.
+type DetailStatus int32
+
+const (
+	DetailStatus_DETAIL_STATUS_UNSPECIFIED DetailStatus = 0
+	DetailStatus_DETAIL_STATUS_FOUND       DetailStatus = 1
+	DetailStatus_DETAIL_STATUS_NOT_FOUND   DetailStatus = 2
+	DetailStatus_DETAIL_STATUS_FAILED      DetailStatus = 3
+)
+
+// FavoriteSortOrder selects the order favorites are listed in.
+type FavoriteSortOrder int32
+
+const (
+	FavoriteSortOrder_FAVORITE_SORT_ORDER_UNSPECIFIED  FavoriteSortOrder = 0
+	FavoriteSortOrder_FAVORITE_SORT_ORDER_OLDEST_FIRST FavoriteSortOrder = 1
+	FavoriteSortOrder_FAVORITE_SORT_ORDER_NEWEST_FIRST FavoriteSortOrder = 2
+)
+
+// ArticleType is the kind of catalog classification a favorite article refers to.
+type ArticleType int32
+
+const (
+	ArticleType_ARTICLE_TYPE_UNSPECIFIED ArticleType = 0
+	ArticleType_ARTICLE_TYPE_GENRE       ArticleType = 1
+	ArticleType_ARTICLE_TYPE_MAKER       ArticleType = 2
+	ArticleType_ARTICLE_TYPE_SERIES      ArticleType = 3
+	ArticleType_ARTICLE_TYPE_DIRECTOR    ArticleType = 4
+)
+
 // Data transfer structures for FavoriteService.
 type FavoriteVideo struct {
-	FavoriteVideoUuid string `json:"favorite_video_uuid"`
-	UserId            string `json:"user_id"`
-	VideoId           string `json:"video_id"`
-	CreatedAt         string `json:"created_at"`
+	FavoriteVideoUuid string       `json:"favorite_video_uuid"`
+	UserId            string       `json:"user_id"`
+	VideoId           string       `json:"video_id"`
+	CreatedAt         string       `json:"created_at"`
+	Video             *video.Video `json:"video,omitempty"`
+	DetailStatus      DetailStatus `json:"detail_status"`
 }
 
 type FavoriteActor struct {
-	FavoriteActorUuid string `json:"favorite_actor_uuid"`
-	UserId            string `json:"user_id"`
-	ActorId           string `json:"actor_id"`
-	CreatedAt         string `json:"created_at"`
+	FavoriteActorUuid string                  `json:"favorite_actor_uuid"`
+	UserId            string                  `json:"user_id"`
+	ActorId           string                  `json:"actor_id"`
+	CreatedAt         string                  `json:"created_at"`
+	Actress           *actress.ActressProfile `json:"actress,omitempty"`
+	DetailStatus      DetailStatus            `json:"detail_status"`
 }
 
 type AddFavoriteVideoRequest struct {
@@ -31,10 +70,19 @@ type RemoveFavoriteVideoResponse struct {
 	FavoriteVideoUuid string `json:"favorite_video_uuid"`
 }
 
-type ListFavoriteVideosRequest struct{}
+type ListFavoriteVideosRequest struct {
+	IncludeDetails bool              `json:"include_details"`
+	PageSize       int32             `json:"page_size"`
+	PageToken      string            `json:"page_token"`
+	SortOrder      FavoriteSortOrder `json:"sort_order"`
+}
 
 type ListFavoriteVideosResponse struct {
 	FavoriteVideos []*FavoriteVideo `json:"favorite_videos"`
+	MissingCount   int32            `json:"missing_count"`
+	FailedCount    int32            `json:"failed_count"`
+	NextPageToken  string           `json:"next_page_token"`
+	TotalCount     int32            `json:"total_count"`
 }
 
 type AddFavoriteActorRequest struct {
@@ -53,8 +101,57 @@ type RemoveFavoriteActorResponse struct {
 	FavoriteActorUuid string `json:"favorite_actor_uuid"`
 }
 
-type ListFavoriteActorsRequest struct{}
+type ListFavoriteActorsRequest struct {
+	IncludeDetails bool              `json:"include_details"`
+	PageSize       int32             `json:"page_size"`
+	PageToken      string            `json:"page_token"`
+	SortOrder      FavoriteSortOrder `json:"sort_order"`
+}
 
 type ListFavoriteActorsResponse struct {
 	FavoriteActors []*FavoriteActor `json:"favorite_actors"`
+	MissingCount   int32            `json:"missing_count"`
+	FailedCount    int32            `json:"failed_count"`
+	NextPageToken  string           `json:"next_page_token"`
+	TotalCount     int32            `json:"total_count"`
+}
+
+type FavoriteArticle struct {
+	FavoriteArticleUuid string      `json:"favorite_article_uuid"`
+	UserId              string      `json:"user_id"`
+	ArticleType         ArticleType `json:"article_type"`
+	ArticleId           string      `json:"article_id"`
+	CreatedAt           string      `json:"created_at"`
+}
+
+type AddFavoriteArticleRequest struct {
+	ArticleType ArticleType `json:"article_type"`
+	ArticleId   string      `json:"article_id"`
+}
+
+type AddFavoriteArticleResponse struct {
+	FavoriteArticle *FavoriteArticle `json:"favorite_article"`
+}
+
+type RemoveFavoriteArticleRequest struct {
+	ArticleType ArticleType `json:"article_type"`
+	ArticleId   string      `json:"article_id"`
+}
+
+type RemoveFavoriteArticleResponse struct {
+	FavoriteArticleUuid string `json:"favorite_article_uuid"`
+	Removed             bool   `json:"removed"`
+}
+
+type ListFavoriteArticlesRequest struct {
+	ArticleType ArticleType       `json:"article_type"`
+	PageSize    int32             `json:"page_size"`
+	PageToken   string            `json:"page_token"`
+	SortOrder   FavoriteSortOrder `json:"sort_order"`
+}
+
+type ListFavoriteArticlesResponse struct {
+	FavoriteArticles []*FavoriteArticle `json:"favorite_articles"`
+	NextPageToken    string             `json:"next_page_token"`
+	TotalCount       int32              `json:"total_count"`
 }
@@ -19,6 +19,10 @@ const (
 	FavoriteServiceAddFavoriteActorProcedure    = "/favorite.FavoriteService/AddFavoriteActor"
 	FavoriteServiceRemoveFavoriteActorProcedure = "/favorite.FavoriteService/RemoveFavoriteActor"
 	FavoriteServiceListFavoriteActorsProcedure  = "/favorite.FavoriteService/ListFavoriteActors"
+
+	FavoriteServiceAddFavoriteArticleProcedure    = "/favorite.FavoriteService/AddFavoriteArticle"
+	FavoriteServiceRemoveFavoriteArticleProcedure = "/favorite.FavoriteService/RemoveFavoriteArticle"
+	FavoriteServiceListFavoriteArticlesProcedure  = "/favorite.FavoriteService/ListFavoriteArticles"
 )
 
 // FavoriteServiceHandler defines the server interface.
@@ -29,6 +33,9 @@ type FavoriteServiceHandler interface {
 	AddFavoriteActor(context.Context, *connect.Request[favorite.AddFavoriteActorRequest]) (*connect.Response[favorite.AddFavoriteActorResponse], error)
 	RemoveFavoriteActor(context.Context, *connect.Request[favorite.RemoveFavoriteActorRequest]) (*connect.Response[favorite.RemoveFavoriteActorResponse], error)
 	ListFavoriteActors(context.Context, *connect.Request[favorite.ListFavoriteActorsRequest]) (*connect.Response[favorite.ListFavoriteActorsResponse], error)
+	AddFavoriteArticle(context.Context, *connect.Request[favorite.AddFavoriteArticleRequest]) (*connect.Response[favorite.AddFavoriteArticleResponse], error)
+	RemoveFavoriteArticle(context.Context, *connect.Request[favorite.RemoveFavoriteArticleRequest]) (*connect.Response[favorite.RemoveFavoriteArticleResponse], error)
+	ListFavoriteArticles(context.Context, *connect.Request[favorite.ListFavoriteArticlesRequest]) (*connect.Response[favorite.ListFavoriteArticlesResponse], error)
 }
 
 // NewFavoriteServiceHandler registers unary handlers for FavoriteService.
@@ -63,6 +70,21 @@ func NewFavoriteServiceHandler(svc FavoriteServiceHandler, opts ...connect.Handl
 		svc.ListFavoriteActors,
 		opts...,
 	)
+	addFavoriteArticleHandler := connect.NewUnaryHandler(
+		FavoriteServiceAddFavoriteArticleProcedure,
+		svc.AddFavoriteArticle,
+		opts...,
+	)
+	removeFavoriteArticleHandler := connect.NewUnaryHandler(
+		FavoriteServiceRemoveFavoriteArticleProcedure,
+		svc.RemoveFavoriteArticle,
+		opts...,
+	)
+	listFavoriteArticlesHandler := connect.NewUnaryHandler(
+		FavoriteServiceListFavoriteArticlesProcedure,
+		svc.ListFavoriteArticles,
+		opts...,
+	)
 
 	return "/favorite.FavoriteService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -78,6 +100,12 @@ func NewFavoriteServiceHandler(svc FavoriteServiceHandler, opts ...connect.Handl
 			removeFavoriteActorHandler.ServeHTTP(w, r)
 		case FavoriteServiceListFavoriteActorsProcedure:
 			listFavoriteActorsHandler.ServeHTTP(w, r)
+		case FavoriteServiceAddFavoriteArticleProcedure:
+			addFavoriteArticleHandler.ServeHTTP(w, r)
+		case FavoriteServiceRemoveFavoriteArticleProcedure:
+			removeFavoriteArticleHandler.ServeHTTP(w, r)
+		case FavoriteServiceListFavoriteArticlesProcedure:
+			listFavoriteArticlesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +138,15 @@ func (UnimplementedFavoriteServiceHandler) RemoveFavoriteActor(context.Context,
 func (UnimplementedFavoriteServiceHandler) ListFavoriteActors(context.Context, *connect.Request[favorite.ListFavoriteActorsRequest]) (*connect.Response[favorite.ListFavoriteActorsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("favorite.FavoriteService.ListFavoriteActors is not implemented"))
 }
+
+func (UnimplementedFavoriteServiceHandler) AddFavoriteArticle(context.Context, *connect.Request[favorite.AddFavoriteArticleRequest]) (*connect.Response[favorite.AddFavoriteArticleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("favorite.FavoriteService.AddFavoriteArticle is not implemented"))
+}
+
+func (UnimplementedFavoriteServiceHandler) RemoveFavoriteArticle(context.Context, *connect.Request[favorite.RemoveFavoriteArticleRequest]) (*connect.Response[favorite.RemoveFavoriteArticleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("favorite.FavoriteService.RemoveFavoriteArticle is not implemented"))
+}
+
+func (UnimplementedFavoriteServiceHandler) ListFavoriteArticles(context.Context, *connect.Request[favorite.ListFavoriteArticlesRequest]) (*connect.Response[favorite.ListFavoriteArticlesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("favorite.FavoriteService.ListFavoriteArticles is not implemented"))
+}
@@ -0,0 +1,1185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: playlist/playlist.proto
+
+package playlist
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Who can see a playlist. Only the owner can modify it regardless of visibility.
+type PlaylistVisibility int32
+
+const (
+	PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED PlaylistVisibility = 0
+	PlaylistVisibility_PLAYLIST_VISIBILITY_PRIVATE     PlaylistVisibility = 1 // owner only
+	PlaylistVisibility_PLAYLIST_VISIBILITY_UNLISTED    PlaylistVisibility = 2 // anyone with the playlist_uuid
+	PlaylistVisibility_PLAYLIST_VISIBILITY_PUBLIC      PlaylistVisibility = 3 // also listed on the owner's profile
+)
+
+// Enum value maps for PlaylistVisibility.
+var (
+	PlaylistVisibility_name = map[int32]string{
+		0: "PLAYLIST_VISIBILITY_UNSPECIFIED",
+		1: "PLAYLIST_VISIBILITY_PRIVATE",
+		2: "PLAYLIST_VISIBILITY_UNLISTED",
+		3: "PLAYLIST_VISIBILITY_PUBLIC",
+	}
+	PlaylistVisibility_value = map[string]int32{
+		"PLAYLIST_VISIBILITY_UNSPECIFIED": 0,
+		"PLAYLIST_VISIBILITY_PRIVATE":     1,
+		"PLAYLIST_VISIBILITY_UNLISTED":    2,
+		"PLAYLIST_VISIBILITY_PUBLIC":      3,
+	}
+)
+
+func (x PlaylistVisibility) Enum() *PlaylistVisibility {
+	p := new(PlaylistVisibility)
+	*p = x
+	return p
+}
+
+func (x PlaylistVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaylistVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_playlist_playlist_proto_enumTypes[0].Descriptor()
+}
+
+func (PlaylistVisibility) Type() protoreflect.EnumType {
+	return &file_playlist_playlist_proto_enumTypes[0]
+}
+
+func (x PlaylistVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaylistVisibility.Descriptor instead.
+func (PlaylistVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{0}
+}
+
+type Playlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility    PlaylistVisibility     `protobuf:"varint,5,opt,name=visibility,proto3,enum=playlist.PlaylistVisibility" json:"visibility,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	mi := &file_playlist_playlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Playlist) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+func (x *Playlist) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Playlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Playlist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Playlist) GetVisibility() PlaylistVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Playlist) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Playlist) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Playlist) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PlaylistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 0-based, contiguous within the playlist
+	AddedAt       string                 `protobuf:"bytes,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaylistItem) Reset() {
+	*x = PlaylistItem{}
+	mi := &file_playlist_playlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistItem) ProtoMessage() {}
+
+func (x *PlaylistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistItem.ProtoReflect.Descriptor instead.
+func (*PlaylistItem) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaylistItem) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *PlaylistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaylistItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type CreatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility    PlaylistVisibility     `protobuf:"varint,3,opt,name=visibility,proto3,enum=playlist.PlaylistVisibility" json:"visibility,omitempty"` // UNSPECIFIED creates a private playlist
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistRequest) Reset() {
+	*x = CreatePlaylistRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistRequest) ProtoMessage() {}
+
+func (x *CreatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePlaylistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetVisibility() PlaylistVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED
+}
+
+type CreatePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistResponse) Reset() {
+	*x = CreatePlaylistResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistResponse) ProtoMessage() {}
+
+func (x *CreatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// Fields that are not set are left unchanged.
+type UpdatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Visibility    PlaylistVisibility     `protobuf:"varint,4,opt,name=visibility,proto3,enum=playlist.PlaylistVisibility" json:"visibility,omitempty"` // UNSPECIFIED keeps the current visibility
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistRequest) Reset() {
+	*x = UpdatePlaylistRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistRequest) ProtoMessage() {}
+
+func (x *UpdatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePlaylistRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetVisibility() PlaylistVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED
+}
+
+type UpdatePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistResponse) Reset() {
+	*x = UpdatePlaylistResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistResponse) ProtoMessage() {}
+
+func (x *UpdatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+type DeletePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistRequest) Reset() {
+	*x = DeletePlaylistRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistRequest) ProtoMessage() {}
+
+func (x *DeletePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePlaylistRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+type DeletePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistResponse) Reset() {
+	*x = DeletePlaylistResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistResponse) ProtoMessage() {}
+
+func (x *DeletePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{7}
+}
+
+type GetPlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaylistRequest) Reset() {
+	*x = GetPlaylistRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistRequest) ProtoMessage() {}
+
+func (x *GetPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPlaylistRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+type GetPlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	Items         []*PlaylistItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // ordered by position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaylistResponse) Reset() {
+	*x = GetPlaylistResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistResponse) ProtoMessage() {}
+
+func (x *GetPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *GetPlaylistResponse) GetItems() []*PlaylistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListPlaylistsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Owner whose playlists to list; empty lists the caller's own playlists.
+	// Only public playlists are returned for other users.
+	OwnerId       string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsRequest) Reset() {
+	*x = ListPlaylistsRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPlaylistsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ListPlaylistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlists     []*Playlist            `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"` // most recently updated first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsResponse) Reset() {
+	*x = ListPlaylistsResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPlaylistsResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+type AddPlaylistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistItemRequest) Reset() {
+	*x = AddPlaylistItemRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistItemRequest) ProtoMessage() {}
+
+func (x *AddPlaylistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddPlaylistItemRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddPlaylistItemRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+func (x *AddPlaylistItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type AddPlaylistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *PlaylistItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"` // the existing item when the video is already in the playlist
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistItemResponse) Reset() {
+	*x = AddPlaylistItemResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistItemResponse) ProtoMessage() {}
+
+func (x *AddPlaylistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddPlaylistItemResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddPlaylistItemResponse) GetItem() *PlaylistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RemovePlaylistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistItemRequest) Reset() {
+	*x = RemovePlaylistItemRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistItemRequest) ProtoMessage() {}
+
+func (x *RemovePlaylistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistItemRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistItemRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemovePlaylistItemRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+func (x *RemovePlaylistItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type RemovePlaylistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistItemResponse) Reset() {
+	*x = RemovePlaylistItemResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistItemResponse) ProtoMessage() {}
+
+func (x *RemovePlaylistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistItemResponse.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistItemResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{15}
+}
+
+type MovePlaylistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistUuid  string                 `protobuf:"bytes,1,opt,name=playlist_uuid,json=playlistUuid,proto3" json:"playlist_uuid,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // new 0-based position; values past the end move the item last
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovePlaylistItemRequest) Reset() {
+	*x = MovePlaylistItemRequest{}
+	mi := &file_playlist_playlist_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovePlaylistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePlaylistItemRequest) ProtoMessage() {}
+
+func (x *MovePlaylistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePlaylistItemRequest.ProtoReflect.Descriptor instead.
+func (*MovePlaylistItemRequest) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MovePlaylistItemRequest) GetPlaylistUuid() string {
+	if x != nil {
+		return x.PlaylistUuid
+	}
+	return ""
+}
+
+func (x *MovePlaylistItemRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *MovePlaylistItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type MovePlaylistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PlaylistItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // the whole playlist in its new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovePlaylistItemResponse) Reset() {
+	*x = MovePlaylistItemResponse{}
+	mi := &file_playlist_playlist_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovePlaylistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePlaylistItemResponse) ProtoMessage() {}
+
+func (x *MovePlaylistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_playlist_playlist_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePlaylistItemResponse.ProtoReflect.Descriptor instead.
+func (*MovePlaylistItemResponse) Descriptor() ([]byte, []int) {
+	return file_playlist_playlist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MovePlaylistItemResponse) GetItems() []*PlaylistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_playlist_playlist_proto protoreflect.FileDescriptor
+
+const file_playlist_playlist_proto_rawDesc = "" +
+	"\n" +
+	"\x17playlist/playlist.proto\x12\bplaylist\"\x9b\x02\n" +
+	"\bPlaylist\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12<\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x1c.playlist.PlaylistVisibilityR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"`\n" +
+	"\fPlaylistItem\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x19\n" +
+	"\badded_at\x18\x03 \x01(\tR\aaddedAt\"\x8b\x01\n" +
+	"\x15CreatePlaylistRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12<\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x1c.playlist.PlaylistVisibilityR\n" +
+	"visibility\"H\n" +
+	"\x16CreatePlaylistResponse\x12.\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x12.playlist.PlaylistR\bplaylist\"\xd3\x01\n" +
+	"\x15UpdatePlaylistRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12<\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x1c.playlist.PlaylistVisibilityR\n" +
+	"visibilityB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"H\n" +
+	"\x16UpdatePlaylistResponse\x12.\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x12.playlist.PlaylistR\bplaylist\"<\n" +
+	"\x15DeletePlaylistRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\"\x18\n" +
+	"\x16DeletePlaylistResponse\"9\n" +
+	"\x12GetPlaylistRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\"s\n" +
+	"\x13GetPlaylistResponse\x12.\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x12.playlist.PlaylistR\bplaylist\x12,\n" +
+	"\x05items\x18\x02 \x03(\v2\x16.playlist.PlaylistItemR\x05items\"1\n" +
+	"\x14ListPlaylistsRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\"I\n" +
+	"\x15ListPlaylistsResponse\x120\n" +
+	"\tplaylists\x18\x01 \x03(\v2\x12.playlist.PlaylistR\tplaylists\"X\n" +
+	"\x16AddPlaylistItemRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"E\n" +
+	"\x17AddPlaylistItemResponse\x12*\n" +
+	"\x04item\x18\x01 \x01(\v2\x16.playlist.PlaylistItemR\x04item\"[\n" +
+	"\x19RemovePlaylistItemRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"\x1c\n" +
+	"\x1aRemovePlaylistItemResponse\"u\n" +
+	"\x17MovePlaylistItemRequest\x12#\n" +
+	"\rplaylist_uuid\x18\x01 \x01(\tR\fplaylistUuid\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"H\n" +
+	"\x18MovePlaylistItemResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.playlist.PlaylistItemR\x05items*\x9c\x01\n" +
+	"\x12PlaylistVisibility\x12#\n" +
+	"\x1fPLAYLIST_VISIBILITY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPLAYLIST_VISIBILITY_PRIVATE\x10\x01\x12 \n" +
+	"\x1cPLAYLIST_VISIBILITY_UNLISTED\x10\x02\x12\x1e\n" +
+	"\x1aPLAYLIST_VISIBILITY_PUBLIC\x10\x032\xc2\x05\n" +
+	"\x0fPlaylistService\x12S\n" +
+	"\x0eCreatePlaylist\x12\x1f.playlist.CreatePlaylistRequest\x1a .playlist.CreatePlaylistResponse\x12S\n" +
+	"\x0eUpdatePlaylist\x12\x1f.playlist.UpdatePlaylistRequest\x1a .playlist.UpdatePlaylistResponse\x12S\n" +
+	"\x0eDeletePlaylist\x12\x1f.playlist.DeletePlaylistRequest\x1a .playlist.DeletePlaylistResponse\x12J\n" +
+	"\vGetPlaylist\x12\x1c.playlist.GetPlaylistRequest\x1a\x1d.playlist.GetPlaylistResponse\x12P\n" +
+	"\rListPlaylists\x12\x1e.playlist.ListPlaylistsRequest\x1a\x1f.playlist.ListPlaylistsResponse\x12V\n" +
+	"\x0fAddPlaylistItem\x12 .playlist.AddPlaylistItemRequest\x1a!.playlist.AddPlaylistItemResponse\x12_\n" +
+	"\x12RemovePlaylistItem\x12#.playlist.RemovePlaylistItemRequest\x1a$.playlist.RemovePlaylistItemResponse\x12Y\n" +
+	"\x10MovePlaylistItem\x12!.playlist.MovePlaylistItemRequest\x1a\".playlist.MovePlaylistItemResponseB1Z/github.com/tikfack/server/gen/playlist;playlistb\x06proto3"
+
+var (
+	file_playlist_playlist_proto_rawDescOnce sync.Once
+	file_playlist_playlist_proto_rawDescData []byte
+)
+
+func file_playlist_playlist_proto_rawDescGZIP() []byte {
+	file_playlist_playlist_proto_rawDescOnce.Do(func() {
+		file_playlist_playlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_playlist_playlist_proto_rawDesc), len(file_playlist_playlist_proto_rawDesc)))
+	})
+	return file_playlist_playlist_proto_rawDescData
+}
+
+var file_playlist_playlist_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_playlist_playlist_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_playlist_playlist_proto_goTypes = []any{
+	(PlaylistVisibility)(0),            // 0: playlist.PlaylistVisibility
+	(*Playlist)(nil),                   // 1: playlist.Playlist
+	(*PlaylistItem)(nil),               // 2: playlist.PlaylistItem
+	(*CreatePlaylistRequest)(nil),      // 3: playlist.CreatePlaylistRequest
+	(*CreatePlaylistResponse)(nil),     // 4: playlist.CreatePlaylistResponse
+	(*UpdatePlaylistRequest)(nil),      // 5: playlist.UpdatePlaylistRequest
+	(*UpdatePlaylistResponse)(nil),     // 6: playlist.UpdatePlaylistResponse
+	(*DeletePlaylistRequest)(nil),      // 7: playlist.DeletePlaylistRequest
+	(*DeletePlaylistResponse)(nil),     // 8: playlist.DeletePlaylistResponse
+	(*GetPlaylistRequest)(nil),         // 9: playlist.GetPlaylistRequest
+	(*GetPlaylistResponse)(nil),        // 10: playlist.GetPlaylistResponse
+	(*ListPlaylistsRequest)(nil),       // 11: playlist.ListPlaylistsRequest
+	(*ListPlaylistsResponse)(nil),      // 12: playlist.ListPlaylistsResponse
+	(*AddPlaylistItemRequest)(nil),     // 13: playlist.AddPlaylistItemRequest
+	(*AddPlaylistItemResponse)(nil),    // 14: playlist.AddPlaylistItemResponse
+	(*RemovePlaylistItemRequest)(nil),  // 15: playlist.RemovePlaylistItemRequest
+	(*RemovePlaylistItemResponse)(nil), // 16: playlist.RemovePlaylistItemResponse
+	(*MovePlaylistItemRequest)(nil),    // 17: playlist.MovePlaylistItemRequest
+	(*MovePlaylistItemResponse)(nil),   // 18: playlist.MovePlaylistItemResponse
+}
+var file_playlist_playlist_proto_depIdxs = []int32{
+	0,  // 0: playlist.Playlist.visibility:type_name -> playlist.PlaylistVisibility
+	0,  // 1: playlist.CreatePlaylistRequest.visibility:type_name -> playlist.PlaylistVisibility
+	1,  // 2: playlist.CreatePlaylistResponse.playlist:type_name -> playlist.Playlist
+	0,  // 3: playlist.UpdatePlaylistRequest.visibility:type_name -> playlist.PlaylistVisibility
+	1,  // 4: playlist.UpdatePlaylistResponse.playlist:type_name -> playlist.Playlist
+	1,  // 5: playlist.GetPlaylistResponse.playlist:type_name -> playlist.Playlist
+	2,  // 6: playlist.GetPlaylistResponse.items:type_name -> playlist.PlaylistItem
+	1,  // 7: playlist.ListPlaylistsResponse.playlists:type_name -> playlist.Playlist
+	2,  // 8: playlist.AddPlaylistItemResponse.item:type_name -> playlist.PlaylistItem
+	2,  // 9: playlist.MovePlaylistItemResponse.items:type_name -> playlist.PlaylistItem
+	3,  // 10: playlist.PlaylistService.CreatePlaylist:input_type -> playlist.CreatePlaylistRequest
+	5,  // 11: playlist.PlaylistService.UpdatePlaylist:input_type -> playlist.UpdatePlaylistRequest
+	7,  // 12: playlist.PlaylistService.DeletePlaylist:input_type -> playlist.DeletePlaylistRequest
+	9,  // 13: playlist.PlaylistService.GetPlaylist:input_type -> playlist.GetPlaylistRequest
+	11, // 14: playlist.PlaylistService.ListPlaylists:input_type -> playlist.ListPlaylistsRequest
+	13, // 15: playlist.PlaylistService.AddPlaylistItem:input_type -> playlist.AddPlaylistItemRequest
+	15, // 16: playlist.PlaylistService.RemovePlaylistItem:input_type -> playlist.RemovePlaylistItemRequest
+	17, // 17: playlist.PlaylistService.MovePlaylistItem:input_type -> playlist.MovePlaylistItemRequest
+	4,  // 18: playlist.PlaylistService.CreatePlaylist:output_type -> playlist.CreatePlaylistResponse
+	6,  // 19: playlist.PlaylistService.UpdatePlaylist:output_type -> playlist.UpdatePlaylistResponse
+	8,  // 20: playlist.PlaylistService.DeletePlaylist:output_type -> playlist.DeletePlaylistResponse
+	10, // 21: playlist.PlaylistService.GetPlaylist:output_type -> playlist.GetPlaylistResponse
+	12, // 22: playlist.PlaylistService.ListPlaylists:output_type -> playlist.ListPlaylistsResponse
+	14, // 23: playlist.PlaylistService.AddPlaylistItem:output_type -> playlist.AddPlaylistItemResponse
+	16, // 24: playlist.PlaylistService.RemovePlaylistItem:output_type -> playlist.RemovePlaylistItemResponse
+	18, // 25: playlist.PlaylistService.MovePlaylistItem:output_type -> playlist.MovePlaylistItemResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_playlist_playlist_proto_init() }
+func file_playlist_playlist_proto_init() {
+	if File_playlist_playlist_proto != nil {
+		return
+	}
+	file_playlist_playlist_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_playlist_playlist_proto_rawDesc), len(file_playlist_playlist_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_playlist_playlist_proto_goTypes,
+		DependencyIndexes: file_playlist_playlist_proto_depIdxs,
+		EnumInfos:         file_playlist_playlist_proto_enumTypes,
+		MessageInfos:      file_playlist_playlist_proto_msgTypes,
+	}.Build()
+	File_playlist_playlist_proto = out.File
+	file_playlist_playlist_proto_goTypes = nil
+	file_playlist_playlist_proto_depIdxs = nil
+}
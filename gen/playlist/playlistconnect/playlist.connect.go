@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: playlist/playlist.proto
+
+package playlistconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	playlist "github.com/tikfack/server/gen/playlist"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// PlaylistServiceName is the fully-qualified name of the PlaylistService service.
+	PlaylistServiceName = "playlist.PlaylistService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PlaylistServiceCreatePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// CreatePlaylist RPC.
+	PlaylistServiceCreatePlaylistProcedure = "/playlist.PlaylistService/CreatePlaylist"
+	// PlaylistServiceUpdatePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// UpdatePlaylist RPC.
+	PlaylistServiceUpdatePlaylistProcedure = "/playlist.PlaylistService/UpdatePlaylist"
+	// PlaylistServiceDeletePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// DeletePlaylist RPC.
+	PlaylistServiceDeletePlaylistProcedure = "/playlist.PlaylistService/DeletePlaylist"
+	// PlaylistServiceGetPlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// GetPlaylist RPC.
+	PlaylistServiceGetPlaylistProcedure = "/playlist.PlaylistService/GetPlaylist"
+	// PlaylistServiceListPlaylistsProcedure is the fully-qualified name of the PlaylistService's
+	// ListPlaylists RPC.
+	PlaylistServiceListPlaylistsProcedure = "/playlist.PlaylistService/ListPlaylists"
+	// PlaylistServiceAddPlaylistItemProcedure is the fully-qualified name of the PlaylistService's
+	// AddPlaylistItem RPC.
+	PlaylistServiceAddPlaylistItemProcedure = "/playlist.PlaylistService/AddPlaylistItem"
+	// PlaylistServiceRemovePlaylistItemProcedure is the fully-qualified name of the PlaylistService's
+	// RemovePlaylistItem RPC.
+	PlaylistServiceRemovePlaylistItemProcedure = "/playlist.PlaylistService/RemovePlaylistItem"
+	// PlaylistServiceMovePlaylistItemProcedure is the fully-qualified name of the PlaylistService's
+	// MovePlaylistItem RPC.
+	PlaylistServiceMovePlaylistItemProcedure = "/playlist.PlaylistService/MovePlaylistItem"
+)
+
+// PlaylistServiceClient is a client for the playlist.PlaylistService service.
+type PlaylistServiceClient interface {
+	CreatePlaylist(context.Context, *connect_go.Request[playlist.CreatePlaylistRequest]) (*connect_go.Response[playlist.CreatePlaylistResponse], error)
+	UpdatePlaylist(context.Context, *connect_go.Request[playlist.UpdatePlaylistRequest]) (*connect_go.Response[playlist.UpdatePlaylistResponse], error)
+	DeletePlaylist(context.Context, *connect_go.Request[playlist.DeletePlaylistRequest]) (*connect_go.Response[playlist.DeletePlaylistResponse], error)
+	GetPlaylist(context.Context, *connect_go.Request[playlist.GetPlaylistRequest]) (*connect_go.Response[playlist.GetPlaylistResponse], error)
+	ListPlaylists(context.Context, *connect_go.Request[playlist.ListPlaylistsRequest]) (*connect_go.Response[playlist.ListPlaylistsResponse], error)
+	AddPlaylistItem(context.Context, *connect_go.Request[playlist.AddPlaylistItemRequest]) (*connect_go.Response[playlist.AddPlaylistItemResponse], error)
+	RemovePlaylistItem(context.Context, *connect_go.Request[playlist.RemovePlaylistItemRequest]) (*connect_go.Response[playlist.RemovePlaylistItemResponse], error)
+	MovePlaylistItem(context.Context, *connect_go.Request[playlist.MovePlaylistItemRequest]) (*connect_go.Response[playlist.MovePlaylistItemResponse], error)
+}
+
+// NewPlaylistServiceClient constructs a client for the playlist.PlaylistService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlaylistServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) PlaylistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &playlistServiceClient{
+		createPlaylist: connect_go.NewClient[playlist.CreatePlaylistRequest, playlist.CreatePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceCreatePlaylistProcedure,
+			opts...,
+		),
+		updatePlaylist: connect_go.NewClient[playlist.UpdatePlaylistRequest, playlist.UpdatePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceUpdatePlaylistProcedure,
+			opts...,
+		),
+		deletePlaylist: connect_go.NewClient[playlist.DeletePlaylistRequest, playlist.DeletePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceDeletePlaylistProcedure,
+			opts...,
+		),
+		getPlaylist: connect_go.NewClient[playlist.GetPlaylistRequest, playlist.GetPlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceGetPlaylistProcedure,
+			opts...,
+		),
+		listPlaylists: connect_go.NewClient[playlist.ListPlaylistsRequest, playlist.ListPlaylistsResponse](
+			httpClient,
+			baseURL+PlaylistServiceListPlaylistsProcedure,
+			opts...,
+		),
+		addPlaylistItem: connect_go.NewClient[playlist.AddPlaylistItemRequest, playlist.AddPlaylistItemResponse](
+			httpClient,
+			baseURL+PlaylistServiceAddPlaylistItemProcedure,
+			opts...,
+		),
+		removePlaylistItem: connect_go.NewClient[playlist.RemovePlaylistItemRequest, playlist.RemovePlaylistItemResponse](
+			httpClient,
+			baseURL+PlaylistServiceRemovePlaylistItemProcedure,
+			opts...,
+		),
+		movePlaylistItem: connect_go.NewClient[playlist.MovePlaylistItemRequest, playlist.MovePlaylistItemResponse](
+			httpClient,
+			baseURL+PlaylistServiceMovePlaylistItemProcedure,
+			opts...,
+		),
+	}
+}
+
+// playlistServiceClient implements PlaylistServiceClient.
+type playlistServiceClient struct {
+	createPlaylist     *connect_go.Client[playlist.CreatePlaylistRequest, playlist.CreatePlaylistResponse]
+	updatePlaylist     *connect_go.Client[playlist.UpdatePlaylistRequest, playlist.UpdatePlaylistResponse]
+	deletePlaylist     *connect_go.Client[playlist.DeletePlaylistRequest, playlist.DeletePlaylistResponse]
+	getPlaylist        *connect_go.Client[playlist.GetPlaylistRequest, playlist.GetPlaylistResponse]
+	listPlaylists      *connect_go.Client[playlist.ListPlaylistsRequest, playlist.ListPlaylistsResponse]
+	addPlaylistItem    *connect_go.Client[playlist.AddPlaylistItemRequest, playlist.AddPlaylistItemResponse]
+	removePlaylistItem *connect_go.Client[playlist.RemovePlaylistItemRequest, playlist.RemovePlaylistItemResponse]
+	movePlaylistItem   *connect_go.Client[playlist.MovePlaylistItemRequest, playlist.MovePlaylistItemResponse]
+}
+
+// CreatePlaylist calls playlist.PlaylistService.CreatePlaylist.
+func (c *playlistServiceClient) CreatePlaylist(ctx context.Context, req *connect_go.Request[playlist.CreatePlaylistRequest]) (*connect_go.Response[playlist.CreatePlaylistResponse], error) {
+	return c.createPlaylist.CallUnary(ctx, req)
+}
+
+// UpdatePlaylist calls playlist.PlaylistService.UpdatePlaylist.
+func (c *playlistServiceClient) UpdatePlaylist(ctx context.Context, req *connect_go.Request[playlist.UpdatePlaylistRequest]) (*connect_go.Response[playlist.UpdatePlaylistResponse], error) {
+	return c.updatePlaylist.CallUnary(ctx, req)
+}
+
+// DeletePlaylist calls playlist.PlaylistService.DeletePlaylist.
+func (c *playlistServiceClient) DeletePlaylist(ctx context.Context, req *connect_go.Request[playlist.DeletePlaylistRequest]) (*connect_go.Response[playlist.DeletePlaylistResponse], error) {
+	return c.deletePlaylist.CallUnary(ctx, req)
+}
+
+// GetPlaylist calls playlist.PlaylistService.GetPlaylist.
+func (c *playlistServiceClient) GetPlaylist(ctx context.Context, req *connect_go.Request[playlist.GetPlaylistRequest]) (*connect_go.Response[playlist.GetPlaylistResponse], error) {
+	return c.getPlaylist.CallUnary(ctx, req)
+}
+
+// ListPlaylists calls playlist.PlaylistService.ListPlaylists.
+func (c *playlistServiceClient) ListPlaylists(ctx context.Context, req *connect_go.Request[playlist.ListPlaylistsRequest]) (*connect_go.Response[playlist.ListPlaylistsResponse], error) {
+	return c.listPlaylists.CallUnary(ctx, req)
+}
+
+// AddPlaylistItem calls playlist.PlaylistService.AddPlaylistItem.
+func (c *playlistServiceClient) AddPlaylistItem(ctx context.Context, req *connect_go.Request[playlist.AddPlaylistItemRequest]) (*connect_go.Response[playlist.AddPlaylistItemResponse], error) {
+	return c.addPlaylistItem.CallUnary(ctx, req)
+}
+
+// RemovePlaylistItem calls playlist.PlaylistService.RemovePlaylistItem.
+func (c *playlistServiceClient) RemovePlaylistItem(ctx context.Context, req *connect_go.Request[playlist.RemovePlaylistItemRequest]) (*connect_go.Response[playlist.RemovePlaylistItemResponse], error) {
+	return c.removePlaylistItem.CallUnary(ctx, req)
+}
+
+// MovePlaylistItem calls playlist.PlaylistService.MovePlaylistItem.
+func (c *playlistServiceClient) MovePlaylistItem(ctx context.Context, req *connect_go.Request[playlist.MovePlaylistItemRequest]) (*connect_go.Response[playlist.MovePlaylistItemResponse], error) {
+	return c.movePlaylistItem.CallUnary(ctx, req)
+}
+
+// PlaylistServiceHandler is an implementation of the playlist.PlaylistService service.
+type PlaylistServiceHandler interface {
+	CreatePlaylist(context.Context, *connect_go.Request[playlist.CreatePlaylistRequest]) (*connect_go.Response[playlist.CreatePlaylistResponse], error)
+	UpdatePlaylist(context.Context, *connect_go.Request[playlist.UpdatePlaylistRequest]) (*connect_go.Response[playlist.UpdatePlaylistResponse], error)
+	DeletePlaylist(context.Context, *connect_go.Request[playlist.DeletePlaylistRequest]) (*connect_go.Response[playlist.DeletePlaylistResponse], error)
+	GetPlaylist(context.Context, *connect_go.Request[playlist.GetPlaylistRequest]) (*connect_go.Response[playlist.GetPlaylistResponse], error)
+	ListPlaylists(context.Context, *connect_go.Request[playlist.ListPlaylistsRequest]) (*connect_go.Response[playlist.ListPlaylistsResponse], error)
+	AddPlaylistItem(context.Context, *connect_go.Request[playlist.AddPlaylistItemRequest]) (*connect_go.Response[playlist.AddPlaylistItemResponse], error)
+	RemovePlaylistItem(context.Context, *connect_go.Request[playlist.RemovePlaylistItemRequest]) (*connect_go.Response[playlist.RemovePlaylistItemResponse], error)
+	MovePlaylistItem(context.Context, *connect_go.Request[playlist.MovePlaylistItemRequest]) (*connect_go.Response[playlist.MovePlaylistItemResponse], error)
+}
+
+// NewPlaylistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlaylistServiceHandler(svc PlaylistServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	playlistServiceCreatePlaylistHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceCreatePlaylistProcedure,
+		svc.CreatePlaylist,
+		opts...,
+	)
+	playlistServiceUpdatePlaylistHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceUpdatePlaylistProcedure,
+		svc.UpdatePlaylist,
+		opts...,
+	)
+	playlistServiceDeletePlaylistHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceDeletePlaylistProcedure,
+		svc.DeletePlaylist,
+		opts...,
+	)
+	playlistServiceGetPlaylistHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceGetPlaylistProcedure,
+		svc.GetPlaylist,
+		opts...,
+	)
+	playlistServiceListPlaylistsHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceListPlaylistsProcedure,
+		svc.ListPlaylists,
+		opts...,
+	)
+	playlistServiceAddPlaylistItemHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceAddPlaylistItemProcedure,
+		svc.AddPlaylistItem,
+		opts...,
+	)
+	playlistServiceRemovePlaylistItemHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceRemovePlaylistItemProcedure,
+		svc.RemovePlaylistItem,
+		opts...,
+	)
+	playlistServiceMovePlaylistItemHandler := connect_go.NewUnaryHandler(
+		PlaylistServiceMovePlaylistItemProcedure,
+		svc.MovePlaylistItem,
+		opts...,
+	)
+	return "/playlist.PlaylistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlaylistServiceCreatePlaylistProcedure:
+			playlistServiceCreatePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceUpdatePlaylistProcedure:
+			playlistServiceUpdatePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceDeletePlaylistProcedure:
+			playlistServiceDeletePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceGetPlaylistProcedure:
+			playlistServiceGetPlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceListPlaylistsProcedure:
+			playlistServiceListPlaylistsHandler.ServeHTTP(w, r)
+		case PlaylistServiceAddPlaylistItemProcedure:
+			playlistServiceAddPlaylistItemHandler.ServeHTTP(w, r)
+		case PlaylistServiceRemovePlaylistItemProcedure:
+			playlistServiceRemovePlaylistItemHandler.ServeHTTP(w, r)
+		case PlaylistServiceMovePlaylistItemProcedure:
+			playlistServiceMovePlaylistItemHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlaylistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlaylistServiceHandler struct{}
+
+func (UnimplementedPlaylistServiceHandler) CreatePlaylist(context.Context, *connect_go.Request[playlist.CreatePlaylistRequest]) (*connect_go.Response[playlist.CreatePlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.CreatePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) UpdatePlaylist(context.Context, *connect_go.Request[playlist.UpdatePlaylistRequest]) (*connect_go.Response[playlist.UpdatePlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.UpdatePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) DeletePlaylist(context.Context, *connect_go.Request[playlist.DeletePlaylistRequest]) (*connect_go.Response[playlist.DeletePlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.DeletePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) GetPlaylist(context.Context, *connect_go.Request[playlist.GetPlaylistRequest]) (*connect_go.Response[playlist.GetPlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.GetPlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) ListPlaylists(context.Context, *connect_go.Request[playlist.ListPlaylistsRequest]) (*connect_go.Response[playlist.ListPlaylistsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.ListPlaylists is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) AddPlaylistItem(context.Context, *connect_go.Request[playlist.AddPlaylistItemRequest]) (*connect_go.Response[playlist.AddPlaylistItemResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.AddPlaylistItem is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) RemovePlaylistItem(context.Context, *connect_go.Request[playlist.RemovePlaylistItemRequest]) (*connect_go.Response[playlist.RemovePlaylistItemResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.RemovePlaylistItem is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) MovePlaylistItem(context.Context, *connect_go.Request[playlist.MovePlaylistItemRequest]) (*connect_go.Response[playlist.MovePlaylistItemResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("playlist.PlaylistService.MovePlaylistItem is not implemented"))
+}
@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: taxonomy/taxonomy.proto
+
+package taxonomy
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ジャンル・メーカー・シリーズの 1 件
+type TaxonomyEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 動画の genres・makers・series の id と対応する
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ruby          string                 `protobuf:"bytes,3,opt,name=ruby,proto3" json:"ruby,omitempty"`                      // 読み仮名
+	ListUrl       string                 `protobuf:"bytes,4,opt,name=list_url,json=listUrl,proto3" json:"list_url,omitempty"` // 該当作品の一覧ページのURL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxonomyEntry) Reset() {
+	*x = TaxonomyEntry{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxonomyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxonomyEntry) ProtoMessage() {}
+
+func (x *TaxonomyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxonomyEntry.ProtoReflect.Descriptor instead.
+func (*TaxonomyEntry) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaxonomyEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaxonomyEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxonomyEntry) GetRuby() string {
+	if x != nil {
+		return x.Ruby
+	}
+	return ""
+}
+
+func (x *TaxonomyEntry) GetListUrl() string {
+	if x != nil {
+		return x.ListUrl
+	}
+	return ""
+}
+
+// 検索結果のメタデータ
+type SearchMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultCount   int32                  `protobuf:"varint,1,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`       // 取得件数
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`          // 全体件数
+	FirstPosition int32                  `protobuf:"varint,3,opt,name=first_position,json=firstPosition,proto3" json:"first_position,omitempty"` // 検索開始位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMetadata) Reset() {
+	*x = SearchMetadata{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMetadata) ProtoMessage() {}
+
+func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMetadata.ProtoReflect.Descriptor instead.
+func (*SearchMetadata) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchMetadata) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetFirstPosition() int32 {
+	if x != nil {
+		return x.FirstPosition
+	}
+	return 0
+}
+
+// 一覧取得用メッセージ（すべてのフィールドはoptional）
+type ListTaxonomyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FloorId       string                 `protobuf:"bytes,1,opt,name=floor_id,json=floorId,proto3" json:"floor_id,omitempty"` // フロアID（初期値：43 = 動画／ビデオ、省略可）
+	Initial       string                 `protobuf:"bytes,2,opt,name=initial,proto3" json:"initial,omitempty"`                // 名前の頭文字（ひらがな 1 文字、省略可）
+	Hits          int32                  `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`                     // 取得件数（初期値：100、最大：500、省略可）
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                 // 検索開始位置（初期値：1、最大：50000、省略可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxonomyRequest) Reset() {
+	*x = ListTaxonomyRequest{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxonomyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxonomyRequest) ProtoMessage() {}
+
+func (x *ListTaxonomyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxonomyRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxonomyRequest) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTaxonomyRequest) GetFloorId() string {
+	if x != nil {
+		return x.FloorId
+	}
+	return ""
+}
+
+func (x *ListTaxonomyRequest) GetInitial() string {
+	if x != nil {
+		return x.Initial
+	}
+	return ""
+}
+
+func (x *ListTaxonomyRequest) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *ListTaxonomyRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTaxonomyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TaxonomyEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxonomyResponse) Reset() {
+	*x = ListTaxonomyResponse{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxonomyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxonomyResponse) ProtoMessage() {}
+
+func (x *ListTaxonomyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxonomyResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxonomyResponse) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTaxonomyResponse) GetEntries() []*TaxonomyEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListTaxonomyResponse) GetMetadata() *SearchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetTaxonomyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FloorId       string                 `protobuf:"bytes,2,opt,name=floor_id,json=floorId,proto3" json:"floor_id,omitempty"` // フロアID（初期値：43、省略可）
+	Initial       string                 `protobuf:"bytes,3,opt,name=initial,proto3" json:"initial,omitempty"`                // 名前の頭文字。分かっている場合に指定すると検索範囲を絞れる（省略可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxonomyRequest) Reset() {
+	*x = GetTaxonomyRequest{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxonomyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxonomyRequest) ProtoMessage() {}
+
+func (x *GetTaxonomyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxonomyRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxonomyRequest) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTaxonomyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTaxonomyRequest) GetFloorId() string {
+	if x != nil {
+		return x.FloorId
+	}
+	return ""
+}
+
+func (x *GetTaxonomyRequest) GetInitial() string {
+	if x != nil {
+		return x.Initial
+	}
+	return ""
+}
+
+type GetTaxonomyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TaxonomyEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxonomyResponse) Reset() {
+	*x = GetTaxonomyResponse{}
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxonomyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxonomyResponse) ProtoMessage() {}
+
+func (x *GetTaxonomyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taxonomy_taxonomy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxonomyResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxonomyResponse) Descriptor() ([]byte, []int) {
+	return file_taxonomy_taxonomy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTaxonomyResponse) GetEntry() *TaxonomyEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+var File_taxonomy_taxonomy_proto protoreflect.FileDescriptor
+
+const file_taxonomy_taxonomy_proto_rawDesc = "" +
+	"\n" +
+	"\x17taxonomy/taxonomy.proto\x12\btaxonomy\"b\n" +
+	"\rTaxonomyEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04ruby\x18\x03 \x01(\tR\x04ruby\x12\x19\n" +
+	"\blist_url\x18\x04 \x01(\tR\alistUrl\"{\n" +
+	"\x0eSearchMetadata\x12!\n" +
+	"\fresult_count\x18\x01 \x01(\x05R\vresultCount\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12%\n" +
+	"\x0efirst_position\x18\x03 \x01(\x05R\rfirstPosition\"v\n" +
+	"\x13ListTaxonomyRequest\x12\x19\n" +
+	"\bfloor_id\x18\x01 \x01(\tR\afloorId\x12\x18\n" +
+	"\ainitial\x18\x02 \x01(\tR\ainitial\x12\x12\n" +
+	"\x04hits\x18\x03 \x01(\x05R\x04hits\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x7f\n" +
+	"\x14ListTaxonomyResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.taxonomy.TaxonomyEntryR\aentries\x124\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x18.taxonomy.SearchMetadataR\bmetadata\"Y\n" +
+	"\x12GetTaxonomyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bfloor_id\x18\x02 \x01(\tR\afloorId\x12\x18\n" +
+	"\ainitial\x18\x03 \x01(\tR\ainitial\"D\n" +
+	"\x13GetTaxonomyResponse\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.taxonomy.TaxonomyEntryR\x05entry2\xdb\x03\n" +
+	"\x16CatalogTaxonomyService\x12K\n" +
+	"\n" +
+	"ListGenres\x12\x1d.taxonomy.ListTaxonomyRequest\x1a\x1e.taxonomy.ListTaxonomyResponse\x12K\n" +
+	"\n" +
+	"ListMakers\x12\x1d.taxonomy.ListTaxonomyRequest\x1a\x1e.taxonomy.ListTaxonomyResponse\x12K\n" +
+	"\n" +
+	"ListSeries\x12\x1d.taxonomy.ListTaxonomyRequest\x1a\x1e.taxonomy.ListTaxonomyResponse\x12G\n" +
+	"\bGetGenre\x12\x1c.taxonomy.GetTaxonomyRequest\x1a\x1d.taxonomy.GetTaxonomyResponse\x12G\n" +
+	"\bGetMaker\x12\x1c.taxonomy.GetTaxonomyRequest\x1a\x1d.taxonomy.GetTaxonomyResponse\x12H\n" +
+	"\tGetSeries\x12\x1c.taxonomy.GetTaxonomyRequest\x1a\x1d.taxonomy.GetTaxonomyResponseB1Z/github.com/tikfack/server/gen/taxonomy;taxonomyb\x06proto3"
+
+var (
+	file_taxonomy_taxonomy_proto_rawDescOnce sync.Once
+	file_taxonomy_taxonomy_proto_rawDescData []byte
+)
+
+func file_taxonomy_taxonomy_proto_rawDescGZIP() []byte {
+	file_taxonomy_taxonomy_proto_rawDescOnce.Do(func() {
+		file_taxonomy_taxonomy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_taxonomy_taxonomy_proto_rawDesc), len(file_taxonomy_taxonomy_proto_rawDesc)))
+	})
+	return file_taxonomy_taxonomy_proto_rawDescData
+}
+
+var file_taxonomy_taxonomy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_taxonomy_taxonomy_proto_goTypes = []any{
+	(*TaxonomyEntry)(nil),        // 0: taxonomy.TaxonomyEntry
+	(*SearchMetadata)(nil),       // 1: taxonomy.SearchMetadata
+	(*ListTaxonomyRequest)(nil),  // 2: taxonomy.ListTaxonomyRequest
+	(*ListTaxonomyResponse)(nil), // 3: taxonomy.ListTaxonomyResponse
+	(*GetTaxonomyRequest)(nil),   // 4: taxonomy.GetTaxonomyRequest
+	(*GetTaxonomyResponse)(nil),  // 5: taxonomy.GetTaxonomyResponse
+}
+var file_taxonomy_taxonomy_proto_depIdxs = []int32{
+	0, // 0: taxonomy.ListTaxonomyResponse.entries:type_name -> taxonomy.TaxonomyEntry
+	1, // 1: taxonomy.ListTaxonomyResponse.metadata:type_name -> taxonomy.SearchMetadata
+	0, // 2: taxonomy.GetTaxonomyResponse.entry:type_name -> taxonomy.TaxonomyEntry
+	2, // 3: taxonomy.CatalogTaxonomyService.ListGenres:input_type -> taxonomy.ListTaxonomyRequest
+	2, // 4: taxonomy.CatalogTaxonomyService.ListMakers:input_type -> taxonomy.ListTaxonomyRequest
+	2, // 5: taxonomy.CatalogTaxonomyService.ListSeries:input_type -> taxonomy.ListTaxonomyRequest
+	4, // 6: taxonomy.CatalogTaxonomyService.GetGenre:input_type -> taxonomy.GetTaxonomyRequest
+	4, // 7: taxonomy.CatalogTaxonomyService.GetMaker:input_type -> taxonomy.GetTaxonomyRequest
+	4, // 8: taxonomy.CatalogTaxonomyService.GetSeries:input_type -> taxonomy.GetTaxonomyRequest
+	3, // 9: taxonomy.CatalogTaxonomyService.ListGenres:output_type -> taxonomy.ListTaxonomyResponse
+	3, // 10: taxonomy.CatalogTaxonomyService.ListMakers:output_type -> taxonomy.ListTaxonomyResponse
+	3, // 11: taxonomy.CatalogTaxonomyService.ListSeries:output_type -> taxonomy.ListTaxonomyResponse
+	5, // 12: taxonomy.CatalogTaxonomyService.GetGenre:output_type -> taxonomy.GetTaxonomyResponse
+	5, // 13: taxonomy.CatalogTaxonomyService.GetMaker:output_type -> taxonomy.GetTaxonomyResponse
+	5, // 14: taxonomy.CatalogTaxonomyService.GetSeries:output_type -> taxonomy.GetTaxonomyResponse
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_taxonomy_taxonomy_proto_init() }
+func file_taxonomy_taxonomy_proto_init() {
+	if File_taxonomy_taxonomy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taxonomy_taxonomy_proto_rawDesc), len(file_taxonomy_taxonomy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taxonomy_taxonomy_proto_goTypes,
+		DependencyIndexes: file_taxonomy_taxonomy_proto_depIdxs,
+		MessageInfos:      file_taxonomy_taxonomy_proto_msgTypes,
+	}.Build()
+	File_taxonomy_taxonomy_proto = out.File
+	file_taxonomy_taxonomy_proto_goTypes = nil
+	file_taxonomy_taxonomy_proto_depIdxs = nil
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: taxonomy/taxonomy.proto
+
+package taxonomyconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	taxonomy "github.com/tikfack/server/gen/taxonomy"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// CatalogTaxonomyServiceName is the fully-qualified name of the CatalogTaxonomyService service.
+	CatalogTaxonomyServiceName = "taxonomy.CatalogTaxonomyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CatalogTaxonomyServiceListGenresProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's ListGenres RPC.
+	CatalogTaxonomyServiceListGenresProcedure = "/taxonomy.CatalogTaxonomyService/ListGenres"
+	// CatalogTaxonomyServiceListMakersProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's ListMakers RPC.
+	CatalogTaxonomyServiceListMakersProcedure = "/taxonomy.CatalogTaxonomyService/ListMakers"
+	// CatalogTaxonomyServiceListSeriesProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's ListSeries RPC.
+	CatalogTaxonomyServiceListSeriesProcedure = "/taxonomy.CatalogTaxonomyService/ListSeries"
+	// CatalogTaxonomyServiceGetGenreProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's GetGenre RPC.
+	CatalogTaxonomyServiceGetGenreProcedure = "/taxonomy.CatalogTaxonomyService/GetGenre"
+	// CatalogTaxonomyServiceGetMakerProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's GetMaker RPC.
+	CatalogTaxonomyServiceGetMakerProcedure = "/taxonomy.CatalogTaxonomyService/GetMaker"
+	// CatalogTaxonomyServiceGetSeriesProcedure is the fully-qualified name of the
+	// CatalogTaxonomyService's GetSeries RPC.
+	CatalogTaxonomyServiceGetSeriesProcedure = "/taxonomy.CatalogTaxonomyService/GetSeries"
+)
+
+// CatalogTaxonomyServiceClient is a client for the taxonomy.CatalogTaxonomyService service.
+type CatalogTaxonomyServiceClient interface {
+	ListGenres(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	ListMakers(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	ListSeries(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	GetGenre(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+	GetMaker(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+	GetSeries(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+}
+
+// NewCatalogTaxonomyServiceClient constructs a client for the taxonomy.CatalogTaxonomyService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCatalogTaxonomyServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) CatalogTaxonomyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &catalogTaxonomyServiceClient{
+		listGenres: connect_go.NewClient[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceListGenresProcedure,
+			opts...,
+		),
+		listMakers: connect_go.NewClient[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceListMakersProcedure,
+			opts...,
+		),
+		listSeries: connect_go.NewClient[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceListSeriesProcedure,
+			opts...,
+		),
+		getGenre: connect_go.NewClient[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceGetGenreProcedure,
+			opts...,
+		),
+		getMaker: connect_go.NewClient[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceGetMakerProcedure,
+			opts...,
+		),
+		getSeries: connect_go.NewClient[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse](
+			httpClient,
+			baseURL+CatalogTaxonomyServiceGetSeriesProcedure,
+			opts...,
+		),
+	}
+}
+
+// catalogTaxonomyServiceClient implements CatalogTaxonomyServiceClient.
+type catalogTaxonomyServiceClient struct {
+	listGenres *connect_go.Client[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse]
+	listMakers *connect_go.Client[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse]
+	listSeries *connect_go.Client[taxonomy.ListTaxonomyRequest, taxonomy.ListTaxonomyResponse]
+	getGenre   *connect_go.Client[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse]
+	getMaker   *connect_go.Client[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse]
+	getSeries  *connect_go.Client[taxonomy.GetTaxonomyRequest, taxonomy.GetTaxonomyResponse]
+}
+
+// ListGenres calls taxonomy.CatalogTaxonomyService.ListGenres.
+func (c *catalogTaxonomyServiceClient) ListGenres(ctx context.Context, req *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return c.listGenres.CallUnary(ctx, req)
+}
+
+// ListMakers calls taxonomy.CatalogTaxonomyService.ListMakers.
+func (c *catalogTaxonomyServiceClient) ListMakers(ctx context.Context, req *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return c.listMakers.CallUnary(ctx, req)
+}
+
+// ListSeries calls taxonomy.CatalogTaxonomyService.ListSeries.
+func (c *catalogTaxonomyServiceClient) ListSeries(ctx context.Context, req *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return c.listSeries.CallUnary(ctx, req)
+}
+
+// GetGenre calls taxonomy.CatalogTaxonomyService.GetGenre.
+func (c *catalogTaxonomyServiceClient) GetGenre(ctx context.Context, req *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return c.getGenre.CallUnary(ctx, req)
+}
+
+// GetMaker calls taxonomy.CatalogTaxonomyService.GetMaker.
+func (c *catalogTaxonomyServiceClient) GetMaker(ctx context.Context, req *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return c.getMaker.CallUnary(ctx, req)
+}
+
+// GetSeries calls taxonomy.CatalogTaxonomyService.GetSeries.
+func (c *catalogTaxonomyServiceClient) GetSeries(ctx context.Context, req *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return c.getSeries.CallUnary(ctx, req)
+}
+
+// CatalogTaxonomyServiceHandler is an implementation of the taxonomy.CatalogTaxonomyService
+// service.
+type CatalogTaxonomyServiceHandler interface {
+	ListGenres(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	ListMakers(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	ListSeries(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error)
+	GetGenre(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+	GetMaker(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+	GetSeries(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error)
+}
+
+// NewCatalogTaxonomyServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCatalogTaxonomyServiceHandler(svc CatalogTaxonomyServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	catalogTaxonomyServiceListGenresHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceListGenresProcedure,
+		svc.ListGenres,
+		opts...,
+	)
+	catalogTaxonomyServiceListMakersHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceListMakersProcedure,
+		svc.ListMakers,
+		opts...,
+	)
+	catalogTaxonomyServiceListSeriesHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceListSeriesProcedure,
+		svc.ListSeries,
+		opts...,
+	)
+	catalogTaxonomyServiceGetGenreHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceGetGenreProcedure,
+		svc.GetGenre,
+		opts...,
+	)
+	catalogTaxonomyServiceGetMakerHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceGetMakerProcedure,
+		svc.GetMaker,
+		opts...,
+	)
+	catalogTaxonomyServiceGetSeriesHandler := connect_go.NewUnaryHandler(
+		CatalogTaxonomyServiceGetSeriesProcedure,
+		svc.GetSeries,
+		opts...,
+	)
+	return "/taxonomy.CatalogTaxonomyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CatalogTaxonomyServiceListGenresProcedure:
+			catalogTaxonomyServiceListGenresHandler.ServeHTTP(w, r)
+		case CatalogTaxonomyServiceListMakersProcedure:
+			catalogTaxonomyServiceListMakersHandler.ServeHTTP(w, r)
+		case CatalogTaxonomyServiceListSeriesProcedure:
+			catalogTaxonomyServiceListSeriesHandler.ServeHTTP(w, r)
+		case CatalogTaxonomyServiceGetGenreProcedure:
+			catalogTaxonomyServiceGetGenreHandler.ServeHTTP(w, r)
+		case CatalogTaxonomyServiceGetMakerProcedure:
+			catalogTaxonomyServiceGetMakerHandler.ServeHTTP(w, r)
+		case CatalogTaxonomyServiceGetSeriesProcedure:
+			catalogTaxonomyServiceGetSeriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCatalogTaxonomyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCatalogTaxonomyServiceHandler struct{}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) ListGenres(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.ListGenres is not implemented"))
+}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) ListMakers(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.ListMakers is not implemented"))
+}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) ListSeries(context.Context, *connect_go.Request[taxonomy.ListTaxonomyRequest]) (*connect_go.Response[taxonomy.ListTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.ListSeries is not implemented"))
+}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) GetGenre(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.GetGenre is not implemented"))
+}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) GetMaker(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.GetMaker is not implemented"))
+}
+
+func (UnimplementedCatalogTaxonomyServiceHandler) GetSeries(context.Context, *connect_go.Request[taxonomy.GetTaxonomyRequest]) (*connect_go.Response[taxonomy.GetTaxonomyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("taxonomy.CatalogTaxonomyService.GetSeries is not implemented"))
+}
@@ -21,6 +21,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 再生URLの解決結果
+type PlaybackURLStatus int32
+
+const (
+	PlaybackURLStatus_PLAYBACK_URL_STATUS_UNSPECIFIED PlaybackURLStatus = 0
+	PlaybackURLStatus_PLAYBACK_URL_STATUS_RESOLVED    PlaybackURLStatus = 1 // 直接再生URLを解決できた
+	PlaybackURLStatus_PLAYBACK_URL_STATUS_NOT_FOUND   PlaybackURLStatus = 2 // 直接再生URLが存在しない（sample_url を利用する）
+	PlaybackURLStatus_PLAYBACK_URL_STATUS_FAILED      PlaybackURLStatus = 3 // 一時的なエラーで解決できなかった（再試行可）
+)
+
+// Enum value maps for PlaybackURLStatus.
+var (
+	PlaybackURLStatus_name = map[int32]string{
+		0: "PLAYBACK_URL_STATUS_UNSPECIFIED",
+		1: "PLAYBACK_URL_STATUS_RESOLVED",
+		2: "PLAYBACK_URL_STATUS_NOT_FOUND",
+		3: "PLAYBACK_URL_STATUS_FAILED",
+	}
+	PlaybackURLStatus_value = map[string]int32{
+		"PLAYBACK_URL_STATUS_UNSPECIFIED": 0,
+		"PLAYBACK_URL_STATUS_RESOLVED":    1,
+		"PLAYBACK_URL_STATUS_NOT_FOUND":   2,
+		"PLAYBACK_URL_STATUS_FAILED":      3,
+	}
+)
+
+func (x PlaybackURLStatus) Enum() *PlaybackURLStatus {
+	p := new(PlaybackURLStatus)
+	*p = x
+	return p
+}
+
+func (x PlaybackURLStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaybackURLStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_video_proto_enumTypes[0].Descriptor()
+}
+
+func (PlaybackURLStatus) Type() protoreflect.EnumType {
+	return &file_video_video_proto_enumTypes[0]
+}
+
+func (x PlaybackURLStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaybackURLStatus.Descriptor instead.
+func (PlaybackURLStatus) EnumDescriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{0}
+}
+
+type VideoLookupStatus int32
+
+const (
+	VideoLookupStatus_VIDEO_LOOKUP_STATUS_UNSPECIFIED VideoLookupStatus = 0
+	VideoLookupStatus_VIDEO_LOOKUP_STATUS_FOUND       VideoLookupStatus = 1 // 取得できた
+	VideoLookupStatus_VIDEO_LOOKUP_STATUS_NOT_FOUND   VideoLookupStatus = 2 // カタログに存在しない（販売終了など）
+	VideoLookupStatus_VIDEO_LOOKUP_STATUS_FAILED      VideoLookupStatus = 3 // 一時的なエラーで取得できなかった（再試行可）
+)
+
+// Enum value maps for VideoLookupStatus.
+var (
+	VideoLookupStatus_name = map[int32]string{
+		0: "VIDEO_LOOKUP_STATUS_UNSPECIFIED",
+		1: "VIDEO_LOOKUP_STATUS_FOUND",
+		2: "VIDEO_LOOKUP_STATUS_NOT_FOUND",
+		3: "VIDEO_LOOKUP_STATUS_FAILED",
+	}
+	VideoLookupStatus_value = map[string]int32{
+		"VIDEO_LOOKUP_STATUS_UNSPECIFIED": 0,
+		"VIDEO_LOOKUP_STATUS_FOUND":       1,
+		"VIDEO_LOOKUP_STATUS_NOT_FOUND":   2,
+		"VIDEO_LOOKUP_STATUS_FAILED":      3,
+	}
+)
+
+func (x VideoLookupStatus) Enum() *VideoLookupStatus {
+	p := new(VideoLookupStatus)
+	*p = x
+	return p
+}
+
+func (x VideoLookupStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoLookupStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_video_video_proto_enumTypes[1].Descriptor()
+}
+
+func (VideoLookupStatus) Type() protoreflect.EnumType {
+	return &file_video_video_proto_enumTypes[1]
+}
+
+func (x VideoLookupStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoLookupStatus.Descriptor instead.
+func (VideoLookupStatus) EnumDescriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{1}
+}
+
 // 各属性用のメッセージ定義（IDと名前）
 type Actress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -282,29 +387,28 @@ func (x *Director) GetName() string {
 	return ""
 }
 
-// レビュー情報
-type Review struct {
+type Label struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Average       float32                `protobuf:"fixed32,2,opt,name=average,proto3" json:"average,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
+func (x *Label) Reset() {
+	*x = Label{}
 	mi := &file_video_video_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Review) String() string {
+func (x *Label) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*Label) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
+func (x *Label) ProtoReflect() protoreflect.Message {
 	mi := &file_video_video_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,61 +420,48 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
 	return file_video_video_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Review) GetCount() int32 {
+func (x *Label) GetId() string {
 	if x != nil {
-		return x.Count
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Review) GetAverage() float32 {
+func (x *Label) GetName() string {
 	if x != nil {
-		return x.Average
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// Videoメッセージを重要度順に並び替え
-type Video struct {
+// レビュー情報
+type Review struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DmmId         string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	DirectUrl     string                 `protobuf:"bytes,3,opt,name=direct_url,json=directUrl,proto3" json:"direct_url,omitempty"`
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	SampleUrl     string                 `protobuf:"bytes,5,opt,name=sample_url,json=sampleUrl,proto3" json:"sample_url,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Price         int32                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
-	LikesCount    int32                  `protobuf:"varint,9,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
-	Actresses     []*Actress             `protobuf:"bytes,10,rep,name=actresses,proto3" json:"actresses,omitempty"`
-	Genres        []*Genre               `protobuf:"bytes,11,rep,name=genres,proto3" json:"genres,omitempty"`
-	Makers        []*Maker               `protobuf:"bytes,12,rep,name=makers,proto3" json:"makers,omitempty"`
-	Series        []*Series              `protobuf:"bytes,13,rep,name=series,proto3" json:"series,omitempty"`
-	Directors     []*Director            `protobuf:"bytes,14,rep,name=directors,proto3" json:"directors,omitempty"`
-	Review        *Review                `protobuf:"bytes,15,opt,name=review,proto3" json:"review,omitempty"` // レビュー情報
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Average       float32                `protobuf:"fixed32,2,opt,name=average,proto3" json:"average,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Video) Reset() {
-	*x = Video{}
+func (x *Review) Reset() {
+	*x = Review{}
 	mi := &file_video_video_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Video) String() string {
+func (x *Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Video) ProtoMessage() {}
+func (*Review) ProtoMessage() {}
 
-func (x *Video) ProtoReflect() protoreflect.Message {
+func (x *Review) ProtoReflect() protoreflect.Message {
 	mi := &file_video_video_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,140 +473,187 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Video.ProtoReflect.Descriptor instead.
-func (*Video) Descriptor() ([]byte, []int) {
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
 	return file_video_video_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Video) GetDmmId() string {
+func (x *Review) GetCount() int32 {
 	if x != nil {
-		return x.DmmId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *Video) GetTitle() string {
+func (x *Review) GetAverage() float32 {
 	if x != nil {
-		return x.Title
+		return x.Average
 	}
-	return ""
+	return 0
 }
 
-func (x *Video) GetDirectUrl() string {
-	if x != nil {
-		return x.DirectUrl
-	}
-	return ""
+// 配信形式（stream・download・hd・4k など）ごとの価格
+type DeliveryPrice struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Price           int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                            // 販売価格
+	ListPrice       int32                  `protobuf:"varint,3,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`                   // 定価（不明な場合は 0）
+	DiscountPercent int32                  `protobuf:"varint,4,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"` // 定価からの割引率（%、切り捨て）。割引がない場合は 0
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Video) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+func (x *DeliveryPrice) Reset() {
+	*x = DeliveryPrice{}
+	mi := &file_video_video_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Video) GetSampleUrl() string {
-	if x != nil {
-		return x.SampleUrl
-	}
-	return ""
+func (x *DeliveryPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Video) GetThumbnailUrl() string {
+func (*DeliveryPrice) ProtoMessage() {}
+
+func (x *DeliveryPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[7]
 	if x != nil {
-		return x.ThumbnailUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Video) GetCreatedAt() string {
+// Deprecated: Use DeliveryPrice.ProtoReflect.Descriptor instead.
+func (*DeliveryPrice) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeliveryPrice) GetType() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Video) GetPrice() int32 {
+func (x *DeliveryPrice) GetPrice() int32 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *Video) GetLikesCount() int32 {
+func (x *DeliveryPrice) GetListPrice() int32 {
 	if x != nil {
-		return x.LikesCount
+		return x.ListPrice
 	}
 	return 0
 }
 
-func (x *Video) GetActresses() []*Actress {
+func (x *DeliveryPrice) GetDiscountPercent() int32 {
 	if x != nil {
-		return x.Actresses
+		return x.DiscountPercent
 	}
-	return nil
+	return 0
 }
 
-func (x *Video) GetGenres() []*Genre {
+// 動画の価格。金額の単位は currency（ISO 4217）
+type Pricing struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Currency           string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Deliveries         []*DeliveryPrice       `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	OnSale             bool                   `protobuf:"varint,3,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`                                       // いずれかの配信形式が定価より安い
+	MaxDiscountPercent int32                  `protobuf:"varint,4,opt,name=max_discount_percent,json=maxDiscountPercent,proto3" json:"max_discount_percent,omitempty"` // 配信形式の中で最も大きい割引率
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Pricing) Reset() {
+	*x = Pricing{}
+	mi := &file_video_video_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pricing) ProtoMessage() {}
+
+func (x *Pricing) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[8]
 	if x != nil {
-		return x.Genres
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Video) GetMakers() []*Maker {
+// Deprecated: Use Pricing.ProtoReflect.Descriptor instead.
+func (*Pricing) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Pricing) GetCurrency() string {
 	if x != nil {
-		return x.Makers
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *Video) GetSeries() []*Series {
+func (x *Pricing) GetDeliveries() []*DeliveryPrice {
 	if x != nil {
-		return x.Series
+		return x.Deliveries
 	}
 	return nil
 }
 
-func (x *Video) GetDirectors() []*Director {
+func (x *Pricing) GetOnSale() bool {
 	if x != nil {
-		return x.Directors
+		return x.OnSale
 	}
-	return nil
+	return false
 }
 
-func (x *Video) GetReview() *Review {
+func (x *Pricing) GetMaxDiscountPercent() int32 {
 	if x != nil {
-		return x.Review
+		return x.MaxDiscountPercent
 	}
-	return nil
+	return 0
 }
 
-type GetVideosByDateRequest struct {
+// サンプル画像。大きい画像がない場合 large_url は空
+type SampleImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`      // Optional date filter
-	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`     // 取得件数（初期値：20、最大：100、省略可）
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // 検索開始位置（初期値：1、最大：50000、省略可）
+	SmallUrl      string                 `protobuf:"bytes,1,opt,name=small_url,json=smallUrl,proto3" json:"small_url,omitempty"`
+	LargeUrl      string                 `protobuf:"bytes,2,opt,name=large_url,json=largeUrl,proto3" json:"large_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByDateRequest) Reset() {
-	*x = GetVideosByDateRequest{}
-	mi := &file_video_video_proto_msgTypes[7]
+func (x *SampleImage) Reset() {
+	*x = SampleImage{}
+	mi := &file_video_video_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByDateRequest) String() string {
+func (x *SampleImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByDateRequest) ProtoMessage() {}
+func (*SampleImage) ProtoMessage() {}
 
-func (x *GetVideosByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[7]
+func (x *SampleImage) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,55 +664,51 @@ func (x *GetVideosByDateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByDateRequest.ProtoReflect.Descriptor instead.
-func (*GetVideosByDateRequest) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SampleImage.ProtoReflect.Descriptor instead.
+func (*SampleImage) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetVideosByDateRequest) GetDate() string {
+func (x *SampleImage) GetSmallUrl() string {
 	if x != nil {
-		return x.Date
+		return x.SmallUrl
 	}
 	return ""
 }
 
-func (x *GetVideosByDateRequest) GetHits() int32 {
-	if x != nil {
-		return x.Hits
-	}
-	return 0
-}
-
-func (x *GetVideosByDateRequest) GetOffset() int32 {
+func (x *SampleImage) GetLargeUrl() string {
 	if x != nil {
-		return x.Offset
+		return x.LargeUrl
 	}
-	return 0
+	return ""
 }
 
-type GetVideosByDateResponse struct {
+// サイズ別のサンプル動画 URL（存在しないサイズは空）
+type SampleMovies struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SmallUrl      string                 `protobuf:"bytes,1,opt,name=small_url,json=smallUrl,proto3" json:"small_url,omitempty"`    // 476x306
+	MediumUrl     string                 `protobuf:"bytes,2,opt,name=medium_url,json=mediumUrl,proto3" json:"medium_url,omitempty"` // 560x360
+	LargeUrl      string                 `protobuf:"bytes,3,opt,name=large_url,json=largeUrl,proto3" json:"large_url,omitempty"`    // 644x414
+	XlargeUrl     string                 `protobuf:"bytes,4,opt,name=xlarge_url,json=xlargeUrl,proto3" json:"xlarge_url,omitempty"` // 720x480
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByDateResponse) Reset() {
-	*x = GetVideosByDateResponse{}
-	mi := &file_video_video_proto_msgTypes[8]
+func (x *SampleMovies) Reset() {
+	*x = SampleMovies{}
+	mi := &file_video_video_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByDateResponse) String() string {
+func (x *SampleMovies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByDateResponse) ProtoMessage() {}
+func (*SampleMovies) ProtoMessage() {}
 
-func (x *GetVideosByDateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[8]
+func (x *SampleMovies) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,47 +719,64 @@ func (x *GetVideosByDateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByDateResponse.ProtoReflect.Descriptor instead.
-func (*GetVideosByDateResponse) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SampleMovies.ProtoReflect.Descriptor instead.
+func (*SampleMovies) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetVideosByDateResponse) GetVideos() []*Video {
+func (x *SampleMovies) GetSmallUrl() string {
 	if x != nil {
-		return x.Videos
+		return x.SmallUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVideosByDateResponse) GetMetadata() *SearchMetadata {
+func (x *SampleMovies) GetMediumUrl() string {
 	if x != nil {
-		return x.Metadata
+		return x.MediumUrl
 	}
-	return nil
+	return ""
 }
 
-type GetVideoByIdRequest struct {
+func (x *SampleMovies) GetLargeUrl() string {
+	if x != nil {
+		return x.LargeUrl
+	}
+	return ""
+}
+
+func (x *SampleMovies) GetXlargeUrl() string {
+	if x != nil {
+		return x.XlargeUrl
+	}
+	return ""
+}
+
+// 動画に適用されているキャンペーン
+type Campaign struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DmmId         string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	BeginAt       string                 `protobuf:"bytes,2,opt,name=begin_at,json=beginAt,proto3" json:"begin_at,omitempty"` // RFC3339
+	EndAt         string                 `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoByIdRequest) Reset() {
-	*x = GetVideoByIdRequest{}
-	mi := &file_video_video_proto_msgTypes[9]
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_video_video_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoByIdRequest) String() string {
+func (x *Campaign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoByIdRequest) ProtoMessage() {}
+func (*Campaign) ProtoMessage() {}
 
-func (x *GetVideoByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[9]
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,40 +787,922 @@ func (x *GetVideoByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetVideoByIdRequest) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetVideoByIdRequest) GetDmmId() string {
+func (x *Campaign) GetTitle() string {
 	if x != nil {
-		return x.DmmId
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Campaign) GetBeginAt() string {
+	if x != nil {
+		return x.BeginAt
+	}
+	return ""
+}
+
+func (x *Campaign) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+// Videoメッセージを重要度順に並び替え
+type Video struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DmmId          string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	DirectUrl      string                 `protobuf:"bytes,3,opt,name=direct_url,json=directUrl,proto3" json:"direct_url,omitempty"` // 解決できない場合や skip_direct_url 指定時は空
+	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	SampleUrl      string                 `protobuf:"bytes,5,opt,name=sample_url,json=sampleUrl,proto3" json:"sample_url,omitempty"`
+	ThumbnailUrl   string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Price          int32                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	LikesCount     int32                  `protobuf:"varint,9,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	Actresses      []*Actress             `protobuf:"bytes,10,rep,name=actresses,proto3" json:"actresses,omitempty"`
+	Genres         []*Genre               `protobuf:"bytes,11,rep,name=genres,proto3" json:"genres,omitempty"`
+	Makers         []*Maker               `protobuf:"bytes,12,rep,name=makers,proto3" json:"makers,omitempty"`
+	Series         []*Series              `protobuf:"bytes,13,rep,name=series,proto3" json:"series,omitempty"`
+	Directors      []*Director            `protobuf:"bytes,14,rep,name=directors,proto3" json:"directors,omitempty"`
+	Review         *Review                `protobuf:"bytes,15,opt,name=review,proto3" json:"review,omitempty"`                                        // レビュー情報
+	ProductId      string                 `protobuf:"bytes,16,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                 // 品番
+	MakerProduct   string                 `protobuf:"bytes,17,opt,name=maker_product,json=makerProduct,proto3" json:"maker_product,omitempty"`        // メーカー品番
+	RuntimeMinutes int32                  `protobuf:"varint,18,opt,name=runtime_minutes,json=runtimeMinutes,proto3" json:"runtime_minutes,omitempty"` // 収録時間（分、不明な場合は 0）
+	ListPrice      int32                  `protobuf:"varint,19,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`                // 定価（不明な場合は 0）
+	SampleImages   []*SampleImage         `protobuf:"bytes,21,rep,name=sample_images,json=sampleImages,proto3" json:"sample_images,omitempty"`
+	SampleMovies   *SampleMovies          `protobuf:"bytes,22,opt,name=sample_movies,json=sampleMovies,proto3" json:"sample_movies,omitempty"`
+	Campaigns      []*Campaign            `protobuf:"bytes,23,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Labels         []*Label               `protobuf:"bytes,24,rep,name=labels,proto3" json:"labels,omitempty"`
+	Pricing        *Pricing               `protobuf:"bytes,25,opt,name=pricing,proto3" json:"pricing,omitempty"` // 配信形式ごとの価格
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Video) Reset() {
+	*x = Video{}
+	mi := &file_video_video_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Video) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Video) ProtoMessage() {}
+
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Video) GetDmmId() string {
+	if x != nil {
+		return x.DmmId
+	}
+	return ""
+}
+
+func (x *Video) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Video) GetDirectUrl() string {
+	if x != nil {
+		return x.DirectUrl
+	}
+	return ""
+}
+
+func (x *Video) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Video) GetSampleUrl() string {
+	if x != nil {
+		return x.SampleUrl
+	}
+	return ""
+}
+
+func (x *Video) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Video) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Video) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Video) GetLikesCount() int32 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *Video) GetActresses() []*Actress {
+	if x != nil {
+		return x.Actresses
+	}
+	return nil
+}
+
+func (x *Video) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Video) GetMakers() []*Maker {
+	if x != nil {
+		return x.Makers
+	}
+	return nil
+}
+
+func (x *Video) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *Video) GetDirectors() []*Director {
+	if x != nil {
+		return x.Directors
+	}
+	return nil
+}
+
+func (x *Video) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *Video) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Video) GetMakerProduct() string {
+	if x != nil {
+		return x.MakerProduct
+	}
+	return ""
+}
+
+func (x *Video) GetRuntimeMinutes() int32 {
+	if x != nil {
+		return x.RuntimeMinutes
+	}
+	return 0
+}
+
+func (x *Video) GetListPrice() int32 {
+	if x != nil {
+		return x.ListPrice
+	}
+	return 0
+}
+
+func (x *Video) GetSampleImages() []*SampleImage {
+	if x != nil {
+		return x.SampleImages
+	}
+	return nil
+}
+
+func (x *Video) GetSampleMovies() *SampleMovies {
+	if x != nil {
+		return x.SampleMovies
+	}
+	return nil
+}
+
+func (x *Video) GetCampaigns() []*Campaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *Video) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Video) GetPricing() *Pricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type GetVideosByDateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                           // Optional date filter
+	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`                                          // 取得件数（初期値：20、最大：100、省略可）
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                                      // 検索開始位置（初期値：1、最大：50000、省略可）
+	SkipDirectUrl bool                   `protobuf:"varint,4,opt,name=skip_direct_url,json=skipDirectUrl,proto3" json:"skip_direct_url,omitempty"` // true の場合 direct_url を解決しない（ResolvePlaybackURLs で後から取得する、省略可）
+	Site          string                 `protobuf:"bytes,5,opt,name=site,proto3" json:"site,omitempty"`                                           // サイト（FANZA または DMM.com、省略可）
+	Service       string                 `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`                                     // サービス（例：digital、省略可）
+	Floor         string                 `protobuf:"bytes,7,opt,name=floor,proto3" json:"floor,omitempty"`                                         // フロア（例：videoa、省略可）
+	// 以下は DMM API にない条件のため、取得した 1 ページ内だけに適用する（省略可）。
+	// 返す件数は hits より少なくなることがあり、total_count は適用前の件数のまま。次のページは offset を hits だけ進めて取得する。
+	FilterPageOnSale   bool `protobuf:"varint,8,opt,name=filter_page_on_sale,json=filterPageOnSale,proto3" json:"filter_page_on_sale,omitempty"`       // true の場合、ページ内のセール中（pricing.on_sale）の動画だけを返す
+	SortPageByDiscount bool `protobuf:"varint,9,opt,name=sort_page_by_discount,json=sortPageByDiscount,proto3" json:"sort_page_by_discount,omitempty"` // true の場合、ページ内を割引率が高い順に並べ替える
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetVideosByDateRequest) Reset() {
+	*x = GetVideosByDateRequest{}
+	mi := &file_video_video_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosByDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosByDateRequest) ProtoMessage() {}
+
+func (x *GetVideosByDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosByDateRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosByDateRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetVideosByDateRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetVideosByDateRequest) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetVideosByDateRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetVideosByDateRequest) GetSkipDirectUrl() bool {
+	if x != nil {
+		return x.SkipDirectUrl
+	}
+	return false
+}
+
+func (x *GetVideosByDateRequest) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *GetVideosByDateRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetVideosByDateRequest) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *GetVideosByDateRequest) GetFilterPageOnSale() bool {
+	if x != nil {
+		return x.FilterPageOnSale
+	}
+	return false
+}
+
+func (x *GetVideosByDateRequest) GetSortPageByDiscount() bool {
+	if x != nil {
+		return x.SortPageByDiscount
+	}
+	return false
+}
+
+type GetVideosByDateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideosByDateResponse) Reset() {
+	*x = GetVideosByDateResponse{}
+	mi := &file_video_video_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosByDateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosByDateResponse) ProtoMessage() {}
+
+func (x *GetVideosByDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosByDateResponse.ProtoReflect.Descriptor instead.
+func (*GetVideosByDateResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetVideosByDateResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *GetVideosByDateResponse) GetMetadata() *SearchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetVideoByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DmmId         string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
+	Site          string                 `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`       // サイト（FANZA または DMM.com、省略可）
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"` // サービス（例：digital、省略可）
+	Floor         string                 `protobuf:"bytes,4,opt,name=floor,proto3" json:"floor,omitempty"`     // フロア（例：videoa、省略可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoByIdRequest) Reset() {
+	*x = GetVideoByIdRequest{}
+	mi := &file_video_video_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoByIdRequest) ProtoMessage() {}
+
+func (x *GetVideoByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoByIdRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetVideoByIdRequest) GetDmmId() string {
+	if x != nil {
+		return x.DmmId
+	}
+	return ""
+}
+
+func (x *GetVideoByIdRequest) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *GetVideoByIdRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetVideoByIdRequest) GetFloor() string {
+	if x != nil {
+		return x.Floor
 	}
 	return ""
 }
 
 type GetVideoByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoByIdResponse) Reset() {
+	*x = GetVideoByIdResponse{}
+	mi := &file_video_video_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoByIdResponse) ProtoMessage() {}
+
+func (x *GetVideoByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoByIdResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetVideoByIdResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+// ID指定による検索用メッセージ（すべてのフィールドはoptional）
+type GetVideosByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActressId     []string               `protobuf:"bytes,1,rep,name=actress_id,json=actressId,proto3" json:"actress_id,omitempty"`                 // 女優ID（複数指定可能、空でも可）
+	GenreId       []string               `protobuf:"bytes,2,rep,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`                       // ジャンルID（複数指定可能、空でも可）
+	MakerId       []string               `protobuf:"bytes,3,rep,name=maker_id,json=makerId,proto3" json:"maker_id,omitempty"`                       // メーカーID（複数指定可能、空でも可）
+	SeriesId      []string               `protobuf:"bytes,4,rep,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`                    // シリーズID（複数指定可能、空でも可）
+	DirectorId    []string               `protobuf:"bytes,5,rep,name=director_id,json=directorId,proto3" json:"director_id,omitempty"`              // 監督ID（複数指定可能、空でも可）
+	Hits          int32                  `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`                                           // 取得件数（初期値：20、最大：100、省略可）
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`                                       // 検索開始位置（初期値：1、最大：50000、省略可）
+	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`                                            // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順、省略可）
+	GteDate       string                 `protobuf:"bytes,9,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"`                       // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
+	LteDate       string                 `protobuf:"bytes,10,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"`                      // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
+	Site          string                 `protobuf:"bytes,11,opt,name=site,proto3" json:"site,omitempty"`                                           // サイト（FANZA または DMM.com、省略可）
+	Service       string                 `protobuf:"bytes,12,opt,name=service,proto3" json:"service,omitempty"`                                     // サービス（例：digital、省略可）
+	Floor         string                 `protobuf:"bytes,13,opt,name=floor,proto3" json:"floor,omitempty"`                                         // フロア（例：videoa、省略可）
+	SkipDirectUrl bool                   `protobuf:"varint,14,opt,name=skip_direct_url,json=skipDirectUrl,proto3" json:"skip_direct_url,omitempty"` // true の場合 direct_url を解決しない（ResolvePlaybackURLs で後から取得する、省略可）
+	// 以下は DMM API にない条件のため、取得した 1 ページ内だけに適用する（省略可）。
+	// 返す件数は hits より少なくなることがあり、total_count は適用前の件数のまま。次のページは offset を hits だけ進めて取得する。
+	FilterPageOnSale   bool `protobuf:"varint,15,opt,name=filter_page_on_sale,json=filterPageOnSale,proto3" json:"filter_page_on_sale,omitempty"`       // true の場合、ページ内のセール中（pricing.on_sale）の動画だけを返す
+	SortPageByDiscount bool `protobuf:"varint,16,opt,name=sort_page_by_discount,json=sortPageByDiscount,proto3" json:"sort_page_by_discount,omitempty"` // true の場合、ページ内を割引率が高い順に並べ替える
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetVideosByIDRequest) Reset() {
+	*x = GetVideosByIDRequest{}
+	mi := &file_video_video_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosByIDRequest) ProtoMessage() {}
+
+func (x *GetVideosByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosByIDRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetVideosByIDRequest) GetActressId() []string {
+	if x != nil {
+		return x.ActressId
+	}
+	return nil
+}
+
+func (x *GetVideosByIDRequest) GetGenreId() []string {
+	if x != nil {
+		return x.GenreId
+	}
+	return nil
+}
+
+func (x *GetVideosByIDRequest) GetMakerId() []string {
+	if x != nil {
+		return x.MakerId
+	}
+	return nil
+}
+
+func (x *GetVideosByIDRequest) GetSeriesId() []string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return nil
+}
+
+func (x *GetVideosByIDRequest) GetDirectorId() []string {
+	if x != nil {
+		return x.DirectorId
+	}
+	return nil
+}
+
+func (x *GetVideosByIDRequest) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetVideosByIDRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetVideosByIDRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetGteDate() string {
+	if x != nil {
+		return x.GteDate
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetLteDate() string {
+	if x != nil {
+		return x.LteDate
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *GetVideosByIDRequest) GetSkipDirectUrl() bool {
+	if x != nil {
+		return x.SkipDirectUrl
+	}
+	return false
+}
+
+func (x *GetVideosByIDRequest) GetFilterPageOnSale() bool {
+	if x != nil {
+		return x.FilterPageOnSale
+	}
+	return false
+}
+
+func (x *GetVideosByIDRequest) GetSortPageByDiscount() bool {
+	if x != nil {
+		return x.SortPageByDiscount
+	}
+	return false
+}
+
+// キーワードによる検索用メッセージ（すべてのフィールドはoptional）
+type GetVideosByKeywordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                                      // 検索キーワード（省略可）
+	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`                                           // 取得件数（初期値：20、最大：100、省略可）
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                                       // 検索開始位置（初期値：1、最大：50000、省略可）
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`                                            // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順、省略可）
+	GteDate       string                 `protobuf:"bytes,5,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"`                       // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
+	LteDate       string                 `protobuf:"bytes,6,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"`                       // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
+	Site          string                 `protobuf:"bytes,7,opt,name=site,proto3" json:"site,omitempty"`                                            // サイト（FANZA または DMM.com、省略可）
+	Service       string                 `protobuf:"bytes,8,opt,name=service,proto3" json:"service,omitempty"`                                      // サービス（例：digital、省略可）
+	Floor         string                 `protobuf:"bytes,9,opt,name=floor,proto3" json:"floor,omitempty"`                                          // フロア（例：videoa、省略可）
+	SkipDirectUrl bool                   `protobuf:"varint,10,opt,name=skip_direct_url,json=skipDirectUrl,proto3" json:"skip_direct_url,omitempty"` // true の場合 direct_url を解決しない（ResolvePlaybackURLs で後から取得する、省略可）
+	// 以下は DMM API にない条件のため、取得した 1 ページ内だけに適用する（省略可）。
+	// 返す件数は hits より少なくなることがあり、total_count は適用前の件数のまま。次のページは offset を hits だけ進めて取得する。
+	FilterPageOnSale   bool `protobuf:"varint,11,opt,name=filter_page_on_sale,json=filterPageOnSale,proto3" json:"filter_page_on_sale,omitempty"`       // true の場合、ページ内のセール中（pricing.on_sale）の動画だけを返す
+	SortPageByDiscount bool `protobuf:"varint,12,opt,name=sort_page_by_discount,json=sortPageByDiscount,proto3" json:"sort_page_by_discount,omitempty"` // true の場合、ページ内を割引率が高い順に並べ替える
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetVideosByKeywordRequest) Reset() {
+	*x = GetVideosByKeywordRequest{}
+	mi := &file_video_video_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosByKeywordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosByKeywordRequest) ProtoMessage() {}
+
+func (x *GetVideosByKeywordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosByKeywordRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosByKeywordRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetVideosByKeywordRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetVideosByKeywordRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetVideosByKeywordRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetGteDate() string {
+	if x != nil {
+		return x.GteDate
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetLteDate() string {
+	if x != nil {
+		return x.LteDate
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *GetVideosByKeywordRequest) GetSkipDirectUrl() bool {
+	if x != nil {
+		return x.SkipDirectUrl
+	}
+	return false
+}
+
+func (x *GetVideosByKeywordRequest) GetFilterPageOnSale() bool {
+	if x != nil {
+		return x.FilterPageOnSale
+	}
+	return false
+}
+
+func (x *GetVideosByKeywordRequest) GetSortPageByDiscount() bool {
+	if x != nil {
+		return x.SortPageByDiscount
+	}
+	return false
+}
+
+// 検索結果のメタデータ
+type SearchMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultCount   int32                  `protobuf:"varint,1,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`       // 取得件数
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`          // 全体件数
+	FirstPosition int32                  `protobuf:"varint,3,opt,name=first_position,json=firstPosition,proto3" json:"first_position,omitempty"` // 検索開始位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMetadata) Reset() {
+	*x = SearchMetadata{}
+	mi := &file_video_video_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMetadata) ProtoMessage() {}
+
+func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMetadata.ProtoReflect.Descriptor instead.
+func (*SearchMetadata) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchMetadata) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetFirstPosition() int32 {
+	if x != nil {
+		return x.FirstPosition
+	}
+	return 0
+}
+
+// 検索結果レスポンス
+type GetVideosByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoByIdResponse) Reset() {
-	*x = GetVideoByIdResponse{}
-	mi := &file_video_video_proto_msgTypes[10]
+func (x *GetVideosByIDResponse) Reset() {
+	*x = GetVideosByIDResponse{}
+	mi := &file_video_video_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoByIdResponse) String() string {
+func (x *GetVideosByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoByIdResponse) ProtoMessage() {}
+func (*GetVideosByIDResponse) ProtoMessage() {}
 
-func (x *GetVideoByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[10]
+func (x *GetVideosByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,53 +1713,49 @@ func (x *GetVideoByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetVideoByIdResponse) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetVideosByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVideosByIDResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetVideoByIdResponse) GetVideo() *Video {
+func (x *GetVideosByIDResponse) GetVideos() []*Video {
 	if x != nil {
-		return x.Video
+		return x.Videos
 	}
 	return nil
 }
 
-// ID指定による検索用メッセージ（すべてのフィールドはoptional）
-type GetVideosByIDRequest struct {
+func (x *GetVideosByIDResponse) GetMetadata() *SearchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// 検索結果レスポンス
+type GetVideosByKeywordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActressId     []string               `protobuf:"bytes,1,rep,name=actress_id,json=actressId,proto3" json:"actress_id,omitempty"`    // 女優ID（複数指定可能、空でも可）
-	GenreId       []string               `protobuf:"bytes,2,rep,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`          // ジャンルID（複数指定可能、空でも可）
-	MakerId       []string               `protobuf:"bytes,3,rep,name=maker_id,json=makerId,proto3" json:"maker_id,omitempty"`          // メーカーID（複数指定可能、空でも可）
-	SeriesId      []string               `protobuf:"bytes,4,rep,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`       // シリーズID（複数指定可能、空でも可）
-	DirectorId    []string               `protobuf:"bytes,5,rep,name=director_id,json=directorId,proto3" json:"director_id,omitempty"` // 監督ID（複数指定可能、空でも可）
-	Hits          int32                  `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`                              // 取得件数（初期値：20、最大：100、省略可）
-	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`                          // 検索開始位置（初期値：1、最大：50000、省略可）
-	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`                               // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順、省略可）
-	GteDate       string                 `protobuf:"bytes,9,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"`          // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
-	LteDate       string                 `protobuf:"bytes,10,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"`         // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
-	Site          string                 `protobuf:"bytes,11,opt,name=site,proto3" json:"site,omitempty"`                              // サイト（FANZA または DMM.com、省略可）
-	Service       string                 `protobuf:"bytes,12,opt,name=service,proto3" json:"service,omitempty"`                        // サービス（例：digital、省略可）
-	Floor         string                 `protobuf:"bytes,13,opt,name=floor,proto3" json:"floor,omitempty"`                            // フロア（例：videoa、省略可）
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByIDRequest) Reset() {
-	*x = GetVideosByIDRequest{}
-	mi := &file_video_video_proto_msgTypes[11]
+func (x *GetVideosByKeywordResponse) Reset() {
+	*x = GetVideosByKeywordResponse{}
+	mi := &file_video_video_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByIDRequest) String() string {
+func (x *GetVideosByKeywordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByIDRequest) ProtoMessage() {}
+func (*GetVideosByKeywordResponse) ProtoMessage() {}
 
-func (x *GetVideosByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[11]
+func (x *GetVideosByKeywordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,133 +1766,223 @@ func (x *GetVideosByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetVideosByIDRequest) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetVideosByKeywordResponse.ProtoReflect.Descriptor instead.
+func (*GetVideosByKeywordResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetVideosByIDRequest) GetActressId() []string {
+func (x *GetVideosByKeywordResponse) GetVideos() []*Video {
 	if x != nil {
-		return x.ActressId
+		return x.Videos
 	}
 	return nil
 }
 
-func (x *GetVideosByIDRequest) GetGenreId() []string {
+func (x *GetVideosByKeywordResponse) GetMetadata() *SearchMetadata {
 	if x != nil {
-		return x.GenreId
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *GetVideosByIDRequest) GetMakerId() []string {
+// 後方互換性のための検索用メッセージ（DMM API v3 パラメータに基づく）
+type SearchVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                                      // Optional keyword
+	ActressId     string                 `protobuf:"bytes,2,opt,name=actress_id,json=actressId,proto3" json:"actress_id,omitempty"`                 // 女優ID
+	GenreId       string                 `protobuf:"bytes,3,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`                       // ジャンルID
+	MakerId       string                 `protobuf:"bytes,4,opt,name=maker_id,json=makerId,proto3" json:"maker_id,omitempty"`                       // メーカーID
+	SeriesId      string                 `protobuf:"bytes,5,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`                    // シリーズID
+	DirectorId    string                 `protobuf:"bytes,6,opt,name=director_id,json=directorId,proto3" json:"director_id,omitempty"`              // 監督ID
+	Hits          int32                  `protobuf:"varint,7,opt,name=hits,proto3" json:"hits,omitempty"`                                           // 取得件数（初期値：20、最大：100）
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                                       // 検索開始位置（初期値：1、最大：50000）
+	Sort          string                 `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`                                            // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順）
+	GteDate       string                 `protobuf:"bytes,10,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"`                      // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00）
+	LteDate       string                 `protobuf:"bytes,11,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"`                      // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00）
+	Site          string                 `protobuf:"bytes,12,opt,name=site,proto3" json:"site,omitempty"`                                           // サイト（FANZA または DMM.com）
+	Service       string                 `protobuf:"bytes,13,opt,name=service,proto3" json:"service,omitempty"`                                     // サービス（例：digital）
+	Floor         string                 `protobuf:"bytes,14,opt,name=floor,proto3" json:"floor,omitempty"`                                         // フロア（例：videoa）
+	SkipDirectUrl bool                   `protobuf:"varint,15,opt,name=skip_direct_url,json=skipDirectUrl,proto3" json:"skip_direct_url,omitempty"` // true の場合 direct_url を解決しない（ResolvePlaybackURLs で後から取得する）
+	// 以下は DMM API にない条件のため、取得した 1 ページ内だけに適用する。
+	// 返す件数は hits より少なくなることがあり、total_count は適用前の件数のまま。次のページは offset を hits だけ進めて取得する。
+	FilterPageOnSale   bool `protobuf:"varint,16,opt,name=filter_page_on_sale,json=filterPageOnSale,proto3" json:"filter_page_on_sale,omitempty"`       // true の場合、ページ内のセール中（pricing.on_sale）の動画だけを返す
+	SortPageByDiscount bool `protobuf:"varint,17,opt,name=sort_page_by_discount,json=sortPageByDiscount,proto3" json:"sort_page_by_discount,omitempty"` // true の場合、ページ内を割引率が高い順に並べ替える
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchVideosRequest) Reset() {
+	*x = SearchVideosRequest{}
+	mi := &file_video_video_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideosRequest) ProtoMessage() {}
+
+func (x *SearchVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideosRequest.ProtoReflect.Descriptor instead.
+func (*SearchVideosRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchVideosRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetActressId() string {
+	if x != nil {
+		return x.ActressId
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetGenreId() string {
+	if x != nil {
+		return x.GenreId
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetMakerId() string {
 	if x != nil {
 		return x.MakerId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVideosByIDRequest) GetSeriesId() []string {
+func (x *SearchVideosRequest) GetSeriesId() string {
 	if x != nil {
 		return x.SeriesId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVideosByIDRequest) GetDirectorId() []string {
+func (x *SearchVideosRequest) GetDirectorId() string {
 	if x != nil {
 		return x.DirectorId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVideosByIDRequest) GetHits() int32 {
+func (x *SearchVideosRequest) GetHits() int32 {
 	if x != nil {
 		return x.Hits
 	}
 	return 0
 }
 
-func (x *GetVideosByIDRequest) GetOffset() int32 {
+func (x *SearchVideosRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-func (x *GetVideosByIDRequest) GetSort() string {
+func (x *SearchVideosRequest) GetSort() string {
 	if x != nil {
 		return x.Sort
 	}
 	return ""
 }
 
-func (x *GetVideosByIDRequest) GetGteDate() string {
+func (x *SearchVideosRequest) GetGteDate() string {
 	if x != nil {
 		return x.GteDate
 	}
 	return ""
 }
 
-func (x *GetVideosByIDRequest) GetLteDate() string {
+func (x *SearchVideosRequest) GetLteDate() string {
 	if x != nil {
 		return x.LteDate
 	}
 	return ""
 }
 
-func (x *GetVideosByIDRequest) GetSite() string {
+func (x *SearchVideosRequest) GetSite() string {
 	if x != nil {
 		return x.Site
 	}
 	return ""
 }
 
-func (x *GetVideosByIDRequest) GetService() string {
+func (x *SearchVideosRequest) GetService() string {
 	if x != nil {
 		return x.Service
 	}
 	return ""
 }
 
-func (x *GetVideosByIDRequest) GetFloor() string {
+func (x *SearchVideosRequest) GetFloor() string {
 	if x != nil {
 		return x.Floor
 	}
 	return ""
 }
 
-// キーワードによる検索用メッセージ（すべてのフィールドはoptional）
-type GetVideosByKeywordRequest struct {
+func (x *SearchVideosRequest) GetSkipDirectUrl() bool {
+	if x != nil {
+		return x.SkipDirectUrl
+	}
+	return false
+}
+
+func (x *SearchVideosRequest) GetFilterPageOnSale() bool {
+	if x != nil {
+		return x.FilterPageOnSale
+	}
+	return false
+}
+
+func (x *SearchVideosRequest) GetSortPageByDiscount() bool {
+	if x != nil {
+		return x.SortPageByDiscount
+	}
+	return false
+}
+
+type SearchVideosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                // 検索キーワード（省略可）
-	Hits          int32                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`                     // 取得件数（初期値：20、最大：100、省略可）
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                 // 検索開始位置（初期値：1、最大：50000、省略可）
-	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`                      // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順、省略可）
-	GteDate       string                 `protobuf:"bytes,5,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"` // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
-	LteDate       string                 `protobuf:"bytes,6,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"` // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00、省略可）
-	Site          string                 `protobuf:"bytes,7,opt,name=site,proto3" json:"site,omitempty"`                      // サイト（FANZA または DMM.com、省略可）
-	Service       string                 `protobuf:"bytes,8,opt,name=service,proto3" json:"service,omitempty"`                // サービス（例：digital、省略可）
-	Floor         string                 `protobuf:"bytes,9,opt,name=floor,proto3" json:"floor,omitempty"`                    // フロア（例：videoa、省略可）
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByKeywordRequest) Reset() {
-	*x = GetVideosByKeywordRequest{}
-	mi := &file_video_video_proto_msgTypes[12]
+func (x *SearchVideosResponse) Reset() {
+	*x = SearchVideosResponse{}
+	mi := &file_video_video_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByKeywordRequest) String() string {
+func (x *SearchVideosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByKeywordRequest) ProtoMessage() {}
+func (*SearchVideosResponse) ProtoMessage() {}
 
-func (x *GetVideosByKeywordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[12]
+func (x *SearchVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,99 +1993,108 @@ func (x *GetVideosByKeywordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByKeywordRequest.ProtoReflect.Descriptor instead.
-func (*GetVideosByKeywordRequest) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchVideosResponse.ProtoReflect.Descriptor instead.
+func (*SearchVideosResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetVideosByKeywordRequest) GetKeyword() string {
+func (x *SearchVideosResponse) GetVideos() []*Video {
 	if x != nil {
-		return x.Keyword
+		return x.Videos
 	}
-	return ""
+	return nil
 }
 
-func (x *GetVideosByKeywordRequest) GetHits() int32 {
+func (x *SearchVideosResponse) GetMetadata() *SearchMetadata {
 	if x != nil {
-		return x.Hits
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-func (x *GetVideosByKeywordRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+type PlaybackURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DmmId         string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
+	DirectUrl     string                 `protobuf:"bytes,2,opt,name=direct_url,json=directUrl,proto3" json:"direct_url,omitempty"` // status が RESOLVED の場合のみ設定
+	Status        PlaybackURLStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=video.PlaybackURLStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByKeywordRequest) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
+func (x *PlaybackURL) Reset() {
+	*x = PlaybackURL{}
+	mi := &file_video_video_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetVideosByKeywordRequest) GetGteDate() string {
+func (*PlaybackURL) ProtoMessage() {}
+
+func (x *PlaybackURL) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[24]
 	if x != nil {
-		return x.GteDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetVideosByKeywordRequest) GetLteDate() string {
-	if x != nil {
-		return x.LteDate
-	}
-	return ""
+// Deprecated: Use PlaybackURL.ProtoReflect.Descriptor instead.
+func (*PlaybackURL) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetVideosByKeywordRequest) GetSite() string {
+func (x *PlaybackURL) GetDmmId() string {
 	if x != nil {
-		return x.Site
+		return x.DmmId
 	}
 	return ""
 }
 
-func (x *GetVideosByKeywordRequest) GetService() string {
+func (x *PlaybackURL) GetDirectUrl() string {
 	if x != nil {
-		return x.Service
+		return x.DirectUrl
 	}
 	return ""
 }
 
-func (x *GetVideosByKeywordRequest) GetFloor() string {
+func (x *PlaybackURL) GetStatus() PlaybackURLStatus {
 	if x != nil {
-		return x.Floor
+		return x.Status
 	}
-	return ""
+	return PlaybackURLStatus_PLAYBACK_URL_STATUS_UNSPECIFIED
 }
 
-// 検索結果のメタデータ
-type SearchMetadata struct {
+// 再生URLの解決用メッセージ
+type ResolvePlaybackURLsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResultCount   int32                  `protobuf:"varint,1,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`       // 取得件数
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`          // 全体件数
-	FirstPosition int32                  `protobuf:"varint,3,opt,name=first_position,json=firstPosition,proto3" json:"first_position,omitempty"` // 検索開始位置
+	DmmIds        []string               `protobuf:"bytes,1,rep,name=dmm_ids,json=dmmIds,proto3" json:"dmm_ids,omitempty"` // 解決する動画ID（英小文字・数字・_ のみ、最大：100）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchMetadata) Reset() {
-	*x = SearchMetadata{}
-	mi := &file_video_video_proto_msgTypes[13]
+func (x *ResolvePlaybackURLsRequest) Reset() {
+	*x = ResolvePlaybackURLsRequest{}
+	mi := &file_video_video_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchMetadata) String() string {
+func (x *ResolvePlaybackURLsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMetadata) ProtoMessage() {}
+func (*ResolvePlaybackURLsRequest) ProtoMessage() {}
 
-func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[13]
+func (x *ResolvePlaybackURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,56 +2105,40 @@ func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMetadata.ProtoReflect.Descriptor instead.
-func (*SearchMetadata) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *SearchMetadata) GetResultCount() int32 {
-	if x != nil {
-		return x.ResultCount
-	}
-	return 0
-}
-
-func (x *SearchMetadata) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+// Deprecated: Use ResolvePlaybackURLsRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePlaybackURLsRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SearchMetadata) GetFirstPosition() int32 {
+func (x *ResolvePlaybackURLsRequest) GetDmmIds() []string {
 	if x != nil {
-		return x.FirstPosition
+		return x.DmmIds
 	}
-	return 0
+	return nil
 }
 
-// 検索結果レスポンス
-type GetVideosByIDResponse struct {
+type ResolvePlaybackURLsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Urls          []*PlaybackURL         `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"` // リクエストの dmm_ids と同じ順序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByIDResponse) Reset() {
-	*x = GetVideosByIDResponse{}
-	mi := &file_video_video_proto_msgTypes[14]
+func (x *ResolvePlaybackURLsResponse) Reset() {
+	*x = ResolvePlaybackURLsResponse{}
+	mi := &file_video_video_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByIDResponse) String() string {
+func (x *ResolvePlaybackURLsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByIDResponse) ProtoMessage() {}
+func (*ResolvePlaybackURLsResponse) ProtoMessage() {}
 
-func (x *GetVideosByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[14]
+func (x *ResolvePlaybackURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,49 +2149,42 @@ func (x *GetVideosByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetVideosByIDResponse) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetVideosByIDResponse) GetVideos() []*Video {
-	if x != nil {
-		return x.Videos
-	}
-	return nil
+// Deprecated: Use ResolvePlaybackURLsResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePlaybackURLsResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetVideosByIDResponse) GetMetadata() *SearchMetadata {
+func (x *ResolvePlaybackURLsResponse) GetUrls() []*PlaybackURL {
 	if x != nil {
-		return x.Metadata
+		return x.Urls
 	}
 	return nil
 }
 
-// 検索結果レスポンス
-type GetVideosByKeywordResponse struct {
+type VideoLookup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DmmId         string                 `protobuf:"bytes,1,opt,name=dmm_id,json=dmmId,proto3" json:"dmm_id,omitempty"`
+	Status        VideoLookupStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=video.VideoLookupStatus" json:"status,omitempty"`
+	Video         *Video                 `protobuf:"bytes,3,opt,name=video,proto3" json:"video,omitempty"` // status が FOUND の場合のみ設定
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideosByKeywordResponse) Reset() {
-	*x = GetVideosByKeywordResponse{}
-	mi := &file_video_video_proto_msgTypes[15]
+func (x *VideoLookup) Reset() {
+	*x = VideoLookup{}
+	mi := &file_video_video_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideosByKeywordResponse) String() string {
+func (x *VideoLookup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideosByKeywordResponse) ProtoMessage() {}
+func (*VideoLookup) ProtoMessage() {}
 
-func (x *GetVideosByKeywordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[15]
+func (x *VideoLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,61 +2195,59 @@ func (x *GetVideosByKeywordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideosByKeywordResponse.ProtoReflect.Descriptor instead.
-func (*GetVideosByKeywordResponse) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use VideoLookup.ProtoReflect.Descriptor instead.
+func (*VideoLookup) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetVideosByKeywordResponse) GetVideos() []*Video {
+func (x *VideoLookup) GetDmmId() string {
 	if x != nil {
-		return x.Videos
+		return x.DmmId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVideosByKeywordResponse) GetMetadata() *SearchMetadata {
+func (x *VideoLookup) GetStatus() VideoLookupStatus {
 	if x != nil {
-		return x.Metadata
+		return x.Status
+	}
+	return VideoLookupStatus_VIDEO_LOOKUP_STATUS_UNSPECIFIED
+}
+
+func (x *VideoLookup) GetVideo() *Video {
+	if x != nil {
+		return x.Video
 	}
 	return nil
 }
 
-// 後方互換性のための検索用メッセージ（DMM API v3 パラメータに基づく）
-type SearchVideosRequest struct {
+// 複数IDによる一括取得用メッセージ
+type GetVideosByDmmIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                         // Optional keyword
-	ActressId     string                 `protobuf:"bytes,2,opt,name=actress_id,json=actressId,proto3" json:"actress_id,omitempty"`    // 女優ID
-	GenreId       string                 `protobuf:"bytes,3,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`          // ジャンルID
-	MakerId       string                 `protobuf:"bytes,4,opt,name=maker_id,json=makerId,proto3" json:"maker_id,omitempty"`          // メーカーID
-	SeriesId      string                 `protobuf:"bytes,5,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`       // シリーズID
-	DirectorId    string                 `protobuf:"bytes,6,opt,name=director_id,json=directorId,proto3" json:"director_id,omitempty"` // 監督ID
-	Hits          int32                  `protobuf:"varint,7,opt,name=hits,proto3" json:"hits,omitempty"`                              // 取得件数（初期値：20、最大：100）
-	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                          // 検索開始位置（初期値：1、最大：50000）
-	Sort          string                 `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`                               // ソート順（rank：人気順、price：価格が高い順、-price：価格が安い順、date：発売日順、review：評価順、match：マッチング順）
-	GteDate       string                 `protobuf:"bytes,10,opt,name=gte_date,json=gteDate,proto3" json:"gte_date,omitempty"`         // 発売日絞り込み（この日付以降、ISO8601形式 YYYY-MM-DDT00:00:00）
-	LteDate       string                 `protobuf:"bytes,11,opt,name=lte_date,json=lteDate,proto3" json:"lte_date,omitempty"`         // 発売日絞り込み（この日付以前、ISO8601形式 YYYY-MM-DDT00:00:00）
-	Site          string                 `protobuf:"bytes,12,opt,name=site,proto3" json:"site,omitempty"`                              // サイト（FANZA または DMM.com）
-	Service       string                 `protobuf:"bytes,13,opt,name=service,proto3" json:"service,omitempty"`                        // サービス（例：digital）
-	Floor         string                 `protobuf:"bytes,14,opt,name=floor,proto3" json:"floor,omitempty"`                            // フロア（例：videoa）
+	DmmIds        []string               `protobuf:"bytes,1,rep,name=dmm_ids,json=dmmIds,proto3" json:"dmm_ids,omitempty"`                         // 取得する動画ID（英小文字・数字・_ のみ、最大：100）
+	Site          string                 `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`                                           // サイト（FANZA または DMM.com、省略可）
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`                                     // サービス（例：digital、省略可）
+	Floor         string                 `protobuf:"bytes,4,opt,name=floor,proto3" json:"floor,omitempty"`                                         // フロア（例：videoa、省略可）
+	SkipDirectUrl bool                   `protobuf:"varint,5,opt,name=skip_direct_url,json=skipDirectUrl,proto3" json:"skip_direct_url,omitempty"` // true の場合 direct_url を解決しない（ResolvePlaybackURLs で後から取得する、省略可）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchVideosRequest) Reset() {
-	*x = SearchVideosRequest{}
-	mi := &file_video_video_proto_msgTypes[16]
+func (x *GetVideosByDmmIdsRequest) Reset() {
+	*x = GetVideosByDmmIdsRequest{}
+	mi := &file_video_video_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchVideosRequest) String() string {
+func (x *GetVideosByDmmIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchVideosRequest) ProtoMessage() {}
+func (*GetVideosByDmmIdsRequest) ProtoMessage() {}
 
-func (x *SearchVideosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[16]
+func (x *GetVideosByDmmIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,132 +2258,204 @@ func (x *SearchVideosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchVideosRequest.ProtoReflect.Descriptor instead.
-func (*SearchVideosRequest) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetVideosByDmmIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosByDmmIdsRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SearchVideosRequest) GetKeyword() string {
+func (x *GetVideosByDmmIdsRequest) GetDmmIds() []string {
 	if x != nil {
-		return x.Keyword
+		return x.DmmIds
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchVideosRequest) GetActressId() string {
+func (x *GetVideosByDmmIdsRequest) GetSite() string {
 	if x != nil {
-		return x.ActressId
+		return x.Site
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetGenreId() string {
+func (x *GetVideosByDmmIdsRequest) GetService() string {
 	if x != nil {
-		return x.GenreId
+		return x.Service
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetMakerId() string {
+func (x *GetVideosByDmmIdsRequest) GetFloor() string {
 	if x != nil {
-		return x.MakerId
+		return x.Floor
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetSeriesId() string {
+func (x *GetVideosByDmmIdsRequest) GetSkipDirectUrl() bool {
 	if x != nil {
-		return x.SeriesId
+		return x.SkipDirectUrl
 	}
-	return ""
+	return false
 }
 
-func (x *SearchVideosRequest) GetDirectorId() string {
+type GetVideosByDmmIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*VideoLookup         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // リクエストの dmm_ids と同じ順序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideosByDmmIdsResponse) Reset() {
+	*x = GetVideosByDmmIdsResponse{}
+	mi := &file_video_video_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideosByDmmIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosByDmmIdsResponse) ProtoMessage() {}
+
+func (x *GetVideosByDmmIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[29]
 	if x != nil {
-		return x.DirectorId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SearchVideosRequest) GetHits() int32 {
+// Deprecated: Use GetVideosByDmmIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetVideosByDmmIdsResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetVideosByDmmIdsResponse) GetResults() []*VideoLookup {
 	if x != nil {
-		return x.Hits
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchVideosRequest) GetOffset() int32 {
+// フロア情報（site_code・service_code・floor_code を各検索リクエストの site・service・floor に指定する）
+type Floor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteName      string                 `protobuf:"bytes,1,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	SiteCode      string                 `protobuf:"bytes,2,opt,name=site_code,json=siteCode,proto3" json:"site_code,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceCode   string                 `protobuf:"bytes,4,opt,name=service_code,json=serviceCode,proto3" json:"service_code,omitempty"`
+	FloorId       string                 `protobuf:"bytes,5,opt,name=floor_id,json=floorId,proto3" json:"floor_id,omitempty"`
+	FloorName     string                 `protobuf:"bytes,6,opt,name=floor_name,json=floorName,proto3" json:"floor_name,omitempty"`
+	FloorCode     string                 `protobuf:"bytes,7,opt,name=floor_code,json=floorCode,proto3" json:"floor_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Floor) Reset() {
+	*x = Floor{}
+	mi := &file_video_video_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Floor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Floor) ProtoMessage() {}
+
+func (x *Floor) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[30]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SearchVideosRequest) GetSort() string {
+// Deprecated: Use Floor.ProtoReflect.Descriptor instead.
+func (*Floor) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Floor) GetSiteName() string {
 	if x != nil {
-		return x.Sort
+		return x.SiteName
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetGteDate() string {
+func (x *Floor) GetSiteCode() string {
 	if x != nil {
-		return x.GteDate
+		return x.SiteCode
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetLteDate() string {
+func (x *Floor) GetServiceName() string {
 	if x != nil {
-		return x.LteDate
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetSite() string {
+func (x *Floor) GetServiceCode() string {
 	if x != nil {
-		return x.Site
+		return x.ServiceCode
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetService() string {
+func (x *Floor) GetFloorId() string {
 	if x != nil {
-		return x.Service
+		return x.FloorId
 	}
 	return ""
 }
 
-func (x *SearchVideosRequest) GetFloor() string {
+func (x *Floor) GetFloorName() string {
 	if x != nil {
-		return x.Floor
+		return x.FloorName
 	}
 	return ""
 }
 
-type SearchVideosResponse struct {
+func (x *Floor) GetFloorCode() string {
+	if x != nil {
+		return x.FloorCode
+	}
+	return ""
+}
+
+type ListFloorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Metadata      *SearchMetadata        `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchVideosResponse) Reset() {
-	*x = SearchVideosResponse{}
-	mi := &file_video_video_proto_msgTypes[17]
+func (x *ListFloorsRequest) Reset() {
+	*x = ListFloorsRequest{}
+	mi := &file_video_video_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchVideosResponse) String() string {
+func (x *ListFloorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchVideosResponse) ProtoMessage() {}
+func (*ListFloorsRequest) ProtoMessage() {}
 
-func (x *SearchVideosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_video_video_proto_msgTypes[17]
+func (x *ListFloorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,21 +2466,51 @@ func (x *SearchVideosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchVideosResponse.ProtoReflect.Descriptor instead.
-func (*SearchVideosResponse) Descriptor() ([]byte, []int) {
-	return file_video_video_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListFloorsRequest.ProtoReflect.Descriptor instead.
+func (*ListFloorsRequest) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SearchVideosResponse) GetVideos() []*Video {
+type ListFloorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Floors        []*Floor               `protobuf:"bytes,1,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloorsResponse) Reset() {
+	*x = ListFloorsResponse{}
+	mi := &file_video_video_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloorsResponse) ProtoMessage() {}
+
+func (x *ListFloorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_video_video_proto_msgTypes[32]
 	if x != nil {
-		return x.Videos
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SearchVideosResponse) GetMetadata() *SearchMetadata {
+// Deprecated: Use ListFloorsResponse.ProtoReflect.Descriptor instead.
+func (*ListFloorsResponse) Descriptor() ([]byte, []int) {
+	return file_video_video_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListFloorsResponse) GetFloors() []*Floor {
 	if x != nil {
-		return x.Metadata
+		return x.Floors
 	}
 	return nil
 }
@@ -1329,10 +2534,40 @@ const file_video_video_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\".\n" +
 	"\bDirector\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"+\n" +
+	"\x05Label\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"8\n" +
 	"\x06Review\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x18\n" +
-	"\aaverage\x18\x02 \x01(\x02R\aaverage\"\xf6\x03\n" +
+	"\aaverage\x18\x02 \x01(\x02R\aaverage\"\x83\x01\n" +
+	"\rDeliveryPrice\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x1d\n" +
+	"\n" +
+	"list_price\x18\x03 \x01(\x05R\tlistPrice\x12)\n" +
+	"\x10discount_percent\x18\x04 \x01(\x05R\x0fdiscountPercent\"\xa6\x01\n" +
+	"\aPricing\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x124\n" +
+	"\n" +
+	"deliveries\x18\x02 \x03(\v2\x14.video.DeliveryPriceR\n" +
+	"deliveries\x12\x17\n" +
+	"\aon_sale\x18\x03 \x01(\bR\x06onSale\x120\n" +
+	"\x14max_discount_percent\x18\x04 \x01(\x05R\x12maxDiscountPercent\"G\n" +
+	"\vSampleImage\x12\x1b\n" +
+	"\tsmall_url\x18\x01 \x01(\tR\bsmallUrl\x12\x1b\n" +
+	"\tlarge_url\x18\x02 \x01(\tR\blargeUrl\"\x86\x01\n" +
+	"\fSampleMovies\x12\x1b\n" +
+	"\tsmall_url\x18\x01 \x01(\tR\bsmallUrl\x12\x1d\n" +
+	"\n" +
+	"medium_url\x18\x02 \x01(\tR\tmediumUrl\x12\x1b\n" +
+	"\tlarge_url\x18\x03 \x01(\tR\blargeUrl\x12\x1d\n" +
+	"\n" +
+	"xlarge_url\x18\x04 \x01(\tR\txlargeUrl\"R\n" +
+	"\bCampaign\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x19\n" +
+	"\bbegin_at\x18\x02 \x01(\tR\abeginAt\x12\x15\n" +
+	"\x06end_at\x18\x03 \x01(\tR\x05endAt\"\xf4\x06\n" +
 	"\x05Video\x12\x15\n" +
 	"\x06dmm_id\x18\x01 \x01(\tR\x05dmmId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
@@ -1353,18 +2588,38 @@ const file_video_video_proto_rawDesc = "" +
 	"\x06makers\x18\f \x03(\v2\f.video.MakerR\x06makers\x12%\n" +
 	"\x06series\x18\r \x03(\v2\r.video.SeriesR\x06series\x12-\n" +
 	"\tdirectors\x18\x0e \x03(\v2\x0f.video.DirectorR\tdirectors\x12%\n" +
-	"\x06review\x18\x0f \x01(\v2\r.video.ReviewR\x06review\"X\n" +
+	"\x06review\x18\x0f \x01(\v2\r.video.ReviewR\x06review\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x10 \x01(\tR\tproductId\x12#\n" +
+	"\rmaker_product\x18\x11 \x01(\tR\fmakerProduct\x12'\n" +
+	"\x0fruntime_minutes\x18\x12 \x01(\x05R\x0eruntimeMinutes\x12\x1d\n" +
+	"\n" +
+	"list_price\x18\x13 \x01(\x05R\tlistPrice\x127\n" +
+	"\rsample_images\x18\x15 \x03(\v2\x12.video.SampleImageR\fsampleImages\x128\n" +
+	"\rsample_movies\x18\x16 \x01(\v2\x13.video.SampleMoviesR\fsampleMovies\x12-\n" +
+	"\tcampaigns\x18\x17 \x03(\v2\x0f.video.CampaignR\tcampaigns\x12$\n" +
+	"\x06labels\x18\x18 \x03(\v2\f.video.LabelR\x06labels\x12(\n" +
+	"\apricing\x18\x19 \x01(\v2\x0e.video.PricingR\apricing\"\xa6\x02\n" +
 	"\x16GetVideosByDateRequest\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
 	"\x04hits\x18\x02 \x01(\x05R\x04hits\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"r\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12&\n" +
+	"\x0fskip_direct_url\x18\x04 \x01(\bR\rskipDirectUrl\x12\x12\n" +
+	"\x04site\x18\x05 \x01(\tR\x04site\x12\x18\n" +
+	"\aservice\x18\x06 \x01(\tR\aservice\x12\x14\n" +
+	"\x05floor\x18\a \x01(\tR\x05floor\x12-\n" +
+	"\x13filter_page_on_sale\x18\b \x01(\bR\x10filterPageOnSale\x121\n" +
+	"\x15sort_page_by_discount\x18\t \x01(\bR\x12sortPageByDiscount\"r\n" +
 	"\x17GetVideosByDateResponse\x12$\n" +
 	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x121\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\",\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\"p\n" +
 	"\x13GetVideoByIdRequest\x12\x15\n" +
-	"\x06dmm_id\x18\x01 \x01(\tR\x05dmmId\":\n" +
+	"\x06dmm_id\x18\x01 \x01(\tR\x05dmmId\x12\x12\n" +
+	"\x04site\x18\x02 \x01(\tR\x04site\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x14\n" +
+	"\x05floor\x18\x04 \x01(\tR\x05floor\":\n" +
 	"\x14GetVideoByIdResponse\x12\"\n" +
-	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"\xe3\x02\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"\xed\x03\n" +
 	"\x14GetVideosByIDRequest\x12\x1d\n" +
 	"\n" +
 	"actress_id\x18\x01 \x03(\tR\tactressId\x12\x19\n" +
@@ -1381,7 +2636,10 @@ const file_video_video_proto_rawDesc = "" +
 	" \x01(\tR\alteDate\x12\x12\n" +
 	"\x04site\x18\v \x01(\tR\x04site\x12\x18\n" +
 	"\aservice\x18\f \x01(\tR\aservice\x12\x14\n" +
-	"\x05floor\x18\r \x01(\tR\x05floor\"\xef\x01\n" +
+	"\x05floor\x18\r \x01(\tR\x05floor\x12&\n" +
+	"\x0fskip_direct_url\x18\x0e \x01(\bR\rskipDirectUrl\x12-\n" +
+	"\x13filter_page_on_sale\x18\x0f \x01(\bR\x10filterPageOnSale\x121\n" +
+	"\x15sort_page_by_discount\x18\x10 \x01(\bR\x12sortPageByDiscount\"\xf9\x02\n" +
 	"\x19GetVideosByKeywordRequest\x12\x18\n" +
 	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
 	"\x04hits\x18\x02 \x01(\x05R\x04hits\x12\x16\n" +
@@ -1391,7 +2649,11 @@ const file_video_video_proto_rawDesc = "" +
 	"\blte_date\x18\x06 \x01(\tR\alteDate\x12\x12\n" +
 	"\x04site\x18\a \x01(\tR\x04site\x12\x18\n" +
 	"\aservice\x18\b \x01(\tR\aservice\x12\x14\n" +
-	"\x05floor\x18\t \x01(\tR\x05floor\"{\n" +
+	"\x05floor\x18\t \x01(\tR\x05floor\x12&\n" +
+	"\x0fskip_direct_url\x18\n" +
+	" \x01(\bR\rskipDirectUrl\x12-\n" +
+	"\x13filter_page_on_sale\x18\v \x01(\bR\x10filterPageOnSale\x121\n" +
+	"\x15sort_page_by_discount\x18\f \x01(\bR\x12sortPageByDiscount\"{\n" +
 	"\x0eSearchMetadata\x12!\n" +
 	"\fresult_count\x18\x01 \x01(\x05R\vresultCount\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -1402,7 +2664,7 @@ const file_video_video_proto_rawDesc = "" +
 	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\"u\n" +
 	"\x1aGetVideosByKeywordResponse\x12$\n" +
 	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x121\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\"\xfc\x02\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\"\x86\x04\n" +
 	"\x13SearchVideosRequest\x12\x18\n" +
 	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x1d\n" +
 	"\n" +
@@ -1420,16 +2682,67 @@ const file_video_video_proto_rawDesc = "" +
 	"\blte_date\x18\v \x01(\tR\alteDate\x12\x12\n" +
 	"\x04site\x18\f \x01(\tR\x04site\x12\x18\n" +
 	"\aservice\x18\r \x01(\tR\aservice\x12\x14\n" +
-	"\x05floor\x18\x0e \x01(\tR\x05floor\"o\n" +
+	"\x05floor\x18\x0e \x01(\tR\x05floor\x12&\n" +
+	"\x0fskip_direct_url\x18\x0f \x01(\bR\rskipDirectUrl\x12-\n" +
+	"\x13filter_page_on_sale\x18\x10 \x01(\bR\x10filterPageOnSale\x121\n" +
+	"\x15sort_page_by_discount\x18\x11 \x01(\bR\x12sortPageByDiscount\"o\n" +
 	"\x14SearchVideosResponse\x12$\n" +
 	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x121\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata2\x99\x03\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x15.video.SearchMetadataR\bmetadata\"u\n" +
+	"\vPlaybackURL\x12\x15\n" +
+	"\x06dmm_id\x18\x01 \x01(\tR\x05dmmId\x12\x1d\n" +
+	"\n" +
+	"direct_url\x18\x02 \x01(\tR\tdirectUrl\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.video.PlaybackURLStatusR\x06status\"5\n" +
+	"\x1aResolvePlaybackURLsRequest\x12\x17\n" +
+	"\admm_ids\x18\x01 \x03(\tR\x06dmmIds\"E\n" +
+	"\x1bResolvePlaybackURLsResponse\x12&\n" +
+	"\x04urls\x18\x01 \x03(\v2\x12.video.PlaybackURLR\x04urls\"z\n" +
+	"\vVideoLookup\x12\x15\n" +
+	"\x06dmm_id\x18\x01 \x01(\tR\x05dmmId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.video.VideoLookupStatusR\x06status\x12\"\n" +
+	"\x05video\x18\x03 \x01(\v2\f.video.VideoR\x05video\"\x9f\x01\n" +
+	"\x18GetVideosByDmmIdsRequest\x12\x17\n" +
+	"\admm_ids\x18\x01 \x03(\tR\x06dmmIds\x12\x12\n" +
+	"\x04site\x18\x02 \x01(\tR\x04site\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x14\n" +
+	"\x05floor\x18\x04 \x01(\tR\x05floor\x12&\n" +
+	"\x0fskip_direct_url\x18\x05 \x01(\bR\rskipDirectUrl\"I\n" +
+	"\x19GetVideosByDmmIdsResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.video.VideoLookupR\aresults\"\xe0\x01\n" +
+	"\x05Floor\x12\x1b\n" +
+	"\tsite_name\x18\x01 \x01(\tR\bsiteName\x12\x1b\n" +
+	"\tsite_code\x18\x02 \x01(\tR\bsiteCode\x12!\n" +
+	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_code\x18\x04 \x01(\tR\vserviceCode\x12\x19\n" +
+	"\bfloor_id\x18\x05 \x01(\tR\afloorId\x12\x1d\n" +
+	"\n" +
+	"floor_name\x18\x06 \x01(\tR\tfloorName\x12\x1d\n" +
+	"\n" +
+	"floor_code\x18\a \x01(\tR\tfloorCode\"\x13\n" +
+	"\x11ListFloorsRequest\":\n" +
+	"\x12ListFloorsResponse\x12$\n" +
+	"\x06floors\x18\x01 \x03(\v2\f.video.FloorR\x06floors*\x9d\x01\n" +
+	"\x11PlaybackURLStatus\x12#\n" +
+	"\x1fPLAYBACK_URL_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPLAYBACK_URL_STATUS_RESOLVED\x10\x01\x12!\n" +
+	"\x1dPLAYBACK_URL_STATUS_NOT_FOUND\x10\x02\x12\x1e\n" +
+	"\x1aPLAYBACK_URL_STATUS_FAILED\x10\x03*\x9a\x01\n" +
+	"\x11VideoLookupStatus\x12#\n" +
+	"\x1fVIDEO_LOOKUP_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19VIDEO_LOOKUP_STATUS_FOUND\x10\x01\x12!\n" +
+	"\x1dVIDEO_LOOKUP_STATUS_NOT_FOUND\x10\x02\x12\x1e\n" +
+	"\x1aVIDEO_LOOKUP_STATUS_FAILED\x10\x032\x92\x05\n" +
 	"\fVideoService\x12P\n" +
 	"\x0fGetVideosByDate\x12\x1d.video.GetVideosByDateRequest\x1a\x1e.video.GetVideosByDateResponse\x12G\n" +
-	"\fGetVideoById\x12\x1a.video.GetVideoByIdRequest\x1a\x1b.video.GetVideoByIdResponse\x12G\n" +
+	"\fGetVideoById\x12\x1a.video.GetVideoByIdRequest\x1a\x1b.video.GetVideoByIdResponse\x12V\n" +
+	"\x11GetVideosByDmmIds\x12\x1f.video.GetVideosByDmmIdsRequest\x1a .video.GetVideosByDmmIdsResponse\x12G\n" +
 	"\fSearchVideos\x12\x1a.video.SearchVideosRequest\x1a\x1b.video.SearchVideosResponse\x12J\n" +
 	"\rGetVideosByID\x12\x1b.video.GetVideosByIDRequest\x1a\x1c.video.GetVideosByIDResponse\x12Y\n" +
-	"\x12GetVideosByKeyword\x12 .video.GetVideosByKeywordRequest\x1a!.video.GetVideosByKeywordResponseB+Z)github.com/tikfack/server/gen/video;videob\x06proto3"
+	"\x12GetVideosByKeyword\x12 .video.GetVideosByKeywordRequest\x1a!.video.GetVideosByKeywordResponse\x12\\\n" +
+	"\x13ResolvePlaybackURLs\x12!.video.ResolvePlaybackURLsRequest\x1a\".video.ResolvePlaybackURLsResponse\x12A\n" +
+	"\n" +
+	"ListFloors\x12\x18.video.ListFloorsRequest\x1a\x19.video.ListFloorsResponseB+Z)github.com/tikfack/server/gen/video;videob\x06proto3"
 
 var (
 	file_video_video_proto_rawDescOnce sync.Once
@@ -1443,58 +2756,94 @@ func file_video_video_proto_rawDescGZIP() []byte {
 	return file_video_video_proto_rawDescData
 }
 
-var file_video_video_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_video_video_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_video_video_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_video_video_proto_goTypes = []any{
-	(*Actress)(nil),                    // 0: video.Actress
-	(*Genre)(nil),                      // 1: video.Genre
-	(*Maker)(nil),                      // 2: video.Maker
-	(*Series)(nil),                     // 3: video.Series
-	(*Director)(nil),                   // 4: video.Director
-	(*Review)(nil),                     // 5: video.Review
-	(*Video)(nil),                      // 6: video.Video
-	(*GetVideosByDateRequest)(nil),     // 7: video.GetVideosByDateRequest
-	(*GetVideosByDateResponse)(nil),    // 8: video.GetVideosByDateResponse
-	(*GetVideoByIdRequest)(nil),        // 9: video.GetVideoByIdRequest
-	(*GetVideoByIdResponse)(nil),       // 10: video.GetVideoByIdResponse
-	(*GetVideosByIDRequest)(nil),       // 11: video.GetVideosByIDRequest
-	(*GetVideosByKeywordRequest)(nil),  // 12: video.GetVideosByKeywordRequest
-	(*SearchMetadata)(nil),             // 13: video.SearchMetadata
-	(*GetVideosByIDResponse)(nil),      // 14: video.GetVideosByIDResponse
-	(*GetVideosByKeywordResponse)(nil), // 15: video.GetVideosByKeywordResponse
-	(*SearchVideosRequest)(nil),        // 16: video.SearchVideosRequest
-	(*SearchVideosResponse)(nil),       // 17: video.SearchVideosResponse
+	(PlaybackURLStatus)(0),              // 0: video.PlaybackURLStatus
+	(VideoLookupStatus)(0),              // 1: video.VideoLookupStatus
+	(*Actress)(nil),                     // 2: video.Actress
+	(*Genre)(nil),                       // 3: video.Genre
+	(*Maker)(nil),                       // 4: video.Maker
+	(*Series)(nil),                      // 5: video.Series
+	(*Director)(nil),                    // 6: video.Director
+	(*Label)(nil),                       // 7: video.Label
+	(*Review)(nil),                      // 8: video.Review
+	(*DeliveryPrice)(nil),               // 9: video.DeliveryPrice
+	(*Pricing)(nil),                     // 10: video.Pricing
+	(*SampleImage)(nil),                 // 11: video.SampleImage
+	(*SampleMovies)(nil),                // 12: video.SampleMovies
+	(*Campaign)(nil),                    // 13: video.Campaign
+	(*Video)(nil),                       // 14: video.Video
+	(*GetVideosByDateRequest)(nil),      // 15: video.GetVideosByDateRequest
+	(*GetVideosByDateResponse)(nil),     // 16: video.GetVideosByDateResponse
+	(*GetVideoByIdRequest)(nil),         // 17: video.GetVideoByIdRequest
+	(*GetVideoByIdResponse)(nil),        // 18: video.GetVideoByIdResponse
+	(*GetVideosByIDRequest)(nil),        // 19: video.GetVideosByIDRequest
+	(*GetVideosByKeywordRequest)(nil),   // 20: video.GetVideosByKeywordRequest
+	(*SearchMetadata)(nil),              // 21: video.SearchMetadata
+	(*GetVideosByIDResponse)(nil),       // 22: video.GetVideosByIDResponse
+	(*GetVideosByKeywordResponse)(nil),  // 23: video.GetVideosByKeywordResponse
+	(*SearchVideosRequest)(nil),         // 24: video.SearchVideosRequest
+	(*SearchVideosResponse)(nil),        // 25: video.SearchVideosResponse
+	(*PlaybackURL)(nil),                 // 26: video.PlaybackURL
+	(*ResolvePlaybackURLsRequest)(nil),  // 27: video.ResolvePlaybackURLsRequest
+	(*ResolvePlaybackURLsResponse)(nil), // 28: video.ResolvePlaybackURLsResponse
+	(*VideoLookup)(nil),                 // 29: video.VideoLookup
+	(*GetVideosByDmmIdsRequest)(nil),    // 30: video.GetVideosByDmmIdsRequest
+	(*GetVideosByDmmIdsResponse)(nil),   // 31: video.GetVideosByDmmIdsResponse
+	(*Floor)(nil),                       // 32: video.Floor
+	(*ListFloorsRequest)(nil),           // 33: video.ListFloorsRequest
+	(*ListFloorsResponse)(nil),          // 34: video.ListFloorsResponse
 }
 var file_video_video_proto_depIdxs = []int32{
-	0,  // 0: video.Video.actresses:type_name -> video.Actress
-	1,  // 1: video.Video.genres:type_name -> video.Genre
-	2,  // 2: video.Video.makers:type_name -> video.Maker
-	3,  // 3: video.Video.series:type_name -> video.Series
-	4,  // 4: video.Video.directors:type_name -> video.Director
-	5,  // 5: video.Video.review:type_name -> video.Review
-	6,  // 6: video.GetVideosByDateResponse.videos:type_name -> video.Video
-	13, // 7: video.GetVideosByDateResponse.metadata:type_name -> video.SearchMetadata
-	6,  // 8: video.GetVideoByIdResponse.video:type_name -> video.Video
-	6,  // 9: video.GetVideosByIDResponse.videos:type_name -> video.Video
-	13, // 10: video.GetVideosByIDResponse.metadata:type_name -> video.SearchMetadata
-	6,  // 11: video.GetVideosByKeywordResponse.videos:type_name -> video.Video
-	13, // 12: video.GetVideosByKeywordResponse.metadata:type_name -> video.SearchMetadata
-	6,  // 13: video.SearchVideosResponse.videos:type_name -> video.Video
-	13, // 14: video.SearchVideosResponse.metadata:type_name -> video.SearchMetadata
-	7,  // 15: video.VideoService.GetVideosByDate:input_type -> video.GetVideosByDateRequest
-	9,  // 16: video.VideoService.GetVideoById:input_type -> video.GetVideoByIdRequest
-	16, // 17: video.VideoService.SearchVideos:input_type -> video.SearchVideosRequest
-	11, // 18: video.VideoService.GetVideosByID:input_type -> video.GetVideosByIDRequest
-	12, // 19: video.VideoService.GetVideosByKeyword:input_type -> video.GetVideosByKeywordRequest
-	8,  // 20: video.VideoService.GetVideosByDate:output_type -> video.GetVideosByDateResponse
-	10, // 21: video.VideoService.GetVideoById:output_type -> video.GetVideoByIdResponse
-	17, // 22: video.VideoService.SearchVideos:output_type -> video.SearchVideosResponse
-	14, // 23: video.VideoService.GetVideosByID:output_type -> video.GetVideosByIDResponse
-	15, // 24: video.VideoService.GetVideosByKeyword:output_type -> video.GetVideosByKeywordResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	9,  // 0: video.Pricing.deliveries:type_name -> video.DeliveryPrice
+	2,  // 1: video.Video.actresses:type_name -> video.Actress
+	3,  // 2: video.Video.genres:type_name -> video.Genre
+	4,  // 3: video.Video.makers:type_name -> video.Maker
+	5,  // 4: video.Video.series:type_name -> video.Series
+	6,  // 5: video.Video.directors:type_name -> video.Director
+	8,  // 6: video.Video.review:type_name -> video.Review
+	11, // 7: video.Video.sample_images:type_name -> video.SampleImage
+	12, // 8: video.Video.sample_movies:type_name -> video.SampleMovies
+	13, // 9: video.Video.campaigns:type_name -> video.Campaign
+	7,  // 10: video.Video.labels:type_name -> video.Label
+	10, // 11: video.Video.pricing:type_name -> video.Pricing
+	14, // 12: video.GetVideosByDateResponse.videos:type_name -> video.Video
+	21, // 13: video.GetVideosByDateResponse.metadata:type_name -> video.SearchMetadata
+	14, // 14: video.GetVideoByIdResponse.video:type_name -> video.Video
+	14, // 15: video.GetVideosByIDResponse.videos:type_name -> video.Video
+	21, // 16: video.GetVideosByIDResponse.metadata:type_name -> video.SearchMetadata
+	14, // 17: video.GetVideosByKeywordResponse.videos:type_name -> video.Video
+	21, // 18: video.GetVideosByKeywordResponse.metadata:type_name -> video.SearchMetadata
+	14, // 19: video.SearchVideosResponse.videos:type_name -> video.Video
+	21, // 20: video.SearchVideosResponse.metadata:type_name -> video.SearchMetadata
+	0,  // 21: video.PlaybackURL.status:type_name -> video.PlaybackURLStatus
+	26, // 22: video.ResolvePlaybackURLsResponse.urls:type_name -> video.PlaybackURL
+	1,  // 23: video.VideoLookup.status:type_name -> video.VideoLookupStatus
+	14, // 24: video.VideoLookup.video:type_name -> video.Video
+	29, // 25: video.GetVideosByDmmIdsResponse.results:type_name -> video.VideoLookup
+	32, // 26: video.ListFloorsResponse.floors:type_name -> video.Floor
+	15, // 27: video.VideoService.GetVideosByDate:input_type -> video.GetVideosByDateRequest
+	17, // 28: video.VideoService.GetVideoById:input_type -> video.GetVideoByIdRequest
+	30, // 29: video.VideoService.GetVideosByDmmIds:input_type -> video.GetVideosByDmmIdsRequest
+	24, // 30: video.VideoService.SearchVideos:input_type -> video.SearchVideosRequest
+	19, // 31: video.VideoService.GetVideosByID:input_type -> video.GetVideosByIDRequest
+	20, // 32: video.VideoService.GetVideosByKeyword:input_type -> video.GetVideosByKeywordRequest
+	27, // 33: video.VideoService.ResolvePlaybackURLs:input_type -> video.ResolvePlaybackURLsRequest
+	33, // 34: video.VideoService.ListFloors:input_type -> video.ListFloorsRequest
+	16, // 35: video.VideoService.GetVideosByDate:output_type -> video.GetVideosByDateResponse
+	18, // 36: video.VideoService.GetVideoById:output_type -> video.GetVideoByIdResponse
+	31, // 37: video.VideoService.GetVideosByDmmIds:output_type -> video.GetVideosByDmmIdsResponse
+	25, // 38: video.VideoService.SearchVideos:output_type -> video.SearchVideosResponse
+	22, // 39: video.VideoService.GetVideosByID:output_type -> video.GetVideosByIDResponse
+	23, // 40: video.VideoService.GetVideosByKeyword:output_type -> video.GetVideosByKeywordResponse
+	28, // 41: video.VideoService.ResolvePlaybackURLs:output_type -> video.ResolvePlaybackURLsResponse
+	34, // 42: video.VideoService.ListFloors:output_type -> video.ListFloorsResponse
+	35, // [35:43] is the sub-list for method output_type
+	27, // [27:35] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_video_video_proto_init() }
@@ -1507,13 +2856,14 @@ func file_video_video_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_video_video_proto_rawDesc), len(file_video_video_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_video_video_proto_goTypes,
 		DependencyIndexes: file_video_video_proto_depIdxs,
+		EnumInfos:         file_video_video_proto_enumTypes,
 		MessageInfos:      file_video_video_proto_msgTypes,
 	}.Build()
 	File_video_video_proto = out.File
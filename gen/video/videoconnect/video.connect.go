@@ -39,6 +39,9 @@ const (
 	// VideoServiceGetVideoByIdProcedure is the fully-qualified name of the VideoService's GetVideoById
 	// RPC.
 	VideoServiceGetVideoByIdProcedure = "/video.VideoService/GetVideoById"
+	// VideoServiceGetVideosByDmmIdsProcedure is the fully-qualified name of the VideoService's
+	// GetVideosByDmmIds RPC.
+	VideoServiceGetVideosByDmmIdsProcedure = "/video.VideoService/GetVideosByDmmIds"
 	// VideoServiceSearchVideosProcedure is the fully-qualified name of the VideoService's SearchVideos
 	// RPC.
 	VideoServiceSearchVideosProcedure = "/video.VideoService/SearchVideos"
@@ -48,15 +51,23 @@ const (
 	// VideoServiceGetVideosByKeywordProcedure is the fully-qualified name of the VideoService's
 	// GetVideosByKeyword RPC.
 	VideoServiceGetVideosByKeywordProcedure = "/video.VideoService/GetVideosByKeyword"
+	// VideoServiceResolvePlaybackURLsProcedure is the fully-qualified name of the VideoService's
+	// ResolvePlaybackURLs RPC.
+	VideoServiceResolvePlaybackURLsProcedure = "/video.VideoService/ResolvePlaybackURLs"
+	// VideoServiceListFloorsProcedure is the fully-qualified name of the VideoService's ListFloors RPC.
+	VideoServiceListFloorsProcedure = "/video.VideoService/ListFloors"
 )
 
 // VideoServiceClient is a client for the video.VideoService service.
 type VideoServiceClient interface {
 	GetVideosByDate(context.Context, *connect_go.Request[video.GetVideosByDateRequest]) (*connect_go.Response[video.GetVideosByDateResponse], error)
 	GetVideoById(context.Context, *connect_go.Request[video.GetVideoByIdRequest]) (*connect_go.Response[video.GetVideoByIdResponse], error)
+	GetVideosByDmmIds(context.Context, *connect_go.Request[video.GetVideosByDmmIdsRequest]) (*connect_go.Response[video.GetVideosByDmmIdsResponse], error)
 	SearchVideos(context.Context, *connect_go.Request[video.SearchVideosRequest]) (*connect_go.Response[video.SearchVideosResponse], error)
 	GetVideosByID(context.Context, *connect_go.Request[video.GetVideosByIDRequest]) (*connect_go.Response[video.GetVideosByIDResponse], error)
 	GetVideosByKeyword(context.Context, *connect_go.Request[video.GetVideosByKeywordRequest]) (*connect_go.Response[video.GetVideosByKeywordResponse], error)
+	ResolvePlaybackURLs(context.Context, *connect_go.Request[video.ResolvePlaybackURLsRequest]) (*connect_go.Response[video.ResolvePlaybackURLsResponse], error)
+	ListFloors(context.Context, *connect_go.Request[video.ListFloorsRequest]) (*connect_go.Response[video.ListFloorsResponse], error)
 }
 
 // NewVideoServiceClient constructs a client for the video.VideoService service. By default, it uses
@@ -79,6 +90,11 @@ func NewVideoServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+VideoServiceGetVideoByIdProcedure,
 			opts...,
 		),
+		getVideosByDmmIds: connect_go.NewClient[video.GetVideosByDmmIdsRequest, video.GetVideosByDmmIdsResponse](
+			httpClient,
+			baseURL+VideoServiceGetVideosByDmmIdsProcedure,
+			opts...,
+		),
 		searchVideos: connect_go.NewClient[video.SearchVideosRequest, video.SearchVideosResponse](
 			httpClient,
 			baseURL+VideoServiceSearchVideosProcedure,
@@ -94,16 +110,29 @@ func NewVideoServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+VideoServiceGetVideosByKeywordProcedure,
 			opts...,
 		),
+		resolvePlaybackURLs: connect_go.NewClient[video.ResolvePlaybackURLsRequest, video.ResolvePlaybackURLsResponse](
+			httpClient,
+			baseURL+VideoServiceResolvePlaybackURLsProcedure,
+			opts...,
+		),
+		listFloors: connect_go.NewClient[video.ListFloorsRequest, video.ListFloorsResponse](
+			httpClient,
+			baseURL+VideoServiceListFloorsProcedure,
+			opts...,
+		),
 	}
 }
 
 // videoServiceClient implements VideoServiceClient.
 type videoServiceClient struct {
-	getVideosByDate    *connect_go.Client[video.GetVideosByDateRequest, video.GetVideosByDateResponse]
-	getVideoById       *connect_go.Client[video.GetVideoByIdRequest, video.GetVideoByIdResponse]
-	searchVideos       *connect_go.Client[video.SearchVideosRequest, video.SearchVideosResponse]
-	getVideosByID      *connect_go.Client[video.GetVideosByIDRequest, video.GetVideosByIDResponse]
-	getVideosByKeyword *connect_go.Client[video.GetVideosByKeywordRequest, video.GetVideosByKeywordResponse]
+	getVideosByDate     *connect_go.Client[video.GetVideosByDateRequest, video.GetVideosByDateResponse]
+	getVideoById        *connect_go.Client[video.GetVideoByIdRequest, video.GetVideoByIdResponse]
+	getVideosByDmmIds   *connect_go.Client[video.GetVideosByDmmIdsRequest, video.GetVideosByDmmIdsResponse]
+	searchVideos        *connect_go.Client[video.SearchVideosRequest, video.SearchVideosResponse]
+	getVideosByID       *connect_go.Client[video.GetVideosByIDRequest, video.GetVideosByIDResponse]
+	getVideosByKeyword  *connect_go.Client[video.GetVideosByKeywordRequest, video.GetVideosByKeywordResponse]
+	resolvePlaybackURLs *connect_go.Client[video.ResolvePlaybackURLsRequest, video.ResolvePlaybackURLsResponse]
+	listFloors          *connect_go.Client[video.ListFloorsRequest, video.ListFloorsResponse]
 }
 
 // GetVideosByDate calls video.VideoService.GetVideosByDate.
@@ -116,6 +145,11 @@ func (c *videoServiceClient) GetVideoById(ctx context.Context, req *connect_go.R
 	return c.getVideoById.CallUnary(ctx, req)
 }
 
+// GetVideosByDmmIds calls video.VideoService.GetVideosByDmmIds.
+func (c *videoServiceClient) GetVideosByDmmIds(ctx context.Context, req *connect_go.Request[video.GetVideosByDmmIdsRequest]) (*connect_go.Response[video.GetVideosByDmmIdsResponse], error) {
+	return c.getVideosByDmmIds.CallUnary(ctx, req)
+}
+
 // SearchVideos calls video.VideoService.SearchVideos.
 func (c *videoServiceClient) SearchVideos(ctx context.Context, req *connect_go.Request[video.SearchVideosRequest]) (*connect_go.Response[video.SearchVideosResponse], error) {
 	return c.searchVideos.CallUnary(ctx, req)
@@ -131,13 +165,26 @@ func (c *videoServiceClient) GetVideosByKeyword(ctx context.Context, req *connec
 	return c.getVideosByKeyword.CallUnary(ctx, req)
 }
 
+// ResolvePlaybackURLs calls video.VideoService.ResolvePlaybackURLs.
+func (c *videoServiceClient) ResolvePlaybackURLs(ctx context.Context, req *connect_go.Request[video.ResolvePlaybackURLsRequest]) (*connect_go.Response[video.ResolvePlaybackURLsResponse], error) {
+	return c.resolvePlaybackURLs.CallUnary(ctx, req)
+}
+
+// ListFloors calls video.VideoService.ListFloors.
+func (c *videoServiceClient) ListFloors(ctx context.Context, req *connect_go.Request[video.ListFloorsRequest]) (*connect_go.Response[video.ListFloorsResponse], error) {
+	return c.listFloors.CallUnary(ctx, req)
+}
+
 // VideoServiceHandler is an implementation of the video.VideoService service.
 type VideoServiceHandler interface {
 	GetVideosByDate(context.Context, *connect_go.Request[video.GetVideosByDateRequest]) (*connect_go.Response[video.GetVideosByDateResponse], error)
 	GetVideoById(context.Context, *connect_go.Request[video.GetVideoByIdRequest]) (*connect_go.Response[video.GetVideoByIdResponse], error)
+	GetVideosByDmmIds(context.Context, *connect_go.Request[video.GetVideosByDmmIdsRequest]) (*connect_go.Response[video.GetVideosByDmmIdsResponse], error)
 	SearchVideos(context.Context, *connect_go.Request[video.SearchVideosRequest]) (*connect_go.Response[video.SearchVideosResponse], error)
 	GetVideosByID(context.Context, *connect_go.Request[video.GetVideosByIDRequest]) (*connect_go.Response[video.GetVideosByIDResponse], error)
 	GetVideosByKeyword(context.Context, *connect_go.Request[video.GetVideosByKeywordRequest]) (*connect_go.Response[video.GetVideosByKeywordResponse], error)
+	ResolvePlaybackURLs(context.Context, *connect_go.Request[video.ResolvePlaybackURLsRequest]) (*connect_go.Response[video.ResolvePlaybackURLsResponse], error)
+	ListFloors(context.Context, *connect_go.Request[video.ListFloorsRequest]) (*connect_go.Response[video.ListFloorsResponse], error)
 }
 
 // NewVideoServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -156,6 +203,11 @@ func NewVideoServiceHandler(svc VideoServiceHandler, opts ...connect_go.HandlerO
 		svc.GetVideoById,
 		opts...,
 	)
+	videoServiceGetVideosByDmmIdsHandler := connect_go.NewUnaryHandler(
+		VideoServiceGetVideosByDmmIdsProcedure,
+		svc.GetVideosByDmmIds,
+		opts...,
+	)
 	videoServiceSearchVideosHandler := connect_go.NewUnaryHandler(
 		VideoServiceSearchVideosProcedure,
 		svc.SearchVideos,
@@ -171,18 +223,34 @@ func NewVideoServiceHandler(svc VideoServiceHandler, opts ...connect_go.HandlerO
 		svc.GetVideosByKeyword,
 		opts...,
 	)
+	videoServiceResolvePlaybackURLsHandler := connect_go.NewUnaryHandler(
+		VideoServiceResolvePlaybackURLsProcedure,
+		svc.ResolvePlaybackURLs,
+		opts...,
+	)
+	videoServiceListFloorsHandler := connect_go.NewUnaryHandler(
+		VideoServiceListFloorsProcedure,
+		svc.ListFloors,
+		opts...,
+	)
 	return "/video.VideoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VideoServiceGetVideosByDateProcedure:
 			videoServiceGetVideosByDateHandler.ServeHTTP(w, r)
 		case VideoServiceGetVideoByIdProcedure:
 			videoServiceGetVideoByIdHandler.ServeHTTP(w, r)
+		case VideoServiceGetVideosByDmmIdsProcedure:
+			videoServiceGetVideosByDmmIdsHandler.ServeHTTP(w, r)
 		case VideoServiceSearchVideosProcedure:
 			videoServiceSearchVideosHandler.ServeHTTP(w, r)
 		case VideoServiceGetVideosByIDProcedure:
 			videoServiceGetVideosByIDHandler.ServeHTTP(w, r)
 		case VideoServiceGetVideosByKeywordProcedure:
 			videoServiceGetVideosByKeywordHandler.ServeHTTP(w, r)
+		case VideoServiceResolvePlaybackURLsProcedure:
+			videoServiceResolvePlaybackURLsHandler.ServeHTTP(w, r)
+		case VideoServiceListFloorsProcedure:
+			videoServiceListFloorsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -200,6 +268,10 @@ func (UnimplementedVideoServiceHandler) GetVideoById(context.Context, *connect_g
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.GetVideoById is not implemented"))
 }
 
+func (UnimplementedVideoServiceHandler) GetVideosByDmmIds(context.Context, *connect_go.Request[video.GetVideosByDmmIdsRequest]) (*connect_go.Response[video.GetVideosByDmmIdsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.GetVideosByDmmIds is not implemented"))
+}
+
 func (UnimplementedVideoServiceHandler) SearchVideos(context.Context, *connect_go.Request[video.SearchVideosRequest]) (*connect_go.Response[video.SearchVideosResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.SearchVideos is not implemented"))
 }
@@ -211,3 +283,11 @@ func (UnimplementedVideoServiceHandler) GetVideosByID(context.Context, *connect_
 func (UnimplementedVideoServiceHandler) GetVideosByKeyword(context.Context, *connect_go.Request[video.GetVideosByKeywordRequest]) (*connect_go.Response[video.GetVideosByKeywordResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.GetVideosByKeyword is not implemented"))
 }
+
+func (UnimplementedVideoServiceHandler) ResolvePlaybackURLs(context.Context, *connect_go.Request[video.ResolvePlaybackURLsRequest]) (*connect_go.Response[video.ResolvePlaybackURLsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.ResolvePlaybackURLs is not implemented"))
+}
+
+func (UnimplementedVideoServiceHandler) ListFloors(context.Context, *connect_go.Request[video.ListFloorsRequest]) (*connect_go.Response[video.ListFloorsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("video.VideoService.ListFloors is not implemented"))
+}
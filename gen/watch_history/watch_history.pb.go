@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: watch_history/watch_history.proto
+
+package watch_history
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Playback state of one video, built from the playback events sent to EventLogService.
+type WatchHistoryEntry struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	VideoId               string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PositionSeconds       float64                `protobuf:"fixed64,2,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"`                     // last reported position
+	DurationSeconds       float64                `protobuf:"fixed64,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`                     // 0 when the player never reported it
+	Progress              float64                `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`                                                          // position / duration in [0, 1]; 0 when the duration is unknown
+	ResumePositionSeconds float64                `protobuf:"fixed64,5,opt,name=resume_position_seconds,json=resumePositionSeconds,proto3" json:"resume_position_seconds,omitempty"` // 0 once the video was left at the end
+	Completed             bool                   `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`                                                         // watched to the end at least once
+	LastWatchedAt         string                 `protobuf:"bytes,7,opt,name=last_watched_at,json=lastWatchedAt,proto3" json:"last_watched_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *WatchHistoryEntry) Reset() {
+	*x = WatchHistoryEntry{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchHistoryEntry) ProtoMessage() {}
+
+func (x *WatchHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchHistoryEntry.ProtoReflect.Descriptor instead.
+func (*WatchHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchHistoryEntry) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *WatchHistoryEntry) GetPositionSeconds() float64 {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return 0
+}
+
+func (x *WatchHistoryEntry) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *WatchHistoryEntry) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *WatchHistoryEntry) GetResumePositionSeconds() float64 {
+	if x != nil {
+		return x.ResumePositionSeconds
+	}
+	return 0
+}
+
+func (x *WatchHistoryEntry) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *WatchHistoryEntry) GetLastWatchedAt() string {
+	if x != nil {
+		return x.LastWatchedAt
+	}
+	return ""
+}
+
+// Entries are returned most recently watched first.
+type ListWatchHistoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	InProgressOnly bool                   `protobuf:"varint,3,opt,name=in_progress_only,json=inProgressOnly,proto3" json:"in_progress_only,omitempty"` // only videos that can be resumed ("continue watching")
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWatchHistoryRequest) Reset() {
+	*x = ListWatchHistoryRequest{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchHistoryRequest) ProtoMessage() {}
+
+func (x *ListWatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListWatchHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWatchHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWatchHistoryRequest) GetInProgressOnly() bool {
+	if x != nil {
+		return x.InProgressOnly
+	}
+	return false
+}
+
+type ListWatchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WatchHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchHistoryResponse) Reset() {
+	*x = ListWatchHistoryResponse{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchHistoryResponse) ProtoMessage() {}
+
+func (x *ListWatchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListWatchHistoryResponse) GetEntries() []*WatchHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListWatchHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetResumePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResumePositionRequest) Reset() {
+	*x = GetResumePositionRequest{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResumePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumePositionRequest) ProtoMessage() {}
+
+func (x *GetResumePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumePositionRequest.ProtoReflect.Descriptor instead.
+func (*GetResumePositionRequest) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetResumePositionRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type GetResumePositionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VideoId         string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PositionSeconds float64                `protobuf:"fixed64,2,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"` // 0 for videos never played or left at the end
+	DurationSeconds float64                `protobuf:"fixed64,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Completed       bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	HasHistory      bool                   `protobuf:"varint,5,opt,name=has_history,json=hasHistory,proto3" json:"has_history,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetResumePositionResponse) Reset() {
+	*x = GetResumePositionResponse{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResumePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumePositionResponse) ProtoMessage() {}
+
+func (x *GetResumePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumePositionResponse.ProtoReflect.Descriptor instead.
+func (*GetResumePositionResponse) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResumePositionResponse) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetResumePositionResponse) GetPositionSeconds() float64 {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return 0
+}
+
+func (x *GetResumePositionResponse) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *GetResumePositionResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *GetResumePositionResponse) GetHasHistory() bool {
+	if x != nil {
+		return x.HasHistory
+	}
+	return false
+}
+
+type ClearHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoIds      []string               `protobuf:"bytes,1,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"` // empty clears the whole history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryRequest) Reset() {
+	*x = ClearHistoryRequest{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryRequest) ProtoMessage() {}
+
+func (x *ClearHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClearHistoryRequest) GetVideoIds() []string {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+type ClearHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount  int32                  `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryResponse) Reset() {
+	*x = ClearHistoryResponse{}
+	mi := &file_watch_history_watch_history_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryResponse) ProtoMessage() {}
+
+func (x *ClearHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watch_history_watch_history_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_watch_history_watch_history_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClearHistoryResponse) GetRemovedCount() int32 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+var File_watch_history_watch_history_proto protoreflect.FileDescriptor
+
+const file_watch_history_watch_history_proto_rawDesc = "" +
+	"\n" +
+	"!watch_history/watch_history.proto\x12\fwatchhistory\"\x9e\x02\n" +
+	"\x11WatchHistoryEntry\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12)\n" +
+	"\x10position_seconds\x18\x02 \x01(\x01R\x0fpositionSeconds\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x01R\x0fdurationSeconds\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x01R\bprogress\x126\n" +
+	"\x17resume_position_seconds\x18\x05 \x01(\x01R\x15resumePositionSeconds\x12\x1c\n" +
+	"\tcompleted\x18\x06 \x01(\bR\tcompleted\x12&\n" +
+	"\x0flast_watched_at\x18\a \x01(\tR\rlastWatchedAt\"\x7f\n" +
+	"\x17ListWatchHistoryRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x10in_progress_only\x18\x03 \x01(\bR\x0einProgressOnly\"}\n" +
+	"\x18ListWatchHistoryResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.watchhistory.WatchHistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"5\n" +
+	"\x18GetResumePositionRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"\xcb\x01\n" +
+	"\x19GetResumePositionResponse\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12)\n" +
+	"\x10position_seconds\x18\x02 \x01(\x01R\x0fpositionSeconds\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x01R\x0fdurationSeconds\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\bR\tcompleted\x12\x1f\n" +
+	"\vhas_history\x18\x05 \x01(\bR\n" +
+	"hasHistory\"2\n" +
+	"\x13ClearHistoryRequest\x12\x1b\n" +
+	"\tvideo_ids\x18\x01 \x03(\tR\bvideoIds\";\n" +
+	"\x14ClearHistoryResponse\x12#\n" +
+	"\rremoved_count\x18\x01 \x01(\x05R\fremovedCount2\xb5\x02\n" +
+	"\x13WatchHistoryService\x12a\n" +
+	"\x10ListWatchHistory\x12%.watchhistory.ListWatchHistoryRequest\x1a&.watchhistory.ListWatchHistoryResponse\x12d\n" +
+	"\x11GetResumePosition\x12&.watchhistory.GetResumePositionRequest\x1a'.watchhistory.GetResumePositionResponse\x12U\n" +
+	"\fClearHistory\x12!.watchhistory.ClearHistoryRequest\x1a\".watchhistory.ClearHistoryResponseB;Z9github.com/tikfack/server/gen/watch_history;watch_historyb\x06proto3"
+
+var (
+	file_watch_history_watch_history_proto_rawDescOnce sync.Once
+	file_watch_history_watch_history_proto_rawDescData []byte
+)
+
+func file_watch_history_watch_history_proto_rawDescGZIP() []byte {
+	file_watch_history_watch_history_proto_rawDescOnce.Do(func() {
+		file_watch_history_watch_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_watch_history_watch_history_proto_rawDesc), len(file_watch_history_watch_history_proto_rawDesc)))
+	})
+	return file_watch_history_watch_history_proto_rawDescData
+}
+
+var file_watch_history_watch_history_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_watch_history_watch_history_proto_goTypes = []any{
+	(*WatchHistoryEntry)(nil),         // 0: watchhistory.WatchHistoryEntry
+	(*ListWatchHistoryRequest)(nil),   // 1: watchhistory.ListWatchHistoryRequest
+	(*ListWatchHistoryResponse)(nil),  // 2: watchhistory.ListWatchHistoryResponse
+	(*GetResumePositionRequest)(nil),  // 3: watchhistory.GetResumePositionRequest
+	(*GetResumePositionResponse)(nil), // 4: watchhistory.GetResumePositionResponse
+	(*ClearHistoryRequest)(nil),       // 5: watchhistory.ClearHistoryRequest
+	(*ClearHistoryResponse)(nil),      // 6: watchhistory.ClearHistoryResponse
+}
+var file_watch_history_watch_history_proto_depIdxs = []int32{
+	0, // 0: watchhistory.ListWatchHistoryResponse.entries:type_name -> watchhistory.WatchHistoryEntry
+	1, // 1: watchhistory.WatchHistoryService.ListWatchHistory:input_type -> watchhistory.ListWatchHistoryRequest
+	3, // 2: watchhistory.WatchHistoryService.GetResumePosition:input_type -> watchhistory.GetResumePositionRequest
+	5, // 3: watchhistory.WatchHistoryService.ClearHistory:input_type -> watchhistory.ClearHistoryRequest
+	2, // 4: watchhistory.WatchHistoryService.ListWatchHistory:output_type -> watchhistory.ListWatchHistoryResponse
+	4, // 5: watchhistory.WatchHistoryService.GetResumePosition:output_type -> watchhistory.GetResumePositionResponse
+	6, // 6: watchhistory.WatchHistoryService.ClearHistory:output_type -> watchhistory.ClearHistoryResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_watch_history_watch_history_proto_init() }
+func file_watch_history_watch_history_proto_init() {
+	if File_watch_history_watch_history_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_watch_history_watch_history_proto_rawDesc), len(file_watch_history_watch_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_watch_history_watch_history_proto_goTypes,
+		DependencyIndexes: file_watch_history_watch_history_proto_depIdxs,
+		MessageInfos:      file_watch_history_watch_history_proto_msgTypes,
+	}.Build()
+	File_watch_history_watch_history_proto = out.File
+	file_watch_history_watch_history_proto_goTypes = nil
+	file_watch_history_watch_history_proto_depIdxs = nil
+}
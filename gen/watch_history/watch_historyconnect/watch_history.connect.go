@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: watch_history/watch_history.proto
+
+package watch_historyconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	watch_history "github.com/tikfack/server/gen/watch_history"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// WatchHistoryServiceName is the fully-qualified name of the WatchHistoryService service.
+	WatchHistoryServiceName = "watchhistory.WatchHistoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WatchHistoryServiceListWatchHistoryProcedure is the fully-qualified name of the
+	// WatchHistoryService's ListWatchHistory RPC.
+	WatchHistoryServiceListWatchHistoryProcedure = "/watchhistory.WatchHistoryService/ListWatchHistory"
+	// WatchHistoryServiceGetResumePositionProcedure is the fully-qualified name of the
+	// WatchHistoryService's GetResumePosition RPC.
+	WatchHistoryServiceGetResumePositionProcedure = "/watchhistory.WatchHistoryService/GetResumePosition"
+	// WatchHistoryServiceClearHistoryProcedure is the fully-qualified name of the WatchHistoryService's
+	// ClearHistory RPC.
+	WatchHistoryServiceClearHistoryProcedure = "/watchhistory.WatchHistoryService/ClearHistory"
+)
+
+// WatchHistoryServiceClient is a client for the watchhistory.WatchHistoryService service.
+type WatchHistoryServiceClient interface {
+	ListWatchHistory(context.Context, *connect_go.Request[watch_history.ListWatchHistoryRequest]) (*connect_go.Response[watch_history.ListWatchHistoryResponse], error)
+	GetResumePosition(context.Context, *connect_go.Request[watch_history.GetResumePositionRequest]) (*connect_go.Response[watch_history.GetResumePositionResponse], error)
+	ClearHistory(context.Context, *connect_go.Request[watch_history.ClearHistoryRequest]) (*connect_go.Response[watch_history.ClearHistoryResponse], error)
+}
+
+// NewWatchHistoryServiceClient constructs a client for the watchhistory.WatchHistoryService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWatchHistoryServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) WatchHistoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &watchHistoryServiceClient{
+		listWatchHistory: connect_go.NewClient[watch_history.ListWatchHistoryRequest, watch_history.ListWatchHistoryResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceListWatchHistoryProcedure,
+			opts...,
+		),
+		getResumePosition: connect_go.NewClient[watch_history.GetResumePositionRequest, watch_history.GetResumePositionResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceGetResumePositionProcedure,
+			opts...,
+		),
+		clearHistory: connect_go.NewClient[watch_history.ClearHistoryRequest, watch_history.ClearHistoryResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceClearHistoryProcedure,
+			opts...,
+		),
+	}
+}
+
+// watchHistoryServiceClient implements WatchHistoryServiceClient.
+type watchHistoryServiceClient struct {
+	listWatchHistory  *connect_go.Client[watch_history.ListWatchHistoryRequest, watch_history.ListWatchHistoryResponse]
+	getResumePosition *connect_go.Client[watch_history.GetResumePositionRequest, watch_history.GetResumePositionResponse]
+	clearHistory      *connect_go.Client[watch_history.ClearHistoryRequest, watch_history.ClearHistoryResponse]
+}
+
+// ListWatchHistory calls watchhistory.WatchHistoryService.ListWatchHistory.
+func (c *watchHistoryServiceClient) ListWatchHistory(ctx context.Context, req *connect_go.Request[watch_history.ListWatchHistoryRequest]) (*connect_go.Response[watch_history.ListWatchHistoryResponse], error) {
+	return c.listWatchHistory.CallUnary(ctx, req)
+}
+
+// GetResumePosition calls watchhistory.WatchHistoryService.GetResumePosition.
+func (c *watchHistoryServiceClient) GetResumePosition(ctx context.Context, req *connect_go.Request[watch_history.GetResumePositionRequest]) (*connect_go.Response[watch_history.GetResumePositionResponse], error) {
+	return c.getResumePosition.CallUnary(ctx, req)
+}
+
+// ClearHistory calls watchhistory.WatchHistoryService.ClearHistory.
+func (c *watchHistoryServiceClient) ClearHistory(ctx context.Context, req *connect_go.Request[watch_history.ClearHistoryRequest]) (*connect_go.Response[watch_history.ClearHistoryResponse], error) {
+	return c.clearHistory.CallUnary(ctx, req)
+}
+
+// WatchHistoryServiceHandler is an implementation of the watchhistory.WatchHistoryService service.
+type WatchHistoryServiceHandler interface {
+	ListWatchHistory(context.Context, *connect_go.Request[watch_history.ListWatchHistoryRequest]) (*connect_go.Response[watch_history.ListWatchHistoryResponse], error)
+	GetResumePosition(context.Context, *connect_go.Request[watch_history.GetResumePositionRequest]) (*connect_go.Response[watch_history.GetResumePositionResponse], error)
+	ClearHistory(context.Context, *connect_go.Request[watch_history.ClearHistoryRequest]) (*connect_go.Response[watch_history.ClearHistoryResponse], error)
+}
+
+// NewWatchHistoryServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWatchHistoryServiceHandler(svc WatchHistoryServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	watchHistoryServiceListWatchHistoryHandler := connect_go.NewUnaryHandler(
+		WatchHistoryServiceListWatchHistoryProcedure,
+		svc.ListWatchHistory,
+		opts...,
+	)
+	watchHistoryServiceGetResumePositionHandler := connect_go.NewUnaryHandler(
+		WatchHistoryServiceGetResumePositionProcedure,
+		svc.GetResumePosition,
+		opts...,
+	)
+	watchHistoryServiceClearHistoryHandler := connect_go.NewUnaryHandler(
+		WatchHistoryServiceClearHistoryProcedure,
+		svc.ClearHistory,
+		opts...,
+	)
+	return "/watchhistory.WatchHistoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WatchHistoryServiceListWatchHistoryProcedure:
+			watchHistoryServiceListWatchHistoryHandler.ServeHTTP(w, r)
+		case WatchHistoryServiceGetResumePositionProcedure:
+			watchHistoryServiceGetResumePositionHandler.ServeHTTP(w, r)
+		case WatchHistoryServiceClearHistoryProcedure:
+			watchHistoryServiceClearHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWatchHistoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWatchHistoryServiceHandler struct{}
+
+func (UnimplementedWatchHistoryServiceHandler) ListWatchHistory(context.Context, *connect_go.Request[watch_history.ListWatchHistoryRequest]) (*connect_go.Response[watch_history.ListWatchHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("watchhistory.WatchHistoryService.ListWatchHistory is not implemented"))
+}
+
+func (UnimplementedWatchHistoryServiceHandler) GetResumePosition(context.Context, *connect_go.Request[watch_history.GetResumePositionRequest]) (*connect_go.Response[watch_history.GetResumePositionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("watchhistory.WatchHistoryService.GetResumePosition is not implemented"))
+}
+
+func (UnimplementedWatchHistoryServiceHandler) ClearHistory(context.Context, *connect_go.Request[watch_history.ClearHistoryRequest]) (*connect_go.Response[watch_history.ClearHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("watchhistory.WatchHistoryService.ClearHistory is not implemented"))
+}
@@ -0,0 +1,64 @@
+package model
+
+// ActressProfile は外部カタログから取得した女優のプロフィール。
+// 数値の項目は不明な場合 0、文字列の項目は空になる。
+type ActressProfile struct {
+	ID          string
+	Name        string
+	Ruby        string
+	Bust        int
+	Cup         string
+	Waist       int
+	Hip         int
+	Height      int
+	Birthday    string // YYYY-MM-DD
+	BloodType   string
+	Hobby       string
+	Prefectures string
+	ImageURL    ActressImage
+	ListURL     ActressListURL
+}
+
+// ActressLookup は ID ごとの女優の取得結果。Actress は Status が LookupFound の場合のみ設定する。
+type ActressLookup struct {
+	ActressID string
+	Status    LookupStatus
+	Actress   *ActressProfile
+	Err       error // LookupFailed の原因
+}
+
+// ActressImage は女優の画像 URL。
+type ActressImage struct {
+	Small string
+	Large string
+}
+
+// ActressListURL は女優の出演作品一覧ページの URL。
+type ActressListURL struct {
+	Digital string
+	Monthly string
+	Mono    string
+}
+
+// ActressSearchQuery は女優検索の条件。ゼロ値の項目は条件に含めない。
+type ActressSearchQuery struct {
+	Keyword string
+	// Initial は名前の頭文字（ひらがな 1 文字）。
+	Initial string
+
+	GteBust   int32
+	LteBust   int32
+	GteWaist  int32
+	LteWaist  int32
+	GteHip    int32
+	LteHip    int32
+	GteHeight int32
+	LteHeight int32
+	// GteBirthday・LteBirthday は生年月日の範囲（YYYY-MM-DD）。
+	GteBirthday string
+	LteBirthday string
+
+	Sort   string
+	Hits   int32
+	Offset int32
+}
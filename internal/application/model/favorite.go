@@ -12,6 +12,9 @@ type FavoriteVideo struct {
 	UserID            string
 	VideoID           string
 	CreatedAt         string
+
+	// Detail holds the catalog lookup of the video when details are requested; nil otherwise.
+	Detail *VideoLookup
 }
 
 // FavoriteActor represents a favorite actor DTO used by the application layer.
@@ -20,6 +23,66 @@ type FavoriteActor struct {
 	UserID            string
 	ActorID           string
 	CreatedAt         string
+
+	// Detail holds the catalog lookup of the actress when details are requested; nil otherwise.
+	Detail *ActressLookup
+}
+
+// FavoriteArticle represents a favorite genre, maker, series or director DTO used by the application layer.
+type FavoriteArticle struct {
+	FavoriteArticleUUID string
+	UserID              string
+	ArticleType         entity.ArticleType
+	ArticleID           string
+	CreatedAt           string
+}
+
+// FavoriteSortOrder selects the order favorites are listed in.
+type FavoriteSortOrder int
+
+const (
+	// FavoriteSortOldestFirst lists favorites in the order they were added. This is the default.
+	FavoriteSortOldestFirst FavoriteSortOrder = iota
+	// FavoriteSortNewestFirst lists the most recently added favorites first.
+	FavoriteSortNewestFirst
+)
+
+// FavoriteListQuery describes a page request for a favorites listing.
+type FavoriteListQuery struct {
+	// PageSize is the maximum number of favorites to return; 0 selects the default.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page, or empty for the first page.
+	PageToken string
+	Order     FavoriteSortOrder
+	// IncludeDetails embeds catalog details in each favorite.
+	IncludeDetails bool
+}
+
+// FavoriteVideoPage is one page of a user's favorite videos.
+type FavoriteVideoPage struct {
+	Favorites []FavoriteVideo
+	// NextPageToken fetches the following page; empty on the last page.
+	NextPageToken string
+	// TotalCount is the number of favorite videos the user has across all pages.
+	TotalCount int
+}
+
+// FavoriteActorPage is one page of a user's favorite actors.
+type FavoriteActorPage struct {
+	Favorites []FavoriteActor
+	// NextPageToken fetches the following page; empty on the last page.
+	NextPageToken string
+	// TotalCount is the number of favorite actors the user has across all pages.
+	TotalCount int
+}
+
+// FavoriteArticlePage is one page of a user's favorite articles.
+type FavoriteArticlePage struct {
+	Favorites []FavoriteArticle
+	// NextPageToken fetches the following page; empty on the last page.
+	NextPageToken string
+	// TotalCount is the number of matching favorite articles the user has across all pages.
+	TotalCount int
 }
 
 // NewFavoriteVideoFromEntity converts a domain entity to an application model.
@@ -41,3 +104,14 @@ func NewFavoriteActorFromEntity(e entity.FavoriteActor) FavoriteActor {
 		CreatedAt:         e.CreatedAt.UTC().Format(time.RFC3339),
 	}
 }
+
+// NewFavoriteArticleFromEntity converts a domain entity to an application model.
+func NewFavoriteArticleFromEntity(e entity.FavoriteArticle) FavoriteArticle {
+	return FavoriteArticle{
+		FavoriteArticleUUID: e.FavoriteArticleUUID,
+		UserID:              e.UserID,
+		ArticleType:         e.ArticleType,
+		ArticleID:           e.ArticleID,
+		CreatedAt:           e.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
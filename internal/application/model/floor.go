@@ -0,0 +1,24 @@
+package model
+
+// FloorSelector は検索対象のサイト・サービス・フロアの組。空の項目は既定値（FANZA / digital / videoa）を使う。
+type FloorSelector struct {
+	Site    string
+	Service string
+	Floor   string
+}
+
+// Floor は FloorList API で提供されているフロアの 1 件。
+type Floor struct {
+	SiteName    string
+	SiteCode    string
+	ServiceName string
+	ServiceCode string
+	FloorID     string
+	FloorName   string
+	FloorCode   string
+}
+
+// Selector はフロアを検索条件に指定するための FloorSelector を返す。
+func (f Floor) Selector() FloorSelector {
+	return FloorSelector{Site: f.SiteCode, Service: f.ServiceCode, Floor: f.FloorCode}
+}
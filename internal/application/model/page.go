@@ -0,0 +1,23 @@
+package model
+
+// MaxOffset は一覧取得で指定できる開始位置の上限。
+const MaxOffset int32 = 50000
+
+// ClampHits は取得件数を maxHits 以下に収める。0 以下は上流の既定件数を使うためそのまま返す。
+func ClampHits(hits, maxHits int32) int32 {
+	if hits > maxHits {
+		return maxHits
+	}
+	return hits
+}
+
+// ClampOffset は開始位置を 0 以上 MaxOffset 以下に収める。
+func ClampOffset(offset int32) int32 {
+	if offset < 0 {
+		return 0
+	}
+	if offset > MaxOffset {
+		return MaxOffset
+	}
+	return offset
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// Playlist represents a playlist DTO used by the application layer.
+type Playlist struct {
+	PlaylistUUID string
+	OwnerID      string
+	Name         string
+	Description  string
+	Visibility   entity.PlaylistVisibility
+	ItemCount    int
+	CreatedAt    string
+	UpdatedAt    string
+
+	// Items holds the playlist's videos in position order when the playlist is fetched on its own; nil in listings.
+	Items []PlaylistItem
+}
+
+// PlaylistItem represents a video in a playlist.
+type PlaylistItem struct {
+	VideoID  string
+	Position int
+	AddedAt  string
+}
+
+// PlaylistInput holds the fields of a new playlist.
+type PlaylistInput struct {
+	Name        string
+	Description string
+	// Visibility defaults to private when empty.
+	Visibility entity.PlaylistVisibility
+}
+
+// PlaylistUpdate holds the playlist fields to change; nil or empty fields are left unchanged.
+type PlaylistUpdate struct {
+	Name        *string
+	Description *string
+	Visibility  entity.PlaylistVisibility
+}
+
+// NewPlaylistFromEntity converts a domain entity to an application model.
+func NewPlaylistFromEntity(e entity.Playlist) Playlist {
+	return Playlist{
+		PlaylistUUID: e.PlaylistUUID,
+		OwnerID:      e.UserID,
+		Name:         e.Name,
+		Description:  e.Description,
+		Visibility:   e.Visibility,
+		ItemCount:    e.ItemCount,
+		CreatedAt:    e.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:    e.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// NewPlaylistItemFromEntity converts a domain entity to an application model.
+func NewPlaylistItemFromEntity(e entity.PlaylistItem) PlaylistItem {
+	return PlaylistItem{
+		VideoID:  e.VideoID,
+		Position: e.Position,
+		AddedAt:  e.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
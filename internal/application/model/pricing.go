@@ -0,0 +1,93 @@
+package model
+
+import "strings"
+
+// CurrencyJPY は DMM の価格の通貨。
+const CurrencyJPY = "JPY"
+
+// DeliveryType は配信形式。
+type DeliveryType string
+
+const (
+	DeliveryStream   DeliveryType = "stream"
+	DeliveryDownload DeliveryType = "download"
+	DeliveryHD       DeliveryType = "hd"
+	Delivery4K       DeliveryType = "4k"
+)
+
+// ParseDeliveryType は配信形式の文字列を DeliveryType に変換する。
+// 上記以外の形式（androiddl など）もそのまま小文字で保持する。
+func ParseDeliveryType(s string) DeliveryType {
+	return DeliveryType(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// DeliveryPrice は配信形式ごとの価格。
+type DeliveryPrice struct {
+	Type            DeliveryType
+	Price           int // 現在の販売価格
+	ListPrice       int // 定価。不明な場合は 0
+	DiscountPercent int // 定価からの割引率（%、切り捨て）。割引がない場合は 0
+}
+
+// NewDeliveryPrice は販売価格と定価から割引率を計算した DeliveryPrice を返す。
+func NewDeliveryPrice(deliveryType DeliveryType, price, listPrice int) DeliveryPrice {
+	return DeliveryPrice{
+		Type:            deliveryType,
+		Price:           price,
+		ListPrice:       listPrice,
+		DiscountPercent: DiscountPercent(price, listPrice),
+	}
+}
+
+// OnSale は定価より安く販売されているかを返す。
+func (p DeliveryPrice) OnSale() bool {
+	return p.DiscountPercent > 0
+}
+
+// DiscountPercent は定価に対する販売価格の割引率（%）を切り捨てで返す。
+// 定価が不明、または販売価格が定価以上の場合は 0 を返す。
+func DiscountPercent(price, listPrice int) int {
+	if listPrice <= 0 || price < 0 || price >= listPrice {
+		return 0
+	}
+	return (listPrice - price) * 100 / listPrice
+}
+
+// Pricing は動画の配信形式ごとの価格。金額の単位は Currency。
+type Pricing struct {
+	Currency   string
+	Deliveries []DeliveryPrice
+}
+
+// Delivery は指定した配信形式の価格を返す。
+func (p Pricing) Delivery(deliveryType DeliveryType) (DeliveryPrice, bool) {
+	for _, d := range p.Deliveries {
+		if d.Type == deliveryType {
+			return d, true
+		}
+	}
+	return DeliveryPrice{}, false
+}
+
+// OnSale はいずれかの配信形式がセール中かを返す。
+func (p Pricing) OnSale() bool {
+	return p.MaxDiscountPercent() > 0
+}
+
+// MaxDiscountPercent は配信形式の中で最も大きい割引率を返す。
+func (p Pricing) MaxDiscountPercent() int {
+	maxDiscount := 0
+	for _, d := range p.Deliveries {
+		if d.DiscountPercent > maxDiscount {
+			maxDiscount = d.DiscountPercent
+		}
+	}
+	return maxDiscount
+}
+
+// PageSaleOptions は取得した 1 ページ内だけに適用するセール関連の条件。
+// DMM API にはセールの条件がないため、ページをまたいだ絞り込み・並べ替えはできない。
+type PageSaleOptions struct {
+	FilterOnSale   bool // セール中の動画だけを残す
+	SortByDiscount bool // 割引率が高い順に並べ替える
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscountPercent(t *testing.T) {
+	tests := []struct {
+		name      string
+		price     int
+		listPrice int
+		expected  int
+	}{
+		{name: "割引あり", price: 300, listPrice: 500, expected: 40},
+		{name: "端数は切り捨て", price: 980, listPrice: 1480, expected: 33},
+		{name: "定価と同じ", price: 1980, listPrice: 1980, expected: 0},
+		{name: "定価より高い", price: 2000, listPrice: 1980, expected: 0},
+		{name: "定価が不明", price: 1980, listPrice: 0, expected: 0},
+		{name: "無料", price: 0, listPrice: 500, expected: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DiscountPercent(tt.price, tt.listPrice))
+		})
+	}
+}
+
+func TestPricing(t *testing.T) {
+	pricing := Pricing{
+		Currency: CurrencyJPY,
+		Deliveries: []DeliveryPrice{
+			NewDeliveryPrice(ParseDeliveryType("stream"), 300, 500),
+			NewDeliveryPrice(ParseDeliveryType(" 4K "), 2980, 2980),
+		},
+	}
+
+	assert.True(t, pricing.OnSale())
+	assert.Equal(t, 40, pricing.MaxDiscountPercent())
+
+	uhd, ok := pricing.Delivery(Delivery4K)
+	assert.True(t, ok)
+	assert.False(t, uhd.OnSale())
+
+	_, ok = pricing.Delivery(DeliveryHD)
+	assert.False(t, ok)
+
+	assert.False(t, Pricing{}.OnSale())
+}
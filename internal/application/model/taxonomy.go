@@ -0,0 +1,29 @@
+package model
+
+// TaxonomyKind は動画の分類（ジャンル・メーカー・シリーズ）の種類。
+type TaxonomyKind string
+
+const (
+	TaxonomyGenre  TaxonomyKind = "genre"
+	TaxonomyMaker  TaxonomyKind = "maker"
+	TaxonomySeries TaxonomyKind = "series"
+)
+
+// TaxonomyEntry は分類の一覧の 1 件。ID は動画の Genres・Makers・Series の ID と対応する。
+type TaxonomyEntry struct {
+	ID   string
+	Name string
+	Ruby string
+	// ListURL は該当作品の一覧ページの URL。
+	ListURL string
+}
+
+// TaxonomyQuery は分類の一覧を取得する条件。ゼロ値の項目は条件に含めない。
+type TaxonomyQuery struct {
+	// FloorID は対象フロアの ID。空の場合は既定のフロアを使う。
+	FloorID string
+	// Initial は名前の頭文字（ひらがな 1 文字）。
+	Initial string
+	Hits    int32
+	Offset  int32
+}
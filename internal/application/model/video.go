@@ -14,15 +14,47 @@ type Video struct {
 	Price        int
 	LikesCount   int
 
+	ProductID      string // 品番
+	MakerProduct   string // メーカー品番
+	RuntimeMinutes int    // 収録時間（分）。不明な場合は 0
+	ListPrice      int    // 定価。不明な場合は 0
+
+	Pricing      Pricing
+	SampleImages []SampleImage
+	SampleMovies SampleMovies
+	Campaigns    []Campaign
+
 	Actresses []Actress
 	Genres    []Genre
 	Makers    []Maker
 	Series    []Series
 	Directors []Director
+	Labels    []Label
 
 	Review Review
 }
 
+// SampleImage はサンプル画像。大きい画像がない場合 Large は空になる。
+type SampleImage struct {
+	Small string
+	Large string
+}
+
+// SampleMovies はサイズ別のサンプル動画の URL。
+type SampleMovies struct {
+	Size476x306 string
+	Size560x360 string
+	Size644x414 string
+	Size720x480 string
+}
+
+// Campaign は動画に適用されているキャンペーン。
+type Campaign struct {
+	Title   string
+	BeginAt time.Time
+	EndAt   time.Time
+}
+
 // Actress は出演女優情報。
 type Actress struct {
 	ID   string
@@ -53,12 +85,35 @@ type Director struct {
 	Name string
 }
 
+// Label はレーベル情報。
+type Label struct {
+	ID   string
+	Name string
+}
+
 // Review はレビュー情報。
 type Review struct {
 	Count   int
 	Average float32
 }
 
+// LookupStatus は ID を指定してカタログから取得した結果の状態。
+type LookupStatus int
+
+const (
+	LookupFound    LookupStatus = iota + 1 // 取得できた
+	LookupNotFound                         // カタログに存在しない
+	LookupFailed                           // 一時的なエラーで取得できなかった（再試行可）
+)
+
+// VideoLookup は ID ごとの動画の取得結果。Video は Status が LookupFound の場合のみ設定する。
+type VideoLookup struct {
+	DmmID  string
+	Status LookupStatus
+	Video  *Video
+	Err    error // LookupFailed の原因
+}
+
 // SearchMetadata は検索結果のメタデータ。
 type SearchMetadata struct {
 	ResultCount   int
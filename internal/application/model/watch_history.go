@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// WatchHistory represents a user's playback state for one video.
+type WatchHistory struct {
+	VideoID         string
+	PositionSeconds float64
+	DurationSeconds float64
+	// Progress is the watched ratio in [0, 1]; 0 when the duration is unknown.
+	Progress float64
+	// ResumePositionSeconds is where playback should resume; 0 once the video was left at the end.
+	ResumePositionSeconds float64
+	Completed             bool
+	LastWatchedAt         string
+}
+
+// WatchHistoryQuery describes a page of a user's watch history, most recently watched first.
+type WatchHistoryQuery struct {
+	// PageSize is the number of entries per page; 0 selects the default.
+	PageSize int
+	// PageToken is the next_page_token of the previous page; empty for the first page.
+	PageToken string
+	// InProgressOnly keeps only videos that can be resumed.
+	InProgressOnly bool
+}
+
+// WatchHistoryPage is a page of watch history.
+type WatchHistoryPage struct {
+	Entries []WatchHistory
+	// NextPageToken fetches the following page; empty on the last page.
+	NextPageToken string
+}
+
+// ResumePosition is the playback position to resume a video from.
+type ResumePosition struct {
+	VideoID         string
+	PositionSeconds float64
+	DurationSeconds float64
+	Completed       bool
+	// HasHistory is false when the user has never played the video; the position is then 0.
+	HasHistory bool
+}
+
+// NewWatchHistoryFromEntity converts a domain entity to an application model.
+func NewWatchHistoryFromEntity(e entity.WatchHistory) WatchHistory {
+	return WatchHistory{
+		VideoID:               e.VideoID,
+		PositionSeconds:       e.PositionSeconds,
+		DurationSeconds:       e.DurationSeconds,
+		Progress:              e.Progress(),
+		ResumePositionSeconds: e.ResumePositionSeconds(),
+		Completed:             e.Completed,
+		LastWatchedAt:         e.LastEventAt.UTC().Format(time.RFC3339),
+	}
+}
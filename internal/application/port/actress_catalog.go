@@ -0,0 +1,15 @@
+package port
+
+//go:generate mockgen -destination=mock/mock_actress_catalog.go -package=mock github.com/tikfack/server/internal/application/port ActressCatalog
+
+import (
+	"context"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// ActressCatalog は外部カタログの女優情報へアクセスするポート。
+type ActressCatalog interface {
+	GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error)
+	SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error)
+}
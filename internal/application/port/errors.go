@@ -0,0 +1,76 @@
+package port
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// カタログ呼び出しの失敗種別。errors.Is(err, ErrCatalogNotFound) のように判定する。
+var (
+	ErrCatalogNotFound         = errors.New("catalog: not found")
+	ErrCatalogInvalidParameter = errors.New("catalog: invalid parameter")
+	ErrCatalogRateLimited      = errors.New("catalog: rate limited")
+	ErrCatalogThrottled        = errors.New("catalog: client-side rate limit exceeded")
+	ErrCatalogUnavailable      = errors.New("catalog: upstream unavailable")
+	ErrCatalogDecode           = errors.New("catalog: decode failure")
+	ErrCatalogUpstreamStatus   = errors.New("catalog: upstream returned error status")
+)
+
+// CatalogError は VideoCatalog 実装が返す分類済みのエラー。
+// Kind には上記の種別のいずれかを設定し、Err には元のエラーを保持する。
+type CatalogError struct {
+	Kind error
+	// Status は上流が返したステータス（HTTP ステータスまたは DMM の result.status）。不明な場合は 0。
+	Status int
+	// RetryAfter は上流が指示した再試行までの待機時間。指示がない場合は 0。
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *CatalogError) Error() string {
+	if e.Err == nil {
+		return e.Kind.Error()
+	}
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Unwrap は種別と元のエラーの両方を返し、どちらも errors.Is / errors.As で辿れるようにする。
+func (e *CatalogError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// Retryable は時間をおいて再試行すれば成功しうる失敗かどうかを返す。
+func (e *CatalogError) Retryable() bool {
+	switch e.Kind {
+	case ErrCatalogRateLimited, ErrCatalogThrottled, ErrCatalogUnavailable:
+		return true
+	case ErrCatalogUpstreamStatus:
+		return e.Status >= 500
+	default:
+		return false
+	}
+}
+
+// IsRetryable は err に再試行可能な CatalogError が含まれるかどうかを返す。
+func IsRetryable(err error) bool {
+	var catalogErr *CatalogError
+	return errors.As(err, &catalogErr) && catalogErr.Retryable()
+}
+
+// LookupStatusOf は ID を指定した取得の結果（見つかったか・エラー）を model.LookupStatus に分類する。
+// 形式が不正な ID も再試行で取得できることはないため、見つからないものとして扱う。
+func LookupStatusOf(found bool, err error) model.LookupStatus {
+	switch {
+	case err == nil && found:
+		return model.LookupFound
+	case err == nil, errors.Is(err, ErrCatalogNotFound), errors.Is(err, ErrCatalogInvalidParameter):
+		return model.LookupNotFound
+	default:
+		return model.LookupFailed
+	}
+}
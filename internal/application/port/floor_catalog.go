@@ -0,0 +1,18 @@
+package port
+
+//go:generate mockgen -destination=mock/mock_floor_catalog.go -package=mock github.com/tikfack/server/internal/application/port FloorCatalog
+
+import (
+	"context"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// FloorCatalog は外部カタログが提供するフロアの一覧を扱うポート。
+type FloorCatalog interface {
+	// ListFloors は利用できるフロアの一覧を返す
+	ListFloors(ctx context.Context) ([]model.Floor, error)
+	// ResolveFloor は空の項目を既定値で補い、実在する組み合わせか検証する。
+	// 存在しない組み合わせの場合は ErrCatalogInvalidParameter の CatalogError を返す。
+	ResolveFloor(ctx context.Context, selector model.FloorSelector) (model.FloorSelector, error)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/tikfack/server/internal/application/port (interfaces: ActressCatalog)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_actress_catalog.go -package=mock github.com/tikfack/server/internal/application/port ActressCatalog
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/tikfack/server/internal/application/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActressCatalog is a mock of ActressCatalog interface.
+type MockActressCatalog struct {
+	ctrl     *gomock.Controller
+	recorder *MockActressCatalogMockRecorder
+	isgomock struct{}
+}
+
+// MockActressCatalogMockRecorder is the mock recorder for MockActressCatalog.
+type MockActressCatalogMockRecorder struct {
+	mock *MockActressCatalog
+}
+
+// NewMockActressCatalog creates a new mock instance.
+func NewMockActressCatalog(ctrl *gomock.Controller) *MockActressCatalog {
+	mock := &MockActressCatalog{ctrl: ctrl}
+	mock.recorder = &MockActressCatalogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActressCatalog) EXPECT() *MockActressCatalogMockRecorder {
+	return m.recorder
+}
+
+// GetActress mocks base method.
+func (m *MockActressCatalog) GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActress", ctx, actressID)
+	ret0, _ := ret[0].(*model.ActressProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActress indicates an expected call of GetActress.
+func (mr *MockActressCatalogMockRecorder) GetActress(ctx, actressID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActress", reflect.TypeOf((*MockActressCatalog)(nil).GetActress), ctx, actressID)
+}
+
+// SearchActresses mocks base method.
+func (m *MockActressCatalog) SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchActresses", ctx, query)
+	ret0, _ := ret[0].([]model.ActressProfile)
+	ret1, _ := ret[1].(*model.SearchMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchActresses indicates an expected call of SearchActresses.
+func (mr *MockActressCatalogMockRecorder) SearchActresses(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchActresses", reflect.TypeOf((*MockActressCatalog)(nil).SearchActresses), ctx, query)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/tikfack/server/internal/application/port (interfaces: FloorCatalog)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_floor_catalog.go -package=mock github.com/tikfack/server/internal/application/port FloorCatalog
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/tikfack/server/internal/application/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFloorCatalog is a mock of FloorCatalog interface.
+type MockFloorCatalog struct {
+	ctrl     *gomock.Controller
+	recorder *MockFloorCatalogMockRecorder
+	isgomock struct{}
+}
+
+// MockFloorCatalogMockRecorder is the mock recorder for MockFloorCatalog.
+type MockFloorCatalogMockRecorder struct {
+	mock *MockFloorCatalog
+}
+
+// NewMockFloorCatalog creates a new mock instance.
+func NewMockFloorCatalog(ctrl *gomock.Controller) *MockFloorCatalog {
+	mock := &MockFloorCatalog{ctrl: ctrl}
+	mock.recorder = &MockFloorCatalogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFloorCatalog) EXPECT() *MockFloorCatalogMockRecorder {
+	return m.recorder
+}
+
+// ListFloors mocks base method.
+func (m *MockFloorCatalog) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", ctx)
+	ret0, _ := ret[0].([]model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockFloorCatalogMockRecorder) ListFloors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockFloorCatalog)(nil).ListFloors), ctx)
+}
+
+// ResolveFloor mocks base method.
+func (m *MockFloorCatalog) ResolveFloor(ctx context.Context, selector model.FloorSelector) (model.FloorSelector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFloor", ctx, selector)
+	ret0, _ := ret[0].(model.FloorSelector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFloor indicates an expected call of ResolveFloor.
+func (mr *MockFloorCatalogMockRecorder) ResolveFloor(ctx, selector any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFloor", reflect.TypeOf((*MockFloorCatalog)(nil).ResolveFloor), ctx, selector)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/tikfack/server/internal/application/port (interfaces: TaxonomyCatalog)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mock_taxonomy_catalog.go -package=mock github.com/tikfack/server/internal/application/port TaxonomyCatalog
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/tikfack/server/internal/application/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaxonomyCatalog is a mock of TaxonomyCatalog interface.
+type MockTaxonomyCatalog struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxonomyCatalogMockRecorder
+	isgomock struct{}
+}
+
+// MockTaxonomyCatalogMockRecorder is the mock recorder for MockTaxonomyCatalog.
+type MockTaxonomyCatalogMockRecorder struct {
+	mock *MockTaxonomyCatalog
+}
+
+// NewMockTaxonomyCatalog creates a new mock instance.
+func NewMockTaxonomyCatalog(ctrl *gomock.Controller) *MockTaxonomyCatalog {
+	mock := &MockTaxonomyCatalog{ctrl: ctrl}
+	mock.recorder = &MockTaxonomyCatalogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxonomyCatalog) EXPECT() *MockTaxonomyCatalogMockRecorder {
+	return m.recorder
+}
+
+// ListTaxonomy mocks base method.
+func (m *MockTaxonomyCatalog) ListTaxonomy(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaxonomy", ctx, kind, query)
+	ret0, _ := ret[0].([]model.TaxonomyEntry)
+	ret1, _ := ret[1].(*model.SearchMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTaxonomy indicates an expected call of ListTaxonomy.
+func (mr *MockTaxonomyCatalogMockRecorder) ListTaxonomy(ctx, kind, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaxonomy", reflect.TypeOf((*MockTaxonomyCatalog)(nil).ListTaxonomy), ctx, kind, query)
+}
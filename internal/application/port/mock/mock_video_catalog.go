@@ -43,24 +43,24 @@ func (m *MockVideoCatalog) EXPECT() *MockVideoCatalogMockRecorder {
 }
 
 // GetVideoById mocks base method.
-func (m *MockVideoCatalog) GetVideoById(ctx context.Context, dmmId string) (*model.Video, error) {
+func (m *MockVideoCatalog) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideoById", ctx, dmmId)
+	ret := m.ctrl.Call(m, "GetVideoById", ctx, floor, dmmId)
 	ret0, _ := ret[0].(*model.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVideoById indicates an expected call of GetVideoById.
-func (mr *MockVideoCatalogMockRecorder) GetVideoById(ctx, dmmId any) *gomock.Call {
+func (mr *MockVideoCatalogMockRecorder) GetVideoById(ctx, floor, dmmId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoById", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideoById), ctx, dmmId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoById", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideoById), ctx, floor, dmmId)
 }
 
 // GetVideosByDate mocks base method.
-func (m *MockVideoCatalog) GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoCatalog) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByDate", ctx, targetDate, hits, offset)
+	ret := m.ctrl.Call(m, "GetVideosByDate", ctx, floor, targetDate, hits, offset)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -68,15 +68,15 @@ func (m *MockVideoCatalog) GetVideosByDate(ctx context.Context, targetDate time.
 }
 
 // GetVideosByDate indicates an expected call of GetVideosByDate.
-func (mr *MockVideoCatalogMockRecorder) GetVideosByDate(ctx, targetDate, hits, offset any) *gomock.Call {
+func (mr *MockVideoCatalogMockRecorder) GetVideosByDate(ctx, floor, targetDate, hits, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByDate), ctx, targetDate, hits, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByDate), ctx, floor, targetDate, hits, offset)
 }
 
 // GetVideosByID mocks base method.
-func (m *MockVideoCatalog) GetVideosByID(ctx context.Context, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoCatalog) GetVideosByID(ctx context.Context, floor model.FloorSelector, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate string) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByID", ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	ret := m.ctrl.Call(m, "GetVideosByID", ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -84,15 +84,15 @@ func (m *MockVideoCatalog) GetVideosByID(ctx context.Context, actressIDs, genreI
 }
 
 // GetVideosByID indicates an expected call of GetVideosByID.
-func (mr *MockVideoCatalogMockRecorder) GetVideosByID(ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor any) *gomock.Call {
+func (mr *MockVideoCatalogMockRecorder) GetVideosByID(ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByID", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByID), ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByID", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByID), ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
 }
 
 // GetVideosByKeyword mocks base method.
-func (m *MockVideoCatalog) GetVideosByKeyword(ctx context.Context, keyword string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoCatalog) GetVideosByKeyword(ctx context.Context, floor model.FloorSelector, keyword string, hits, offset int32, sort, gteDate, lteDate string) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByKeyword", ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	ret := m.ctrl.Call(m, "GetVideosByKeyword", ctx, floor, keyword, hits, offset, sort, gteDate, lteDate)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -100,15 +100,15 @@ func (m *MockVideoCatalog) GetVideosByKeyword(ctx context.Context, keyword strin
 }
 
 // GetVideosByKeyword indicates an expected call of GetVideosByKeyword.
-func (mr *MockVideoCatalogMockRecorder) GetVideosByKeyword(ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor any) *gomock.Call {
+func (mr *MockVideoCatalogMockRecorder) GetVideosByKeyword(ctx, floor, keyword, hits, offset, sort, gteDate, lteDate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByKeyword), ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoCatalog)(nil).GetVideosByKeyword), ctx, floor, keyword, hits, offset, sort, gteDate, lteDate)
 }
 
 // SearchVideos mocks base method.
-func (m *MockVideoCatalog) SearchVideos(ctx context.Context, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoCatalog) SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchVideos", ctx, keyword, actressID, genreID, makerID, seriesID, directorID)
+	ret := m.ctrl.Call(m, "SearchVideos", ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -116,7 +116,7 @@ func (m *MockVideoCatalog) SearchVideos(ctx context.Context, keyword, actressID,
 }
 
 // SearchVideos indicates an expected call of SearchVideos.
-func (mr *MockVideoCatalogMockRecorder) SearchVideos(ctx, keyword, actressID, genreID, makerID, seriesID, directorID any) *gomock.Call {
+func (mr *MockVideoCatalogMockRecorder) SearchVideos(ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVideos", reflect.TypeOf((*MockVideoCatalog)(nil).SearchVideos), ctx, keyword, actressID, genreID, makerID, seriesID, directorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVideos", reflect.TypeOf((*MockVideoCatalog)(nil).SearchVideos), ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID)
 }
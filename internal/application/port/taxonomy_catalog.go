@@ -0,0 +1,14 @@
+package port
+
+//go:generate mockgen -destination=mock/mock_taxonomy_catalog.go -package=mock github.com/tikfack/server/internal/application/port TaxonomyCatalog
+
+import (
+	"context"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// TaxonomyCatalog は外部カタログのジャンル・メーカー・シリーズの一覧へアクセスするポート。
+type TaxonomyCatalog interface {
+	ListTaxonomy(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error)
+}
@@ -8,10 +8,11 @@ import (
 )
 
 // VideoCatalog は外部動画カタログへアクセスするポート。
+// floor は検索対象のサイト・サービス・フロアで、空の項目は既定値（FANZA / digital / videoa）を使う。
 type VideoCatalog interface {
-	GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error)
-	GetVideoById(ctx context.Context, dmmId string) (*model.Video, error)
-	SearchVideos(ctx context.Context, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error)
-	GetVideosByID(ctx context.Context, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits int32, offset int32, sort string, gteDate string, lteDate string, site string, service string, floor string) ([]model.Video, *model.SearchMetadata, error)
-	GetVideosByKeyword(ctx context.Context, keyword string, hits int32, offset int32, sort string, gteDate string, lteDate string, site string, service string, floor string) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error)
+	GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error)
+	SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByID(ctx context.Context, floor model.FloorSelector, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits int32, offset int32, sort string, gteDate string, lteDate string) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByKeyword(ctx context.Context, floor model.FloorSelector, keyword string, hits int32, offset int32, sort string, gteDate string, lteDate string) ([]model.Video, *model.SearchMetadata, error)
 }
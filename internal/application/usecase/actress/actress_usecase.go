@@ -0,0 +1,70 @@
+package actress
+
+//go:generate mockgen -destination=../mock/mock_actress_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/actress ActressUsecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+const maxHits int32 = 100
+
+// ActressUsecase は女優情報の参照に関するユースケースを定義するインターフェイス
+type ActressUsecase interface {
+	// GetActress は指定された女優IDのプロフィールを取得する
+	GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error)
+
+	// SearchActresses は名前や頭文字、サイズなどの条件で女優を検索する
+	SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error)
+}
+
+// actressUsecase は ActressUsecase の実装
+type actressUsecase struct {
+	catalog port.ActressCatalog
+	logger  *slog.Logger
+}
+
+// NewActressUsecase は ActressCatalog ポートを受け取り ActressUsecase を返す
+func NewActressUsecase(catalog port.ActressCatalog) ActressUsecase {
+	if catalog == nil {
+		panic("actress catalog must be provided")
+	}
+	return &actressUsecase{
+		catalog: catalog,
+		logger:  slog.Default().With(slog.String("component", "actress_usecase")),
+	}
+}
+
+func (u *actressUsecase) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return u.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// GetActress は指定された女優IDのプロフィールを取得する
+func (u *actressUsecase) GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error) {
+	logger := u.loggerWithCtx(ctx)
+	logger.Debug("GetActress called", "actressID", actressID)
+	return u.catalog.GetActress(ctx, actressID)
+}
+
+// SearchActresses は件数と開始位置を上限内に収めてから女優を検索する
+func (u *actressUsecase) SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error) {
+	logger := u.loggerWithCtx(ctx)
+	query.Hits = model.ClampHits(query.Hits, maxHits)
+	query.Offset = model.ClampOffset(query.Offset)
+	logger.Debug("SearchActresses called",
+		"keyword", query.Keyword,
+		"initial", query.Initial,
+		"sort", query.Sort,
+		"hits", query.Hits,
+		"offset", query.Offset,
+	)
+	return u.catalog.SearchActresses(ctx, query)
+}
@@ -0,0 +1,63 @@
+package actress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+)
+
+var testActress = model.ActressProfile{ID: "1011199", Name: "女優A", Bust: 88, Height: 160}
+
+func TestGetActress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalog := mockcatalog.NewMockActressCatalog(ctrl)
+	catalog.EXPECT().GetActress(gomock.Any(), "1011199").Return(&testActress, nil)
+
+	got, err := NewActressUsecase(catalog).GetActress(context.Background(), "1011199")
+	require.NoError(t, err)
+	require.Equal(t, &testActress, got)
+}
+
+func TestSearchActresses(t *testing.T) {
+	cases := []struct {
+		name   string
+		query  model.ActressSearchQuery
+		expect model.ActressSearchQuery
+	}{
+		{
+			name:   "normal request",
+			query:  model.ActressSearchQuery{Initial: "あ", Hits: 20, Offset: 1},
+			expect: model.ActressSearchQuery{Initial: "あ", Hits: 20, Offset: 1},
+		},
+		{
+			name:   "clamp hits and offset",
+			query:  model.ActressSearchQuery{Keyword: "kw", Hits: 500, Offset: -5},
+			expect: model.ActressSearchQuery{Keyword: "kw", Hits: maxHits, Offset: 0},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			metadata := &model.SearchMetadata{ResultCount: 1, TotalCount: 1, FirstPosition: 1}
+			catalog := mockcatalog.NewMockActressCatalog(ctrl)
+			catalog.EXPECT().
+				SearchActresses(gomock.Any(), tt.expect).
+				Return([]model.ActressProfile{testActress}, metadata, nil)
+
+			actresses, md, err := NewActressUsecase(catalog).SearchActresses(context.Background(), tt.query)
+			require.NoError(t, err)
+			require.Equal(t, []model.ActressProfile{testActress}, actresses)
+			require.Equal(t, metadata, md)
+		})
+	}
+}
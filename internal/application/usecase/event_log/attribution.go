@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"sync/atomic"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// ReasonUserMismatch rejects events whose user_id is not the authenticated user.
+const ReasonUserMismatch = "USER_MISMATCH"
+
+// Caller identifies who sends events.
+type Caller struct {
+	// UserID is the subject of the verified access token; empty for anonymous requests.
+	UserID string
+}
+
+// AttributionStats counts how events were attributed since startup. The totals are logged with each mismatch.
+type AttributionStats struct {
+	// Authenticated and Anonymous count accepted attributions.
+	Authenticated uint64
+	Anonymous     uint64
+	// Mismatched counts events rejected because they claimed another user.
+	Mismatched uint64
+}
+
+type attributionCounters struct {
+	authenticated atomic.Uint64
+	anonymous     atomic.Uint64
+	mismatched    atomic.Uint64
+}
+
+func (c *attributionCounters) stats() AttributionStats {
+	return AttributionStats{
+		Authenticated: c.authenticated.Load(),
+		Anonymous:     c.anonymous.Load(),
+		Mismatched:    c.mismatched.Load(),
+	}
+}
+
+// attribute sets the event's user from the caller rather than trusting the client.
+// A user_id other than the caller's, or any user_id on an anonymous request, is rejected;
+// anonymous events must carry a device_id instead.
+func (c *attributionCounters) attribute(caller Caller, e *entity.EventLog) *ValidationError {
+	if e.UserID != "" && e.UserID != caller.UserID {
+		c.mismatched.Add(1)
+		if caller.UserID == "" {
+			return &ValidationError{Reason: ReasonUserMismatch, Field: "user_id", Message: "user_id requires an authenticated request"}
+		}
+		return &ValidationError{Reason: ReasonUserMismatch, Field: "user_id", Message: "user_id does not match the authenticated user"}
+	}
+	if caller.UserID != "" {
+		e.UserID = caller.UserID
+		c.authenticated.Add(1)
+		return nil
+	}
+	if e.DeviceID == "" {
+		return &ValidationError{Reason: ReasonMissingField, Field: "device_id", Message: "device_id is required for anonymous events"}
+	}
+	c.anonymous.Add(1)
+	return nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// DefaultDedupeWindow is how long the dedupe keys of recorded events are remembered by default.
+const DefaultDedupeWindow = 24 * time.Hour
+
+// claimDedupeKeys drops the events already recorded within the dedupe window and claims the keys of the others.
+// It returns the events to persist, the keys to release if persisting them fails, and the number of duplicates.
+// When the store fails every event is persisted: a duplicate is preferred to a lost event, and consumers can
+// still drop it by its Kafka message key.
+func (s *eventLogService) claimDedupeKeys(ctx context.Context, logs []*entity.EventLog) ([]*entity.EventLog, []string, int) {
+	if s.dedupe == nil {
+		return logs, nil, 0
+	}
+	keys := make([]string, 0, len(logs))
+	keyed := make([]int, 0, len(logs))
+	for i, log := range logs {
+		if key := log.DedupeKey(); key != "" {
+			keys = append(keys, key)
+			keyed = append(keyed, i)
+		}
+	}
+	if len(keys) == 0 {
+		return logs, nil, 0
+	}
+
+	seen, err := s.dedupe.Claim(ctx, keys)
+	if err != nil {
+		logger.LoggerWithCtx(ctx).Warn("failed to claim dedupe keys; recording without deduplication",
+			"event_count", len(logs), "error", err)
+		return logs, nil, 0
+	}
+	duplicate := make([]bool, len(logs))
+	claimed := make([]string, 0, len(keys))
+	duplicates := 0
+	for j, i := range keyed {
+		if seen[j] {
+			duplicate[i] = true
+			duplicates++
+			continue
+		}
+		claimed = append(claimed, keys[j])
+	}
+	if duplicates == 0 {
+		return logs, claimed, 0
+	}
+
+	fresh := make([]*entity.EventLog, 0, len(logs)-duplicates)
+	for i, log := range logs {
+		if !duplicate[i] {
+			fresh = append(fresh, log)
+		}
+	}
+	logger.LoggerWithCtx(ctx).Info("dropped duplicate events", "duplicates", duplicates, "event_count", len(logs))
+	return fresh, claimed, duplicates
+}
+
+// releaseDedupeKeys forgets keys claimed for events that could not be persisted, so that retries are recorded.
+// It runs even when ctx has been canceled, since a failed persist is often caused by cancellation.
+func (s *eventLogService) releaseDedupeKeys(ctx context.Context, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := s.dedupe.Release(context.WithoutCancel(ctx), keys); err != nil {
+		logger.LoggerWithCtx(ctx).Error("failed to release dedupe keys; retries of these events will be dropped",
+			"key_count", len(keys), "error", err)
+	}
+}
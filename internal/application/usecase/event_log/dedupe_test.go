@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// mapDedupeStore remembers claimed keys forever and can be made to fail.
+type mapDedupeStore struct {
+	keys       map[string]bool
+	claimErr   error
+	releaseErr error
+}
+
+func newMapDedupeStore() *mapDedupeStore {
+	return &mapDedupeStore{keys: map[string]bool{}}
+}
+
+func (m *mapDedupeStore) Claim(ctx context.Context, keys []string) ([]bool, error) {
+	if m.claimErr != nil {
+		return nil, m.claimErr
+	}
+	seen := make([]bool, len(keys))
+	for i, key := range keys {
+		seen[i] = m.keys[key]
+		m.keys[key] = true
+	}
+	return seen, nil
+}
+
+func (m *mapDedupeStore) Release(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		delete(m.keys, key)
+	}
+	return m.releaseErr
+}
+
+// failingRepository fails every insert.
+type failingRepository struct{ err error }
+
+func (r failingRepository) InsertEventLog(ctx context.Context, log *entity.EventLog) error {
+	return r.err
+}
+
+func (r failingRepository) InsertEventLogs(ctx context.Context, logs []*entity.EventLog) error {
+	return r.err
+}
+
+func keyed(eventType, key string) *entity.EventLog {
+	e := event(eventType, nil)
+	e.IdempotencyKey = key
+	return e
+}
+
+func TestRecordBatch_DropsDuplicates(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.dedupe = newMapDedupeStore()
+	ctx := context.Background()
+
+	result, err := s.RecordBatch(ctx, Caller{}, []*entity.EventLog{
+		keyed("like", "k1"),
+		keyed("like", "k1"),
+		event("like", nil), // events without a key are never deduplicated
+		event("like", nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, result.Accepted)
+	require.Equal(t, 1, result.Duplicates)
+	require.Len(t, repo.inserted, 3)
+
+	// a retry of a recorded event is accepted without recording it again
+	result, err = s.Record(ctx, Caller{}, keyed("like", "k1"))
+	require.NoError(t, err)
+	require.Equal(t, &RecordResult{Accepted: 1, Duplicates: 1}, result)
+	require.Len(t, repo.inserted, 3)
+
+	// keys are scoped to the user or device that sent them
+	other := keyed("like", "k1")
+	other.DeviceID = "device-2"
+	result, err = s.Record(ctx, Caller{}, other)
+	require.NoError(t, err)
+	require.Zero(t, result.Duplicates)
+	result, err = s.Record(ctx, Caller{UserID: "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"}, keyed("like", "k1"))
+	require.NoError(t, err)
+	require.Zero(t, result.Duplicates)
+	require.Len(t, repo.inserted, 5)
+}
+
+func TestRecordBatch_ReleasesKeysWhenPersistFails(t *testing.T) {
+	store := newMapDedupeStore()
+	s := newTestService(&recordingRepository{})
+	s.dedupe = store
+	s.repo = failingRepository{err: errors.New("database is down")}
+	ctx := context.Background()
+
+	_, err := s.RecordBatch(ctx, Caller{}, []*entity.EventLog{keyed("like", "k1")})
+	require.Error(t, err)
+	require.Empty(t, store.keys)
+
+	// the retry is recorded once the repository is back
+	repo := &recordingRepository{}
+	s.repo = repo
+	result, err := s.RecordBatch(ctx, Caller{}, []*entity.EventLog{keyed("like", "k1")})
+	require.NoError(t, err)
+	require.Zero(t, result.Duplicates)
+	require.Len(t, repo.inserted, 1)
+}
+
+func TestRecordBatch_RecordsEverythingWhenDedupeStoreFails(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.dedupe = &mapDedupeStore{claimErr: errors.New("dedupe store is down")}
+
+	result, err := s.RecordBatch(context.Background(), Caller{}, []*entity.EventLog{keyed("like", "k1"), keyed("like", "k1")})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Accepted)
+	require.Zero(t, result.Duplicates)
+	require.Len(t, repo.inserted, 2)
+}
+
+func TestRecordStream_AcksDuplicates(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.dedupe = newMapDedupeStore()
+
+	var acked []string
+	err := s.RecordStream(context.Background(), Caller{}, sliceReceiver([]StreamEvent{
+		streamed("a", keyed("like", "k1")),
+		streamed("b", keyed("like", "k1")),
+	}, nil), func(ack *StreamAck) error {
+		acked = append(acked, ack.AckIDs...)
+		require.Empty(t, ack.Rejections)
+		return nil
+	})
+	require.NoError(t, err)
+	// the resent copy is acknowledged so that the client stops resending it
+	require.Equal(t, []string{"a", "b"}, acked)
+	require.Len(t, repo.inserted, 1)
+}
@@ -2,38 +2,140 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/tikfack/server/internal/domain/entity"
 	repo "github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/middleware/logger"
 )
 
 // EventLogUsecase defines business operations for processing event logs
 // It works with domain entities rather than protobuf types.
 type EventLogUsecase interface {
-	// Record processes and persists a single EventLog entity
-	Record(ctx context.Context, log *entity.EventLog) error
+	// Record validates, attributes to the caller and persists a single EventLog entity.
+	// An invalid event is rejected with a *ValidationError; a duplicate is accepted without persisting it again.
+	Record(ctx context.Context, caller Caller, log *entity.EventLog) (*RecordResult, error)
 
-	// RecordBatch processes and persists multiple EventLog entities
-	RecordBatch(ctx context.Context, logs []*entity.EventLog) error
+	// RecordBatch validates multiple EventLog entities, attributes them to the caller and persists the valid ones.
+	// Invalid events, including nil entries, are reported in the result rather than failing the batch.
+	RecordBatch(ctx context.Context, caller Caller, logs []*entity.EventLog) (*RecordResult, error)
+
+	// RecordStream records the events of a stream in micro-batches, acknowledging each batch once persisted.
+	// It returns when recv reports io.EOF and the last batch has been acknowledged, or on the first failure.
+	RecordStream(ctx context.Context, caller Caller, recv StreamReceiver, ack StreamAcker) error
+}
+
+// RecordResult reports the outcome of Record and RecordBatch.
+type RecordResult struct {
+	// Accepted counts the events that were not rejected, including Duplicates.
+	Accepted int
+	// Duplicates counts accepted events whose dedupe key had already been recorded within the
+	// dedupe window; they are not persisted again.
+	Duplicates int
+	Rejections []EventRejection
 }
 
+// EventRejection identifies an event of a batch that was not recorded and why.
+type EventRejection struct {
+	// Index is the event's position in the batch, or in the stream for RecordStream.
+	Index int
+	// AckID is the client's ack id of a streamed event.
+	AckID string
+	*ValidationError
+}
+
+// EventSubscriber receives events in process once they have been persisted,
+// e.g. to keep read models such as watch history up to date without a Kafka consumer.
+type EventSubscriber func(ctx context.Context, logs []*entity.EventLog) error
+
 // eventLogService is a concrete implementation of EventLogUsecase
 // delegating to the repository layer.
 type eventLogService struct {
-	repo repo.EventLogRepository
+	repo        repo.EventLogRepository
+	registry    *EventTypeRegistry
+	dedupe      repo.EventDedupeStore
+	stream      StreamConfig
+	subscribers []EventSubscriber
+	counters    attributionCounters
+	now         func() time.Time
 }
 
-// NewEventLogService constructs a new EventLogUsecase
-func NewEventLogService(r repo.EventLogRepository) EventLogUsecase {
-	return &eventLogService{repo: r}
+// NewEventLogService constructs a new EventLogUsecase validating events against registry,
+// dropping events already recorded according to dedupe, and batching streamed events as configured by stream.
+// dedupe may be nil to record every event.
+// Subscriber failures are logged and do not fail the recording, since the events are already persisted.
+func NewEventLogService(r repo.EventLogRepository, registry *EventTypeRegistry, dedupe repo.EventDedupeStore, stream StreamConfig, subscribers ...EventSubscriber) EventLogUsecase {
+	return &eventLogService{repo: r, registry: registry, dedupe: dedupe, stream: stream.withDefaults(), subscribers: subscribers, now: time.Now}
 }
 
 // Record validates and persists a single EventLog
-func (s *eventLogService) Record(ctx context.Context, log *entity.EventLog) error {
-	return s.repo.InsertEventLog(ctx, log)
+func (s *eventLogService) Record(ctx context.Context, caller Caller, log *entity.EventLog) (*RecordResult, error) {
+	if verr := s.check(ctx, caller, log, s.now()); verr != nil {
+		return nil, verr
+	}
+	fresh, claimed, duplicates := s.claimDedupeKeys(ctx, []*entity.EventLog{log})
+	result := &RecordResult{Accepted: 1, Duplicates: duplicates}
+	if len(fresh) == 0 {
+		return result, nil
+	}
+	if err := s.repo.InsertEventLog(ctx, log); err != nil {
+		s.releaseDedupeKeys(ctx, claimed)
+		return nil, err
+	}
+	s.publish(ctx, fresh)
+	return result, nil
 }
 
-// RecordBatch validates and persists multiple EventLog entries
-func (s *eventLogService) RecordBatch(ctx context.Context, logs []*entity.EventLog) error {
-	return s.repo.InsertEventLogs(ctx, logs)
-}
\ No newline at end of file
+// RecordBatch validates multiple EventLog entries and persists the valid ones in one call
+func (s *eventLogService) RecordBatch(ctx context.Context, caller Caller, logs []*entity.EventLog) (*RecordResult, error) {
+	now := s.now()
+	result := &RecordResult{}
+	valid := make([]*entity.EventLog, 0, len(logs))
+	for i, log := range logs {
+		if verr := s.check(ctx, caller, log, now); verr != nil {
+			result.Rejections = append(result.Rejections, EventRejection{Index: i, ValidationError: verr})
+			continue
+		}
+		valid = append(valid, log)
+	}
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	fresh, claimed, duplicates := s.claimDedupeKeys(ctx, valid)
+	if len(fresh) > 0 {
+		if err := s.repo.InsertEventLogs(ctx, fresh); err != nil {
+			s.releaseDedupeKeys(ctx, claimed)
+			return nil, err
+		}
+		s.publish(ctx, fresh)
+	}
+	result.Accepted = len(valid)
+	result.Duplicates = duplicates
+	return result, nil
+}
+
+// check validates an event against its schema and attributes it to the caller.
+func (s *eventLogService) check(ctx context.Context, caller Caller, log *entity.EventLog, now time.Time) *ValidationError {
+	if verr := s.registry.Validate(log, now); verr != nil {
+		return verr
+	}
+	verr := s.counters.attribute(caller, log)
+	if verr != nil && verr.Reason == ReasonUserMismatch {
+		// the totals since startup tell a single misbehaving client from a broader problem
+		stats := s.counters.stats()
+		logger.LoggerWithCtx(ctx).Warn("rejected event recorded on behalf of another user",
+			"event_type", log.EventType, "authenticated", caller.UserID != "",
+			"mismatched_total", stats.Mismatched, "authenticated_total", stats.Authenticated, "anonymous_total", stats.Anonymous)
+	}
+	return verr
+}
+
+// publish hands persisted events to the subscribers.
+func (s *eventLogService) publish(ctx context.Context, logs []*entity.EventLog) {
+	for _, subscribe := range s.subscribers {
+		if err := subscribe(ctx, logs); err != nil {
+			logger.LoggerWithCtx(ctx).Warn("event subscriber failed", "event_count", len(logs), "error", err)
+		}
+	}
+}
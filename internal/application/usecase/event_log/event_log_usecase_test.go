@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+var testNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// recordingRepository keeps the events it was asked to insert.
+type recordingRepository struct {
+	inserted []*entity.EventLog
+}
+
+func (r *recordingRepository) InsertEventLog(ctx context.Context, log *entity.EventLog) error {
+	r.inserted = append(r.inserted, log)
+	return nil
+}
+
+func (r *recordingRepository) InsertEventLogs(ctx context.Context, logs []*entity.EventLog) error {
+	r.inserted = append(r.inserted, logs...)
+	return nil
+}
+
+func newTestService(repo *recordingRepository) *eventLogService {
+	s := NewEventLogService(repo, DefaultEventTypeRegistry(DefaultTimeWindow), nil, DefaultStreamConfig).(*eventLogService)
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+func event(eventType string, props map[string]any) *entity.EventLog {
+	raw, _ := json.Marshal(props)
+	return &entity.EventLog{EventLogID: eventType, DeviceID: "device-1", VideoDmmID: "abc001", EventType: eventType, EventTime: testNow, Props: raw}
+}
+
+func TestEventTypeRegistry_Validate(t *testing.T) {
+	registry := DefaultEventTypeRegistry(DefaultTimeWindow)
+
+	withTime := func(e *entity.EventLog, at time.Time) *entity.EventLog {
+		e.EventTime = at
+		return e
+	}
+	withKey := func(e *entity.EventLog, key string) *entity.EventLog {
+		e.IdempotencyKey = key
+		return e
+	}
+	withoutVideo := event("like", nil)
+	withoutVideo.VideoDmmID = ""
+
+	tests := []struct {
+		name   string
+		event  *entity.EventLog
+		reason string
+		field  string
+	}{
+		{name: "valid pause", event: event("pause", map[string]any{"position": 120.5, "duration": 3600})},
+		{name: "unknown props are accepted", event: event("start", map[string]any{"quality": "hd"})},
+		{name: "nil event", event: nil, reason: ReasonMissingEvent},
+		{name: "unknown type", event: event("rewind", nil), reason: ReasonUnknownEventType, field: "event_type"},
+		{name: "missing video", event: withoutVideo, reason: ReasonMissingField, field: "video_dmm_id"},
+		{name: "required prop", event: event("pause", map[string]any{"duration": 3600}), reason: ReasonInvalidProps, field: "props.position"},
+		{name: "non numeric prop", event: event("start", map[string]any{"position": "10"}), reason: ReasonInvalidProps, field: "props.position"},
+		{name: "negative prop", event: event("skip", map[string]any{"position": -1}), reason: ReasonInvalidProps, field: "props.position"},
+		{name: "zero time", event: withTime(event("like", nil), time.Time{}), reason: ReasonMissingEventTime, field: "event_time"},
+		{name: "too old", event: withTime(event("like", nil), testNow.Add(-8*24*time.Hour)), reason: ReasonEventTimeOutOfRange, field: "event_time"},
+		{name: "too far ahead", event: withTime(event("like", nil), testNow.Add(10*time.Minute)), reason: ReasonEventTimeOutOfRange, field: "event_time"},
+		{name: "small clock skew", event: withTime(event("like", nil), testNow.Add(time.Minute))},
+		{name: "long idempotency key", event: withKey(event("like", nil), strings.Repeat("k", 129)), reason: ReasonInvalidIdempotencyKey, field: "idempotency_key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr := registry.Validate(tt.event, testNow)
+			if tt.reason == "" {
+				require.Nil(t, verr)
+				return
+			}
+			require.NotNil(t, verr)
+			require.Equal(t, tt.reason, verr.Reason)
+			require.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestRecordBatch_RecordsValidEventsAndReportsRejections(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+
+	result, err := s.RecordBatch(context.Background(), Caller{}, []*entity.EventLog{
+		event("start", nil),
+		event("rewind", nil),
+		nil,
+		event("pause", map[string]any{"position": 30}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Accepted)
+	require.Len(t, result.Rejections, 2)
+	require.Equal(t, 1, result.Rejections[0].Index)
+	require.Equal(t, ReasonUnknownEventType, result.Rejections[0].Reason)
+	require.Equal(t, 2, result.Rejections[1].Index)
+	require.Equal(t, ReasonMissingEvent, result.Rejections[1].Reason)
+	require.Len(t, repo.inserted, 2)
+
+	_, err = s.Record(context.Background(), Caller{}, event("pause", nil))
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, "props.position", verr.Field)
+	require.Len(t, repo.inserted, 2)
+}
+
+func TestRecord_AttributesEventsToCaller(t *testing.T) {
+	const userID = "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	ctx := context.Background()
+	record := func(caller Caller, e *entity.EventLog) error {
+		_, err := s.Record(ctx, caller, e)
+		return err
+	}
+
+	// the authenticated user is taken from the caller, not from the event
+	require.NoError(t, record(Caller{UserID: userID}, event("like", nil)))
+	require.Equal(t, userID, repo.inserted[0].UserID)
+
+	claimed := event("like", nil)
+	claimed.UserID = userID
+	require.NoError(t, record(Caller{UserID: userID}, claimed))
+
+	// another user's id is rejected, with or without a token
+	other := event("like", nil)
+	other.UserID = "9b2d7e10-3c4f-4a6b-8e1d-7f0a2b3c4d5e"
+	err := record(Caller{UserID: userID}, other)
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, ReasonUserMismatch, verr.Reason)
+	require.ErrorAs(t, record(Caller{}, other), &verr)
+	require.Equal(t, ReasonUserMismatch, verr.Reason)
+
+	// anonymous events need a device id
+	require.NoError(t, record(Caller{}, event("like", nil)))
+	anonymous := event("like", nil)
+	anonymous.DeviceID = ""
+	require.ErrorAs(t, record(Caller{}, anonymous), &verr)
+	require.Equal(t, "device_id", verr.Field)
+
+	require.Equal(t, AttributionStats{Authenticated: 2, Anonymous: 1, Mismatched: 2}, s.counters.stats())
+	require.Len(t, repo.inserted, 3)
+}
@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// Rejection reasons reported for events that fail validation.
+const (
+	ReasonMissingEvent          = "MISSING_EVENT"
+	ReasonUnknownEventType      = "UNKNOWN_EVENT_TYPE"
+	ReasonMissingField          = "MISSING_FIELD"
+	ReasonInvalidProps          = "INVALID_PROPS"
+	ReasonMissingEventTime      = "MISSING_EVENT_TIME"
+	ReasonEventTimeOutOfRange   = "EVENT_TIME_OUT_OF_RANGE"
+	ReasonInvalidIdempotencyKey = "INVALID_IDEMPOTENCY_KEY"
+)
+
+// ValidationError describes why an event was rejected.
+type ValidationError struct {
+	// Reason is one of the Reason* constants.
+	Reason string
+	// Field names the offending field, e.g. "event_type" or "props.position"; empty when not field specific.
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid event: %s", e.Message)
+	}
+	return fmt.Sprintf("invalid event: %s: %s", e.Field, e.Message)
+}
+
+// PropType is the JSON type a prop must have.
+type PropType string
+
+const (
+	PropNumber PropType = "number"
+	PropString PropType = "string"
+	PropBool   PropType = "boolean"
+)
+
+// PropSpec constrains a single prop, in the spirit of a JSON Schema property.
+type PropSpec struct {
+	Type     PropType
+	Required bool
+	// Min and Max bound number props when set.
+	Min *float64
+	Max *float64
+}
+
+// EventSchema describes an event type. Props not listed in Props are accepted as is.
+type EventSchema struct {
+	EventType string
+	// RequiresVideo rejects events without video_dmm_id.
+	RequiresVideo bool
+	Props         map[string]PropSpec
+}
+
+// TimeWindow bounds event_time relative to the time the server receives the event.
+type TimeWindow struct {
+	// MaxAge is how far in the past an event may be, e.g. when an offline player flushes its buffer.
+	MaxAge time.Duration
+	// MaxSkew is how far in the future an event may be, to tolerate client clock drift.
+	MaxSkew time.Duration
+}
+
+// DefaultTimeWindow accepts events up to a week old and five minutes ahead of the server clock.
+var DefaultTimeWindow = TimeWindow{MaxAge: 7 * 24 * time.Hour, MaxSkew: 5 * time.Minute}
+
+// EventTypeRegistry holds the known event types and validates events against them.
+type EventTypeRegistry struct {
+	schemas map[string]EventSchema
+	window  TimeWindow
+}
+
+// NewEventTypeRegistry returns an empty registry that checks event_time against window.
+func NewEventTypeRegistry(window TimeWindow) *EventTypeRegistry {
+	return &EventTypeRegistry{schemas: make(map[string]EventSchema), window: window}
+}
+
+// Register adds or replaces the schema of an event type.
+func (r *EventTypeRegistry) Register(schema EventSchema) {
+	r.schemas[schema.EventType] = schema
+}
+
+// Lookup returns the schema of an event type.
+func (r *EventTypeRegistry) Lookup(eventType string) (EventSchema, bool) {
+	schema, ok := r.schemas[eventType]
+	return schema, ok
+}
+
+// DefaultEventTypeRegistry returns a registry with the event types players send today.
+// Playback positions and durations are in seconds.
+func DefaultEventTypeRegistry(window TimeWindow) *EventTypeRegistry {
+	nonNegative := 0.0
+	position := PropSpec{Type: PropNumber, Min: &nonNegative}
+	duration := PropSpec{Type: PropNumber, Min: &nonNegative}
+
+	r := NewEventTypeRegistry(window)
+	r.Register(EventSchema{EventType: entity.EventTypeStart, RequiresVideo: true, Props: map[string]PropSpec{
+		"position": position,
+		"duration": duration,
+	}})
+	for _, eventType := range []string{entity.EventTypePause, entity.EventTypeSkip} {
+		r.Register(EventSchema{EventType: eventType, RequiresVideo: true, Props: map[string]PropSpec{
+			"position": {Type: PropNumber, Required: true, Min: &nonNegative},
+			"duration": duration,
+		}})
+	}
+	r.Register(EventSchema{EventType: entity.EventTypeComplete, RequiresVideo: true, Props: map[string]PropSpec{
+		"position": position,
+		"duration": duration,
+	}})
+	r.Register(EventSchema{EventType: "like", RequiresVideo: true})
+	r.Register(EventSchema{EventType: "share", RequiresVideo: true, Props: map[string]PropSpec{
+		"channel": {Type: PropString},
+	}})
+	return r
+}
+
+// Validate checks an event against its type's schema and the time window around now.
+// It returns nil for a valid event, or the first problem found.
+func (r *EventTypeRegistry) Validate(e *entity.EventLog, now time.Time) *ValidationError {
+	if e == nil {
+		return &ValidationError{Reason: ReasonMissingEvent, Message: "event is required"}
+	}
+	if len(e.IdempotencyKey) > entity.MaxIdempotencyKeyLength {
+		return &ValidationError{Reason: ReasonInvalidIdempotencyKey, Field: "idempotency_key",
+			Message: fmt.Sprintf("idempotency_key must be at most %d bytes", entity.MaxIdempotencyKeyLength)}
+	}
+	schema, ok := r.schemas[e.EventType]
+	if !ok {
+		return &ValidationError{Reason: ReasonUnknownEventType, Field: "event_type", Message: fmt.Sprintf("unknown event type %q", e.EventType)}
+	}
+	if schema.RequiresVideo && e.VideoDmmID == "" {
+		return &ValidationError{Reason: ReasonMissingField, Field: "video_dmm_id", Message: "video_dmm_id is required"}
+	}
+	if err := r.validateEventTime(e.EventTime, now); err != nil {
+		return err
+	}
+	return validateProps(schema, e.Props)
+}
+
+func (r *EventTypeRegistry) validateEventTime(t, now time.Time) *ValidationError {
+	if t.IsZero() {
+		return &ValidationError{Reason: ReasonMissingEventTime, Field: "event_time", Message: "event_time is required"}
+	}
+	if r.window.MaxAge > 0 && t.Before(now.Add(-r.window.MaxAge)) {
+		return &ValidationError{Reason: ReasonEventTimeOutOfRange, Field: "event_time",
+			Message: fmt.Sprintf("event_time %s is more than %s in the past", t.UTC().Format(time.RFC3339), r.window.MaxAge)}
+	}
+	if r.window.MaxSkew > 0 && t.After(now.Add(r.window.MaxSkew)) {
+		return &ValidationError{Reason: ReasonEventTimeOutOfRange, Field: "event_time",
+			Message: fmt.Sprintf("event_time %s is more than %s in the future", t.UTC().Format(time.RFC3339), r.window.MaxSkew)}
+	}
+	return nil
+}
+
+func validateProps(schema EventSchema, raw json.RawMessage) *ValidationError {
+	props := map[string]any{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &props); err != nil {
+			return &ValidationError{Reason: ReasonInvalidProps, Field: "props", Message: "props must be a JSON object"}
+		}
+	}
+	for name, spec := range schema.Props {
+		field := "props." + name
+		value, ok := props[name]
+		if !ok || value == nil {
+			if spec.Required {
+				return &ValidationError{Reason: ReasonInvalidProps, Field: field, Message: "is required"}
+			}
+			continue
+		}
+		if err := spec.check(value); err != "" {
+			return &ValidationError{Reason: ReasonInvalidProps, Field: field, Message: err}
+		}
+	}
+	return nil
+}
+
+// check returns a description of why value does not satisfy the spec, or "" when it does.
+func (s PropSpec) check(value any) string {
+	switch s.Type {
+	case PropNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return "must be a number"
+		}
+		if s.Min != nil && n < *s.Min {
+			return fmt.Sprintf("must be at least %g", *s.Min)
+		}
+		if s.Max != nil && n > *s.Max {
+			return fmt.Sprintf("must be at most %g", *s.Max)
+		}
+	case PropString:
+		if _, ok := value.(string); !ok {
+			return "must be a string"
+		}
+	case PropBool:
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	}
+	return ""
+}
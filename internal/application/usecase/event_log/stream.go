@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// DefaultStreamConfig is used for the zero fields of a StreamConfig.
+var DefaultStreamConfig = StreamConfig{
+	MaxBatchSize:  100,
+	MaxBatchDelay: 200 * time.Millisecond,
+	MaxPending:    500,
+}
+
+// StreamConfig controls how RecordStream micro-batches events.
+type StreamConfig struct {
+	// MaxBatchSize flushes the pending events once this many have been received.
+	MaxBatchSize int
+	// MaxBatchDelay flushes the pending events this long after the first of them was received.
+	MaxBatchDelay time.Duration
+	// MaxPending bounds how many received events may wait for a flush. Beyond it the stream
+	// is no longer read, so transport flow control slows the client down.
+	MaxPending int
+}
+
+func (c StreamConfig) withDefaults() StreamConfig {
+	if c.MaxBatchSize <= 0 {
+		c.MaxBatchSize = DefaultStreamConfig.MaxBatchSize
+	}
+	if c.MaxBatchDelay <= 0 {
+		c.MaxBatchDelay = DefaultStreamConfig.MaxBatchDelay
+	}
+	if c.MaxPending < c.MaxBatchSize {
+		c.MaxPending = max(DefaultStreamConfig.MaxPending, c.MaxBatchSize)
+	}
+	return c
+}
+
+// StreamEvent is an event received on a stream together with the client's ack id.
+type StreamEvent struct {
+	AckID string
+	Event *entity.EventLog
+}
+
+// StreamAck acknowledges the events of a flushed micro-batch.
+// Acknowledged events, recorded or rejected, are not expected to be resent.
+type StreamAck struct {
+	// AckIDs are the ack ids of the recorded events.
+	AckIDs []string
+	// Rejections index events by their position in the stream and carry their ack id.
+	Rejections []EventRejection
+}
+
+// StreamReceiver returns the next event of a stream, or io.EOF once the client closed it.
+type StreamReceiver func() (StreamEvent, error)
+
+// StreamAcker delivers an ack to the client.
+type StreamAcker func(ack *StreamAck) error
+
+// RecordStream records the events received from recv in micro-batches of up to MaxBatchSize
+// events or MaxBatchDelay, acknowledging each batch through ack once it has been persisted.
+// When the stream or the repository fails, events that were not acknowledged are dropped
+// and the client is expected to resend them.
+//
+// recv runs on its own goroutine, which stops receiving once RecordStream has returned.
+// A receive already blocked on the network is not waited for: it returns once the client sends
+// or the stream is torn down, and its result is dropped. This lets a client that waits for acks
+// without sending see the error.
+func (s *eventLogService) RecordStream(ctx context.Context, caller Caller, recv StreamReceiver, ack StreamAcker) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Events are received on their own goroutine so that a slow flush only stops reading
+	// once MaxPending events are waiting.
+	received := make(chan StreamEvent, s.stream.MaxPending)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(received)
+		for ctx.Err() == nil {
+			evt, err := recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+			select {
+			case received <- evt:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		pending []StreamEvent
+		offset  int // stream position of pending[0]
+	)
+	timer := time.NewTimer(s.stream.MaxBatchDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	flush := func() error {
+		timer.Stop()
+		if len(pending) == 0 {
+			return nil
+		}
+		batch := pending
+		pending = nil
+		first := offset
+		offset += len(batch)
+		return s.flushStream(ctx, caller, batch, first, ack)
+	}
+
+	for {
+		select {
+		case evt, ok := <-received:
+			if !ok {
+				select {
+				case err := <-recvErr:
+					return err
+				default:
+				}
+				return flush()
+			}
+			pending = append(pending, evt)
+			if len(pending) == 1 {
+				timer.Reset(s.stream.MaxBatchDelay)
+			}
+			if len(pending) >= s.stream.MaxBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-timer.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// flushStream records a micro-batch whose first event is at stream position first and acknowledges it.
+func (s *eventLogService) flushStream(ctx context.Context, caller Caller, batch []StreamEvent, first int, ack StreamAcker) error {
+	logs := make([]*entity.EventLog, len(batch))
+	for i, evt := range batch {
+		logs[i] = evt.Event
+	}
+	result, err := s.RecordBatch(ctx, caller, logs)
+	if err != nil {
+		return err
+	}
+
+	rejected := make(map[int]bool, len(result.Rejections))
+	out := &StreamAck{AckIDs: make([]string, 0, result.Accepted)}
+	for _, r := range result.Rejections {
+		rejected[r.Index] = true
+		r.AckID = batch[r.Index].AckID
+		r.Index += first
+		out.Rejections = append(out.Rejections, r)
+	}
+	for i, evt := range batch {
+		if !rejected[i] {
+			out.AckIDs = append(out.AckIDs, evt.AckID)
+		}
+	}
+	if len(out.Rejections) > 0 {
+		logger.LoggerWithCtx(ctx).Warn("rejected invalid streamed events",
+			"accepted", result.Accepted, "rejected", len(out.Rejections), "first_reason", out.Rejections[0].Reason)
+	}
+	return ack(out)
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// sliceReceiver returns events in order and then io.EOF, or err when set.
+func sliceReceiver(events []StreamEvent, err error) StreamReceiver {
+	return func() (StreamEvent, error) {
+		if len(events) == 0 {
+			if err != nil {
+				return StreamEvent{}, err
+			}
+			return StreamEvent{}, io.EOF
+		}
+		evt := events[0]
+		events = events[1:]
+		return evt, nil
+	}
+}
+
+func streamed(ackID string, e *entity.EventLog) StreamEvent {
+	return StreamEvent{AckID: ackID, Event: e}
+}
+
+func TestRecordStream_FlushesBySizeAndAcksEachBatch(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.stream = StreamConfig{MaxBatchSize: 2, MaxBatchDelay: time.Hour}.withDefaults()
+
+	var acks []*StreamAck
+	err := s.RecordStream(context.Background(), Caller{}, sliceReceiver([]StreamEvent{
+		streamed("a", event("start", nil)),
+		streamed("b", event("rewind", nil)),
+		streamed("c", event("like", nil)),
+		streamed("d", nil),
+		streamed("e", event("share", nil)),
+	}, nil), func(ack *StreamAck) error {
+		acks = append(acks, ack)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, repo.inserted, 3)
+
+	// two full batches, then the remainder when the stream ends
+	require.Len(t, acks, 3)
+	require.Equal(t, []string{"a"}, acks[0].AckIDs)
+	require.Len(t, acks[0].Rejections, 1)
+	require.Equal(t, "b", acks[0].Rejections[0].AckID)
+	require.Equal(t, 1, acks[0].Rejections[0].Index)
+	require.Equal(t, ReasonUnknownEventType, acks[0].Rejections[0].Reason)
+
+	// rejections are indexed by their position in the stream
+	require.Equal(t, []string{"c"}, acks[1].AckIDs)
+	require.Equal(t, "d", acks[1].Rejections[0].AckID)
+	require.Equal(t, 3, acks[1].Rejections[0].Index)
+	require.Equal(t, ReasonMissingEvent, acks[1].Rejections[0].Reason)
+	require.Equal(t, []string{"e"}, acks[2].AckIDs)
+}
+
+func TestRecordStream_FlushesAfterMaxBatchDelay(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.stream = StreamConfig{MaxBatchSize: 100, MaxBatchDelay: 10 * time.Millisecond}.withDefaults()
+
+	acked := make(chan *StreamAck, 1)
+	release := make(chan struct{})
+	sent := false
+	recv := func() (StreamEvent, error) {
+		if !sent {
+			sent = true
+			return streamed("a", event("like", nil)), nil
+		}
+		// keep the stream open until the pending event has been acknowledged
+		<-release
+		return StreamEvent{}, io.EOF
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.RecordStream(context.Background(), Caller{}, recv, func(ack *StreamAck) error {
+			acked <- ack
+			return nil
+		})
+	}()
+
+	select {
+	case ack := <-acked:
+		require.Equal(t, []string{"a"}, ack.AckIDs)
+	case <-time.After(time.Second):
+		t.Fatal("pending event was not flushed after MaxBatchDelay")
+	}
+	close(release)
+	require.NoError(t, <-done)
+	require.Len(t, repo.inserted, 1)
+}
+
+func TestRecordStream_DropsUnackedEventsOnStreamError(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.stream = StreamConfig{MaxBatchSize: 2, MaxBatchDelay: time.Hour}.withDefaults()
+
+	broken := errors.New("connection reset")
+	var acked []string
+	err := s.RecordStream(context.Background(), Caller{}, sliceReceiver([]StreamEvent{
+		streamed("a", event("like", nil)),
+		streamed("b", event("like", nil)),
+		streamed("c", event("like", nil)),
+	}, broken), func(ack *StreamAck) error {
+		acked = append(acked, ack.AckIDs...)
+		return nil
+	})
+	require.ErrorIs(t, err, broken)
+
+	// the full batch was acknowledged; "c" is left for the client to resend
+	require.Equal(t, []string{"a", "b"}, acked)
+	require.Len(t, repo.inserted, 2)
+}
+
+func TestStreamConfig_WithDefaults(t *testing.T) {
+	require.Equal(t, DefaultStreamConfig, StreamConfig{}.withDefaults())
+
+	// at least a full batch can wait for a flush
+	cfg := StreamConfig{MaxBatchSize: 1000, MaxPending: 10}.withDefaults()
+	require.Equal(t, 1000, cfg.MaxPending)
+}
+
+func TestRecordStream_DoesNotWaitForBlockedReceive(t *testing.T) {
+	repo := &recordingRepository{}
+	s := newTestService(repo)
+	s.stream = StreamConfig{MaxBatchSize: 1, MaxBatchDelay: time.Hour}.withDefaults()
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	recv := func() (StreamEvent, error) {
+		if calls.Add(1) == 1 {
+			return streamed("a", event("like", nil)), nil
+		}
+		// the client waits for its ack without sending anything else
+		<-release
+		return streamed("b", event("like", nil)), nil
+	}
+	broken := errors.New("ack failed")
+	done := make(chan error, 1)
+	go func() {
+		done <- s.RecordStream(context.Background(), Caller{}, recv, func(ack *StreamAck) error {
+			return broken
+		})
+	}()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, broken)
+	case <-time.After(time.Second):
+		t.Fatal("RecordStream waited for a blocked receive")
+	}
+
+	// the blocked receive is dropped and the stream is not read again
+	close(release)
+	time.Sleep(10 * time.Millisecond)
+	require.EqualValues(t, 2, calls.Load())
+}
@@ -4,42 +4,77 @@ package favorite
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
 	"github.com/tikfack/server/internal/domain/entity"
 	"github.com/tikfack/server/internal/domain/repository"
 	"github.com/tikfack/server/internal/middleware/logger"
 )
 
+// actorDetailConcurrency caps the actress lookups run at once when listing favorite actors with details.
+const actorDetailConcurrency = 8
+
+// VideoLookup looks up videos by DMM ID in batches; video.VideoUsecase implements it.
+// Lookups that are not found or fail are reported per ID without failing the batch.
+type VideoLookup interface {
+	GetVideosByDmmIds(ctx context.Context, floor model.FloorSelector, dmmIDs []string) ([]model.VideoLookup, error)
+}
+
 // FavoriteUsecase defines the operations for managing user favorites.
 type FavoriteUsecase interface {
 	AddFavoriteVideo(ctx context.Context, keycloakID, videoID string) (*model.FavoriteVideo, error)
 	RemoveFavoriteVideo(ctx context.Context, keycloakID, videoID string) (*model.FavoriteVideo, error)
-	ListFavoriteVideos(ctx context.Context, keycloakID string) ([]model.FavoriteVideo, error)
+	// ListFavoriteVideos lists one page of the user's favorite videos. With IncludeDetails, each
+	// favorite's Detail carries the video looked up from the catalog; favorites whose video has
+	// disappeared or could not be fetched are still listed, with the status recorded in Detail.
+	// A malformed page token, or one issued for another sort order, yields ErrInvalidPageToken.
+	ListFavoriteVideos(ctx context.Context, keycloakID string, query model.FavoriteListQuery) (*model.FavoriteVideoPage, error)
 
 	AddFavoriteActor(ctx context.Context, keycloakID, actorID string) (*model.FavoriteActor, error)
 	RemoveFavoriteActor(ctx context.Context, keycloakID, actorID string) (*model.FavoriteActor, error)
-	ListFavoriteActors(ctx context.Context, keycloakID string) ([]model.FavoriteActor, error)
+	// ListFavoriteActors lists one page of the user's favorite actors, optionally with their profiles (see ListFavoriteVideos).
+	ListFavoriteActors(ctx context.Context, keycloakID string, query model.FavoriteListQuery) (*model.FavoriteActorPage, error)
+
+	// AddFavoriteArticle favorites a genre, maker, series or director. Adding an existing favorite returns it unchanged.
+	AddFavoriteArticle(ctx context.Context, keycloakID string, articleType entity.ArticleType, articleID string) (*model.FavoriteArticle, error)
+	// RemoveFavoriteArticle removes a favorite article. Removing one the user does not have succeeds and returns nil.
+	RemoveFavoriteArticle(ctx context.Context, keycloakID string, articleType entity.ArticleType, articleID string) (*model.FavoriteArticle, error)
+	// ListFavoriteArticles lists one page of the user's favorite articles of articleType, or of every type when empty.
+	// IncludeDetails in query is ignored.
+	ListFavoriteArticles(ctx context.Context, keycloakID string, articleType entity.ArticleType, query model.FavoriteListQuery) (*model.FavoriteArticlePage, error)
 }
 
 // usecase implements FavoriteUsecase.
 type usecase struct {
-	userRepo          repository.UserRepository
-	favoriteVideoRepo repository.FavoriteVideoRepository
-	favoriteActorRepo repository.FavoriteActorRepository
+	userRepo            repository.UserRepository
+	favoriteVideoRepo   repository.FavoriteVideoRepository
+	favoriteActorRepo   repository.FavoriteActorRepository
+	favoriteArticleRepo repository.FavoriteArticleRepository
+	videos              VideoLookup
+	actressCatalog      port.ActressCatalog
 }
 
 // NewFavoriteUsecase constructs a FavoriteUsecase.
+// videos and actressCatalog are only used to embed details when listing favorites.
 func NewFavoriteUsecase(
 	userRepo repository.UserRepository,
 	favoriteVideoRepo repository.FavoriteVideoRepository,
 	favoriteActorRepo repository.FavoriteActorRepository,
+	favoriteArticleRepo repository.FavoriteArticleRepository,
+	videos VideoLookup,
+	actressCatalog port.ActressCatalog,
 ) FavoriteUsecase {
 	return &usecase{
-		userRepo:          userRepo,
-		favoriteVideoRepo: favoriteVideoRepo,
-		favoriteActorRepo: favoriteActorRepo,
+		userRepo:            userRepo,
+		favoriteVideoRepo:   favoriteVideoRepo,
+		favoriteActorRepo:   favoriteActorRepo,
+		favoriteArticleRepo: favoriteArticleRepo,
+		videos:              videos,
+		actressCatalog:      actressCatalog,
 	}
 }
 
@@ -80,20 +115,37 @@ func (u *usecase) RemoveFavoriteVideo(ctx context.Context, keycloakID, videoID s
 	return &fv, nil
 }
 
-func (u *usecase) ListFavoriteVideos(ctx context.Context, keycloakID string) ([]model.FavoriteVideo, error) {
+func (u *usecase) ListFavoriteVideos(ctx context.Context, keycloakID string, query model.FavoriteListQuery) (*model.FavoriteVideoPage, error) {
+	opts, pageSize, err := listOptions(query)
+	if err != nil {
+		return nil, err
+	}
 	user, err := u.ensureUser(ctx, keycloakID)
 	if err != nil {
 		return nil, err
 	}
-	favorites, err := u.favoriteVideoRepo.ListByUserID(ctx, user.UserID)
+	favorites, err := u.favoriteVideoRepo.ListByUserID(ctx, user.UserID, opts)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.favoriteVideoRepo.CountByUserID(ctx, user.UserID)
 	if err != nil {
 		return nil, err
 	}
+	favorites, next := nextPageToken(favorites, pageSize, query.Order, func(f entity.FavoriteVideo) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteVideoUUID}
+	})
+
 	results := make([]model.FavoriteVideo, 0, len(favorites))
 	for _, f := range favorites {
 		results = append(results, model.NewFavoriteVideoFromEntity(f))
 	}
-	return results, nil
+	if query.IncludeDetails {
+		if err := u.attachVideoDetails(ctx, results); err != nil {
+			return nil, err
+		}
+	}
+	return &model.FavoriteVideoPage{Favorites: results, NextPageToken: next, TotalCount: total}, nil
 }
 
 func (u *usecase) AddFavoriteActor(ctx context.Context, keycloakID, actorID string) (*model.FavoriteActor, error) {
@@ -132,20 +184,161 @@ func (u *usecase) RemoveFavoriteActor(ctx context.Context, keycloakID, actorID s
 	return &fa, nil
 }
 
-func (u *usecase) ListFavoriteActors(ctx context.Context, keycloakID string) ([]model.FavoriteActor, error) {
+func (u *usecase) ListFavoriteActors(ctx context.Context, keycloakID string, query model.FavoriteListQuery) (*model.FavoriteActorPage, error) {
+	opts, pageSize, err := listOptions(query)
+	if err != nil {
+		return nil, err
+	}
 	user, err := u.ensureUser(ctx, keycloakID)
 	if err != nil {
 		return nil, err
 	}
-	favorites, err := u.favoriteActorRepo.ListByUserID(ctx, user.UserID)
+	favorites, err := u.favoriteActorRepo.ListByUserID(ctx, user.UserID, opts)
 	if err != nil {
 		return nil, err
 	}
+	total, err := u.favoriteActorRepo.CountByUserID(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	favorites, next := nextPageToken(favorites, pageSize, query.Order, func(f entity.FavoriteActor) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteActorUUID}
+	})
+
 	results := make([]model.FavoriteActor, 0, len(favorites))
 	for _, f := range favorites {
 		results = append(results, model.NewFavoriteActorFromEntity(f))
 	}
-	return results, nil
+	if query.IncludeDetails {
+		if err := u.attachActorDetails(ctx, results); err != nil {
+			return nil, err
+		}
+	}
+	return &model.FavoriteActorPage{Favorites: results, NextPageToken: next, TotalCount: total}, nil
+}
+
+func (u *usecase) AddFavoriteArticle(ctx context.Context, keycloakID string, articleType entity.ArticleType, articleID string) (*model.FavoriteArticle, error) {
+	log := logger.LoggerWithCtx(ctx)
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := u.favoriteArticleRepo.FindByUserAndArticleID(ctx, user.UserID, articleType, articleID)
+	if err == nil && existing != nil {
+		log.Debug("favorite article already exists", "article_type", articleType, "article_id", articleID)
+		fa := model.NewFavoriteArticleFromEntity(*existing)
+		return &fa, nil
+	}
+	favorite, err := entity.NewFavoriteArticle(user.UserID, articleType, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.favoriteArticleRepo.Add(ctx, favorite); err != nil {
+		return nil, err
+	}
+	fa := model.NewFavoriteArticleFromEntity(*favorite)
+	return &fa, nil
+}
+
+func (u *usecase) RemoveFavoriteArticle(ctx context.Context, keycloakID string, articleType entity.ArticleType, articleID string) (*model.FavoriteArticle, error) {
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := u.favoriteArticleRepo.RemoveByArticleID(ctx, user.UserID, articleType, articleID)
+	if errors.Is(err, repository.ErrFavoriteArticleNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	fa := model.NewFavoriteArticleFromEntity(*removed)
+	return &fa, nil
+}
+
+func (u *usecase) ListFavoriteArticles(ctx context.Context, keycloakID string, articleType entity.ArticleType, query model.FavoriteListQuery) (*model.FavoriteArticlePage, error) {
+	opts, pageSize, err := listOptions(query)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := u.favoriteArticleRepo.ListByUserID(ctx, user.UserID, articleType, opts)
+	if err != nil {
+		return nil, err
+	}
+	total, err := u.favoriteArticleRepo.CountByUserID(ctx, user.UserID, articleType)
+	if err != nil {
+		return nil, err
+	}
+	favorites, next := nextPageToken(favorites, pageSize, query.Order, func(f entity.FavoriteArticle) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteArticleUUID}
+	})
+
+	results := make([]model.FavoriteArticle, 0, len(favorites))
+	for _, f := range favorites {
+		results = append(results, model.NewFavoriteArticleFromEntity(f))
+	}
+	return &model.FavoriteArticlePage{Favorites: results, NextPageToken: next, TotalCount: total}, nil
+}
+
+// attachVideoDetails looks up the favorites' videos in the default floor with one batch lookup.
+func (u *usecase) attachVideoDetails(ctx context.Context, favorites []model.FavoriteVideo) error {
+	ids := make([]string, len(favorites))
+	for i, f := range favorites {
+		ids[i] = f.VideoID
+	}
+	lookups, err := u.videos.GetVideosByDmmIds(ctx, model.FloorSelector{}, ids)
+	if err != nil {
+		return err
+	}
+	for i := range favorites {
+		favorites[i].Detail = &lookups[i]
+	}
+	return nil
+}
+
+// attachActorDetails looks up each favorite's actress profile in the catalog.
+func (u *usecase) attachActorDetails(ctx context.Context, favorites []model.FavoriteActor) error {
+	log := logger.LoggerWithCtx(ctx)
+	forEachLimited(ctx, len(favorites), func(i int) {
+		actorID := favorites[i].ActorID
+		actress, err := u.actressCatalog.GetActress(ctx, actorID)
+		detail := &model.ActressLookup{ActressID: actorID, Status: port.LookupStatusOf(actress != nil, err)}
+		switch detail.Status {
+		case model.LookupFound:
+			detail.Actress = actress
+		case model.LookupFailed:
+			log.Warn("failed to look up favorite actor", "actor_id", actorID, "error", err)
+			detail.Err = err
+		}
+		favorites[i].Detail = detail
+	})
+	return ctx.Err()
+}
+
+// forEachLimited runs fn for 0..n-1 with at most actorDetailConcurrency calls in flight.
+// The actress catalog has no batch lookup, so actor details are fetched one by one.
+// No new calls are started once ctx is done.
+func forEachLimited(ctx context.Context, n int, fn func(i int)) {
+	sem := make(chan struct{}, actorDetailConcurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for i := 0; i < n && ctx.Err() == nil; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
 }
 
 func (u *usecase) ensureUser(ctx context.Context, keycloakID string) (*entity.User, error) {
@@ -0,0 +1,226 @@
+package favorite
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+	videouc "github.com/tikfack/server/internal/application/usecase/video"
+	"github.com/tikfack/server/internal/domain/entity"
+	favoriterepo "github.com/tikfack/server/internal/infrastructure/repository/favorite"
+	userrepo "github.com/tikfack/server/internal/infrastructure/repository/user"
+)
+
+const testKeycloakID = "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"
+
+// newTestUsecase looks up favorite videos through the real batch lookup, backed by a mock catalog
+// whose floor resolution leaves the default floor selector unchanged.
+func newTestUsecase(t *testing.T) (FavoriteUsecase, *mockcatalog.MockVideoCatalog, *mockcatalog.MockActressCatalog) {
+	ctrl := gomock.NewController(t)
+	videos := mockcatalog.NewMockVideoCatalog(ctrl)
+	floors := mockcatalog.NewMockFloorCatalog(ctrl)
+	floors.EXPECT().ResolveFloor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, selector model.FloorSelector) (model.FloorSelector, error) {
+			return selector, nil
+		}).
+		AnyTimes()
+	actresses := mockcatalog.NewMockActressCatalog(ctrl)
+	uc := NewFavoriteUsecase(
+		userrepo.NewMemoryUserRepository(),
+		favoriterepo.NewMemoryFavoriteVideoRepository(),
+		favoriterepo.NewMemoryFavoriteActorRepository(),
+		favoriterepo.NewMemoryFavoriteArticleRepository(),
+		videouc.NewVideoUsecase(videos, floors),
+		actresses,
+	)
+	return uc, videos, actresses
+}
+
+func TestListFavoriteVideos_IncludeDetails(t *testing.T) {
+	uc, videos, _ := newTestUsecase(t)
+	ctx := context.Background()
+	for _, id := range []string{"found", "gone", "broken"} {
+		_, err := uc.AddFavoriteVideo(ctx, testKeycloakID, id)
+		require.NoError(t, err)
+	}
+
+	found := &model.Video{DmmID: "found", Title: "動画"}
+	videos.EXPECT().GetVideoById(gomock.Any(), model.FloorSelector{}, "found").Return(found, nil)
+	videos.EXPECT().GetVideoById(gomock.Any(), model.FloorSelector{}, "gone").
+		Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound})
+	videos.EXPECT().GetVideoById(gomock.Any(), model.FloorSelector{}, "broken").
+		Return(nil, &port.CatalogError{Kind: port.ErrCatalogUnavailable, Err: errors.New("boom")})
+
+	page, err := uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{IncludeDetails: true})
+	require.NoError(t, err)
+	require.Len(t, page.Favorites, 3)
+
+	details := make(map[string]*model.VideoLookup, len(page.Favorites))
+	for _, f := range page.Favorites {
+		require.NotNil(t, f.Detail)
+		details[f.VideoID] = f.Detail
+	}
+	require.Equal(t, model.LookupFound, details["found"].Status)
+	require.Equal(t, found, details["found"].Video)
+	require.Equal(t, model.LookupNotFound, details["gone"].Status)
+	require.Nil(t, details["gone"].Video)
+	require.Equal(t, model.LookupFailed, details["broken"].Status)
+	require.Error(t, details["broken"].Err)
+}
+
+func TestListFavoriteVideos_WithoutDetails(t *testing.T) {
+	uc, _, _ := newTestUsecase(t)
+	ctx := context.Background()
+	_, err := uc.AddFavoriteVideo(ctx, testKeycloakID, "v1")
+	require.NoError(t, err)
+
+	// The catalog must not be called when details are not requested.
+	page, err := uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{})
+	require.NoError(t, err)
+	require.Len(t, page.Favorites, 1)
+	require.Nil(t, page.Favorites[0].Detail)
+}
+
+func TestListFavoriteActors_IncludeDetails(t *testing.T) {
+	uc, _, actresses := newTestUsecase(t)
+	ctx := context.Background()
+	for _, id := range []string{"1011199", "999"} {
+		_, err := uc.AddFavoriteActor(ctx, testKeycloakID, id)
+		require.NoError(t, err)
+	}
+
+	profile := &model.ActressProfile{ID: "1011199", Name: "女優A"}
+	actresses.EXPECT().GetActress(gomock.Any(), "1011199").Return(profile, nil)
+	actresses.EXPECT().GetActress(gomock.Any(), "999").Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound})
+
+	page, err := uc.ListFavoriteActors(ctx, testKeycloakID, model.FavoriteListQuery{IncludeDetails: true})
+	require.NoError(t, err)
+	require.Len(t, page.Favorites, 2)
+	for _, f := range page.Favorites {
+		require.NotNil(t, f.Detail)
+		switch f.ActorID {
+		case "1011199":
+			require.Equal(t, model.LookupFound, f.Detail.Status)
+			require.Equal(t, profile, f.Detail.Actress)
+		case "999":
+			require.Equal(t, model.LookupNotFound, f.Detail.Status)
+		}
+	}
+}
+
+func TestListFavoriteVideos_Canceled(t *testing.T) {
+	uc, _, _ := newTestUsecase(t)
+	_, err := uc.AddFavoriteVideo(context.Background(), testKeycloakID, "v1")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{IncludeDetails: true})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestListFavoriteVideos_Pagination(t *testing.T) {
+	for _, order := range []model.FavoriteSortOrder{model.FavoriteSortOldestFirst, model.FavoriteSortNewestFirst} {
+		uc, _, _ := newTestUsecase(t)
+		ctx := context.Background()
+		added := []string{"v1", "v2", "v3", "v4", "v5"}
+		for _, id := range added {
+			_, err := uc.AddFavoriteVideo(ctx, testKeycloakID, id)
+			require.NoError(t, err)
+		}
+		if order == model.FavoriteSortNewestFirst {
+			slices.Reverse(added)
+		}
+
+		var listed []string
+		token := ""
+		for pages := 1; ; pages++ {
+			page, err := uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{PageSize: 2, PageToken: token, Order: order})
+			require.NoError(t, err)
+			require.Equal(t, 5, page.TotalCount)
+			require.LessOrEqual(t, len(page.Favorites), 2)
+			for _, f := range page.Favorites {
+				listed = append(listed, f.VideoID)
+			}
+			if page.NextPageToken == "" {
+				require.Equal(t, 3, pages)
+				break
+			}
+			token = page.NextPageToken
+		}
+		require.Equal(t, added, listed)
+	}
+}
+
+func TestListFavoriteVideos_InvalidPageToken(t *testing.T) {
+	uc, _, _ := newTestUsecase(t)
+	ctx := context.Background()
+	for _, id := range []string{"v1", "v2"} {
+		_, err := uc.AddFavoriteVideo(ctx, testKeycloakID, id)
+		require.NoError(t, err)
+	}
+
+	_, err := uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{PageToken: "not-a-token"})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// A token is bound to the sort order it was issued for.
+	page, err := uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextPageToken)
+	_, err = uc.ListFavoriteVideos(ctx, testKeycloakID, model.FavoriteListQuery{PageSize: 1, PageToken: page.NextPageToken, Order: model.FavoriteSortNewestFirst})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestFavoriteArticles(t *testing.T) {
+	uc, _, _ := newTestUsecase(t)
+	ctx := context.Background()
+
+	added, err := uc.AddFavoriteArticle(ctx, testKeycloakID, entity.ArticleGenre, "6533")
+	require.NoError(t, err)
+	require.Equal(t, entity.ArticleGenre, added.ArticleType)
+
+	// Adding the same article again returns the existing favorite.
+	again, err := uc.AddFavoriteArticle(ctx, testKeycloakID, entity.ArticleGenre, "6533")
+	require.NoError(t, err)
+	require.Equal(t, added.FavoriteArticleUUID, again.FavoriteArticleUUID)
+
+	// The same ID under another type is a separate favorite.
+	_, err = uc.AddFavoriteArticle(ctx, testKeycloakID, entity.ArticleMaker, "6533")
+	require.NoError(t, err)
+	_, err = uc.AddFavoriteArticle(ctx, testKeycloakID, entity.ArticleDirector, "101")
+	require.NoError(t, err)
+
+	_, err = uc.AddFavoriteArticle(ctx, testKeycloakID, entity.ArticleType("label"), "1")
+	require.Error(t, err)
+
+	all, err := uc.ListFavoriteArticles(ctx, testKeycloakID, "", model.FavoriteListQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 3, all.TotalCount)
+	require.Len(t, all.Favorites, 3)
+
+	genres, err := uc.ListFavoriteArticles(ctx, testKeycloakID, entity.ArticleGenre, model.FavoriteListQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 1, genres.TotalCount)
+	require.Equal(t, "6533", genres.Favorites[0].ArticleID)
+
+	removed, err := uc.RemoveFavoriteArticle(ctx, testKeycloakID, entity.ArticleGenre, "6533")
+	require.NoError(t, err)
+	require.Equal(t, added.FavoriteArticleUUID, removed.FavoriteArticleUUID)
+
+	// Removing an article that is not a favorite succeeds without a result.
+	removed, err = uc.RemoveFavoriteArticle(ctx, testKeycloakID, entity.ArticleGenre, "6533")
+	require.NoError(t, err)
+	require.Nil(t, removed)
+
+	genres, err = uc.ListFavoriteArticles(ctx, testKeycloakID, entity.ArticleGenre, model.FavoriteListQuery{})
+	require.NoError(t, err)
+	require.Zero(t, genres.TotalCount)
+	require.Empty(t, genres.Favorites)
+}
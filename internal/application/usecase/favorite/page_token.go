@@ -0,0 +1,83 @@
+package favorite
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+const (
+	// defaultPageSize is used when a listing does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the page size a client may request.
+	maxPageSize = 200
+)
+
+// ErrInvalidPageToken is returned when a page token is malformed or was issued for a different sort order.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageToken is the decoded form of the opaque token handed to clients.
+// It records the last favorite of the previous page and the order the page was listed in.
+type pageToken struct {
+	CreatedAt time.Time               `json:"t"`
+	UUID      string                  `json:"id"`
+	Order     model.FavoriteSortOrder `json:"o"`
+}
+
+func encodePageToken(token pageToken) string {
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &token); err != nil || token.UUID == "" || token.CreatedAt.IsZero() {
+		return token, ErrInvalidPageToken
+	}
+	return token, nil
+}
+
+// listOptions translates a page request into repository options and the effective page size.
+// One extra row is requested so the caller can tell whether another page follows.
+func listOptions(query model.FavoriteListQuery) (repository.FavoriteListOptions, int, error) {
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	opts := repository.FavoriteListOptions{
+		Limit:      pageSize + 1,
+		Descending: query.Order == model.FavoriteSortNewestFirst,
+	}
+	if query.PageToken != "" {
+		token, err := decodePageToken(query.PageToken)
+		if err != nil {
+			return opts, 0, err
+		}
+		if token.Order != query.Order {
+			return opts, 0, ErrInvalidPageToken
+		}
+		opts.After = &repository.FavoriteCursor{CreatedAt: token.CreatedAt, UUID: token.UUID}
+	}
+	return opts, pageSize, nil
+}
+
+// nextPageToken trims rows fetched beyond pageSize and returns the token for the following page,
+// or an empty token when this is the last page.
+func nextPageToken[T any](rows []T, pageSize int, order model.FavoriteSortOrder, cursorOf func(T) repository.FavoriteCursor) ([]T, string) {
+	if len(rows) <= pageSize {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	last := cursorOf(rows[pageSize-1])
+	return rows, encodePageToken(pageToken{CreatedAt: last.CreatedAt, UUID: last.UUID, Order: order})
+}
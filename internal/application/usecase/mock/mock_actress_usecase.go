@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/tikfack/server/internal/application/usecase/actress (interfaces: ActressUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/mock_actress_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/actress ActressUsecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/tikfack/server/internal/application/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActressUsecase is a mock of ActressUsecase interface.
+type MockActressUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockActressUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockActressUsecaseMockRecorder is the mock recorder for MockActressUsecase.
+type MockActressUsecaseMockRecorder struct {
+	mock *MockActressUsecase
+}
+
+// NewMockActressUsecase creates a new mock instance.
+func NewMockActressUsecase(ctrl *gomock.Controller) *MockActressUsecase {
+	mock := &MockActressUsecase{ctrl: ctrl}
+	mock.recorder = &MockActressUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActressUsecase) EXPECT() *MockActressUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetActress mocks base method.
+func (m *MockActressUsecase) GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActress", ctx, actressID)
+	ret0, _ := ret[0].(*model.ActressProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActress indicates an expected call of GetActress.
+func (mr *MockActressUsecaseMockRecorder) GetActress(ctx, actressID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActress", reflect.TypeOf((*MockActressUsecase)(nil).GetActress), ctx, actressID)
+}
+
+// SearchActresses mocks base method.
+func (m *MockActressUsecase) SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchActresses", ctx, query)
+	ret0, _ := ret[0].([]model.ActressProfile)
+	ret1, _ := ret[1].(*model.SearchMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchActresses indicates an expected call of SearchActresses.
+func (mr *MockActressUsecaseMockRecorder) SearchActresses(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchActresses", reflect.TypeOf((*MockActressUsecase)(nil).SearchActresses), ctx, query)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/tikfack/server/internal/application/usecase/taxonomy (interfaces: TaxonomyUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/mock_taxonomy_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/taxonomy TaxonomyUsecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/tikfack/server/internal/application/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaxonomyUsecase is a mock of TaxonomyUsecase interface.
+type MockTaxonomyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxonomyUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTaxonomyUsecaseMockRecorder is the mock recorder for MockTaxonomyUsecase.
+type MockTaxonomyUsecaseMockRecorder struct {
+	mock *MockTaxonomyUsecase
+}
+
+// NewMockTaxonomyUsecase creates a new mock instance.
+func NewMockTaxonomyUsecase(ctrl *gomock.Controller) *MockTaxonomyUsecase {
+	mock := &MockTaxonomyUsecase{ctrl: ctrl}
+	mock.recorder = &MockTaxonomyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxonomyUsecase) EXPECT() *MockTaxonomyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTaxonomyUsecase) Get(ctx context.Context, kind model.TaxonomyKind, floorID, id, initial string) (*model.TaxonomyEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, kind, floorID, id, initial)
+	ret0, _ := ret[0].(*model.TaxonomyEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaxonomyUsecaseMockRecorder) Get(ctx, kind, floorID, id, initial any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaxonomyUsecase)(nil).Get), ctx, kind, floorID, id, initial)
+}
+
+// List mocks base method.
+func (m *MockTaxonomyUsecase) List(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, kind, query)
+	ret0, _ := ret[0].([]model.TaxonomyEntry)
+	ret1, _ := ret[1].(*model.SearchMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaxonomyUsecaseMockRecorder) List(ctx, kind, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaxonomyUsecase)(nil).List), ctx, kind, query)
+}
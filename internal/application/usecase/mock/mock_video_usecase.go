@@ -43,24 +43,24 @@ func (m *MockVideoUsecase) EXPECT() *MockVideoUsecaseMockRecorder {
 }
 
 // GetVideoById mocks base method.
-func (m *MockVideoUsecase) GetVideoById(ctx context.Context, dmmId string) (*model.Video, error) {
+func (m *MockVideoUsecase) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideoById", ctx, dmmId)
+	ret := m.ctrl.Call(m, "GetVideoById", ctx, floor, dmmId)
 	ret0, _ := ret[0].(*model.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVideoById indicates an expected call of GetVideoById.
-func (mr *MockVideoUsecaseMockRecorder) GetVideoById(ctx, dmmId any) *gomock.Call {
+func (mr *MockVideoUsecaseMockRecorder) GetVideoById(ctx, floor, dmmId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoById", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideoById), ctx, dmmId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoById", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideoById), ctx, floor, dmmId)
 }
 
 // GetVideosByDate mocks base method.
-func (m *MockVideoUsecase) GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoUsecase) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByDate", ctx, targetDate, hits, offset)
+	ret := m.ctrl.Call(m, "GetVideosByDate", ctx, floor, targetDate, hits, offset, sale)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -68,15 +68,30 @@ func (m *MockVideoUsecase) GetVideosByDate(ctx context.Context, targetDate time.
 }
 
 // GetVideosByDate indicates an expected call of GetVideosByDate.
-func (mr *MockVideoUsecaseMockRecorder) GetVideosByDate(ctx, targetDate, hits, offset any) *gomock.Call {
+func (mr *MockVideoUsecaseMockRecorder) GetVideosByDate(ctx, floor, targetDate, hits, offset, sale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByDate), ctx, targetDate, hits, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByDate), ctx, floor, targetDate, hits, offset, sale)
+}
+
+// GetVideosByDmmIds mocks base method.
+func (m *MockVideoUsecase) GetVideosByDmmIds(ctx context.Context, floor model.FloorSelector, dmmIDs []string) ([]model.VideoLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideosByDmmIds", ctx, floor, dmmIDs)
+	ret0, _ := ret[0].([]model.VideoLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideosByDmmIds indicates an expected call of GetVideosByDmmIds.
+func (mr *MockVideoUsecaseMockRecorder) GetVideosByDmmIds(ctx, floor, dmmIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDmmIds", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByDmmIds), ctx, floor, dmmIDs)
 }
 
 // GetVideosByID mocks base method.
-func (m *MockVideoUsecase) GetVideosByID(ctx context.Context, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoUsecase) GetVideosByID(ctx context.Context, floor model.FloorSelector, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByID", ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	ret := m.ctrl.Call(m, "GetVideosByID", ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, sale)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -84,15 +99,15 @@ func (m *MockVideoUsecase) GetVideosByID(ctx context.Context, actressIDs, genreI
 }
 
 // GetVideosByID indicates an expected call of GetVideosByID.
-func (mr *MockVideoUsecaseMockRecorder) GetVideosByID(ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor any) *gomock.Call {
+func (mr *MockVideoUsecaseMockRecorder) GetVideosByID(ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, sale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByID", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByID), ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByID", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByID), ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate, sale)
 }
 
 // GetVideosByKeyword mocks base method.
-func (m *MockVideoUsecase) GetVideosByKeyword(ctx context.Context, keyword string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoUsecase) GetVideosByKeyword(ctx context.Context, floor model.FloorSelector, keyword string, hits, offset int32, sort, gteDate, lteDate string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByKeyword", ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	ret := m.ctrl.Call(m, "GetVideosByKeyword", ctx, floor, keyword, hits, offset, sort, gteDate, lteDate, sale)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -100,15 +115,30 @@ func (m *MockVideoUsecase) GetVideosByKeyword(ctx context.Context, keyword strin
 }
 
 // GetVideosByKeyword indicates an expected call of GetVideosByKeyword.
-func (mr *MockVideoUsecaseMockRecorder) GetVideosByKeyword(ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor any) *gomock.Call {
+func (mr *MockVideoUsecaseMockRecorder) GetVideosByKeyword(ctx, floor, keyword, hits, offset, sort, gteDate, lteDate, sale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByKeyword), ctx, floor, keyword, hits, offset, sort, gteDate, lteDate, sale)
+}
+
+// ListFloors mocks base method.
+func (m *MockVideoUsecase) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", ctx)
+	ret0, _ := ret[0].([]model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockVideoUsecaseMockRecorder) ListFloors(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoUsecase)(nil).GetVideosByKeyword), ctx, keyword, hits, offset, sort, gteDate, lteDate, site, service, floor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockVideoUsecase)(nil).ListFloors), ctx)
 }
 
 // SearchVideos mocks base method.
-func (m *MockVideoUsecase) SearchVideos(ctx context.Context, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
+func (m *MockVideoUsecase) SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchVideos", ctx, keyword, actressID, genreID, makerID, seriesID, directorID)
+	ret := m.ctrl.Call(m, "SearchVideos", ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID, sale)
 	ret0, _ := ret[0].([]model.Video)
 	ret1, _ := ret[1].(*model.SearchMetadata)
 	ret2, _ := ret[2].(error)
@@ -116,7 +146,7 @@ func (m *MockVideoUsecase) SearchVideos(ctx context.Context, keyword, actressID,
 }
 
 // SearchVideos indicates an expected call of SearchVideos.
-func (mr *MockVideoUsecaseMockRecorder) SearchVideos(ctx, keyword, actressID, genreID, makerID, seriesID, directorID any) *gomock.Call {
+func (mr *MockVideoUsecaseMockRecorder) SearchVideos(ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID, sale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVideos", reflect.TypeOf((*MockVideoUsecase)(nil).SearchVideos), ctx, keyword, actressID, genreID, makerID, seriesID, directorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchVideos", reflect.TypeOf((*MockVideoUsecase)(nil).SearchVideos), ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID, sale)
 }
@@ -0,0 +1,246 @@
+package playlist
+
+//go:generate mockgen -destination=../mock/mock_playlist_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/playlist PlaylistUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// maxPlaylistItems caps the number of videos in a single playlist.
+const maxPlaylistItems = 1000
+
+var (
+	// ErrInvalidPlaylist is returned when a playlist's name, description or visibility is invalid.
+	ErrInvalidPlaylist = errors.New("invalid playlist")
+	// ErrNotPlaylistOwner is returned when a user tries to modify a playlist they can see but do not own.
+	ErrNotPlaylistOwner = errors.New("only the playlist owner can modify it")
+	// ErrPlaylistFull is returned when adding a video to a playlist that already holds maxPlaylistItems videos.
+	ErrPlaylistFull = fmt.Errorf("playlist already holds %d videos", maxPlaylistItems)
+)
+
+// PlaylistUsecase defines the operations for managing user playlists.
+// Private playlists of other users are reported as repository.ErrPlaylistNotFound so their existence is not revealed.
+type PlaylistUsecase interface {
+	CreatePlaylist(ctx context.Context, keycloakID string, input model.PlaylistInput) (*model.Playlist, error)
+	UpdatePlaylist(ctx context.Context, keycloakID, playlistUUID string, update model.PlaylistUpdate) (*model.Playlist, error)
+	DeletePlaylist(ctx context.Context, keycloakID, playlistUUID string) error
+	// GetPlaylist returns a playlist with its items. Other users can open unlisted and public playlists.
+	GetPlaylist(ctx context.Context, keycloakID, playlistUUID string) (*model.Playlist, error)
+	// ListPlaylists lists ownerID's playlists, or the caller's own when ownerID is empty.
+	// Only public playlists are listed for other users.
+	ListPlaylists(ctx context.Context, keycloakID, ownerID string) ([]model.Playlist, error)
+
+	// AddPlaylistItem appends a video to a playlist. Adding a video already in the playlist returns the existing item.
+	AddPlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string) (*model.PlaylistItem, error)
+	RemovePlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string) error
+	// MovePlaylistItem moves a video to position and returns the playlist's items in their new order.
+	MovePlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string, position int) ([]model.PlaylistItem, error)
+}
+
+// usecase implements PlaylistUsecase.
+type usecase struct {
+	userRepo     repository.UserRepository
+	playlistRepo repository.PlaylistRepository
+}
+
+// NewPlaylistUsecase constructs a PlaylistUsecase.
+func NewPlaylistUsecase(userRepo repository.UserRepository, playlistRepo repository.PlaylistRepository) PlaylistUsecase {
+	return &usecase{userRepo: userRepo, playlistRepo: playlistRepo}
+}
+
+func (u *usecase) CreatePlaylist(ctx context.Context, keycloakID string, input model.PlaylistInput) (*model.Playlist, error) {
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = entity.PlaylistPrivate
+	}
+	playlist, err := entity.NewPlaylist(user.UserID, input.Name, input.Description, visibility)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPlaylist, err)
+	}
+	if err := u.playlistRepo.Create(ctx, playlist); err != nil {
+		return nil, err
+	}
+	logger.LoggerWithCtx(ctx).Info("playlist created", "playlist_uuid", playlist.PlaylistUUID)
+	p := model.NewPlaylistFromEntity(*playlist)
+	return &p, nil
+}
+
+func (u *usecase) UpdatePlaylist(ctx context.Context, keycloakID, playlistUUID string, update model.PlaylistUpdate) (*model.Playlist, error) {
+	playlist, err := u.ownedPlaylist(ctx, keycloakID, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyUpdate(playlist, update); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPlaylist, err)
+	}
+	if err := u.playlistRepo.Update(ctx, playlist); err != nil {
+		return nil, err
+	}
+	p := model.NewPlaylistFromEntity(*playlist)
+	return &p, nil
+}
+
+func (u *usecase) DeletePlaylist(ctx context.Context, keycloakID, playlistUUID string) error {
+	if _, err := u.ownedPlaylist(ctx, keycloakID, playlistUUID); err != nil {
+		return err
+	}
+	if err := u.playlistRepo.Delete(ctx, playlistUUID); err != nil {
+		return err
+	}
+	logger.LoggerWithCtx(ctx).Info("playlist deleted", "playlist_uuid", playlistUUID)
+	return nil
+}
+
+func (u *usecase) GetPlaylist(ctx context.Context, keycloakID, playlistUUID string) (*model.Playlist, error) {
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := u.visiblePlaylist(ctx, user.UserID, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := u.playlistRepo.ListItems(ctx, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	p := model.NewPlaylistFromEntity(*playlist)
+	p.Items = toItems(items)
+	return &p, nil
+}
+
+func (u *usecase) ListPlaylists(ctx context.Context, keycloakID, ownerID string) ([]model.Playlist, error) {
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	var visibility entity.PlaylistVisibility
+	if ownerID == "" {
+		ownerID = user.UserID
+	} else if ownerID != user.UserID {
+		visibility = entity.PlaylistPublic
+	}
+	playlists, err := u.playlistRepo.ListByUserID(ctx, ownerID, visibility)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]model.Playlist, 0, len(playlists))
+	for _, p := range playlists {
+		results = append(results, model.NewPlaylistFromEntity(p))
+	}
+	return results, nil
+}
+
+func (u *usecase) AddPlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string) (*model.PlaylistItem, error) {
+	if _, err := u.ownedPlaylist(ctx, keycloakID, playlistUUID); err != nil {
+		return nil, err
+	}
+	item, err := entity.NewPlaylistItem(playlistUUID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	err = u.playlistRepo.AddItem(ctx, item, maxPlaylistItems)
+	if errors.Is(err, repository.ErrPlaylistFull) {
+		return nil, ErrPlaylistFull
+	}
+	if err != nil {
+		return nil, err
+	}
+	i := model.NewPlaylistItemFromEntity(*item)
+	return &i, nil
+}
+
+func (u *usecase) RemovePlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string) error {
+	if _, err := u.ownedPlaylist(ctx, keycloakID, playlistUUID); err != nil {
+		return err
+	}
+	return u.playlistRepo.RemoveItem(ctx, playlistUUID, videoID)
+}
+
+func (u *usecase) MovePlaylistItem(ctx context.Context, keycloakID, playlistUUID, videoID string, position int) ([]model.PlaylistItem, error) {
+	if _, err := u.ownedPlaylist(ctx, keycloakID, playlistUUID); err != nil {
+		return nil, err
+	}
+	if err := u.playlistRepo.MoveItem(ctx, playlistUUID, videoID, position); err != nil {
+		return nil, err
+	}
+	items, err := u.playlistRepo.ListItems(ctx, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	return toItems(items), nil
+}
+
+// visiblePlaylist loads a playlist userID may view.
+func (u *usecase) visiblePlaylist(ctx context.Context, userID, playlistUUID string) (*entity.Playlist, error) {
+	playlist, err := u.playlistRepo.Get(ctx, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !playlist.VisibleTo(userID) {
+		return nil, repository.ErrPlaylistNotFound
+	}
+	return playlist, nil
+}
+
+// ownedPlaylist loads a playlist the caller may modify.
+func (u *usecase) ownedPlaylist(ctx context.Context, keycloakID, playlistUUID string) (*entity.Playlist, error) {
+	user, err := u.ensureUser(ctx, keycloakID)
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := u.visiblePlaylist(ctx, user.UserID, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	if playlist.UserID != user.UserID {
+		return nil, ErrNotPlaylistOwner
+	}
+	return playlist, nil
+}
+
+// ensureUser upserts the caller's user record, as the favorite usecase does.
+func (u *usecase) ensureUser(ctx context.Context, keycloakID string) (*entity.User, error) {
+	if keycloakID == "" {
+		return nil, fmt.Errorf("keycloak id is required")
+	}
+	return u.userRepo.UpsertByKeycloakID(ctx, keycloakID)
+}
+
+func applyUpdate(playlist *entity.Playlist, update model.PlaylistUpdate) error {
+	if update.Name != nil {
+		if err := playlist.Rename(*update.Name); err != nil {
+			return err
+		}
+	}
+	if update.Description != nil {
+		if err := playlist.Describe(*update.Description); err != nil {
+			return err
+		}
+	}
+	if update.Visibility != "" {
+		if err := playlist.SetVisibility(update.Visibility); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toItems(items []entity.PlaylistItem) []model.PlaylistItem {
+	results := make([]model.PlaylistItem, 0, len(items))
+	for _, item := range items {
+		results = append(results, model.NewPlaylistItemFromEntity(item))
+	}
+	return results
+}
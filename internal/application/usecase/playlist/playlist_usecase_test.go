@@ -0,0 +1,151 @@
+package playlist
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+	playlistrepo "github.com/tikfack/server/internal/infrastructure/repository/playlist"
+	userrepo "github.com/tikfack/server/internal/infrastructure/repository/user"
+)
+
+const (
+	ownerID = "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"
+	otherID = "9b2d7e10-3c4f-4a6b-8e1d-7f0a2b3c4d5e"
+)
+
+func newTestUsecase() PlaylistUsecase {
+	return NewPlaylistUsecase(userrepo.NewMemoryUserRepository(), playlistrepo.NewMemoryPlaylistRepository())
+}
+
+func videoIDs(items []model.PlaylistItem) []string {
+	ids := make([]string, 0, len(items))
+	for i, item := range items {
+		if item.Position != i {
+			return nil
+		}
+		ids = append(ids, item.VideoID)
+	}
+	return ids
+}
+
+func TestPlaylistLifecycle(t *testing.T) {
+	uc := newTestUsecase()
+	ctx := context.Background()
+
+	created, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "あとで見る"})
+	require.NoError(t, err)
+	require.Equal(t, entity.PlaylistPrivate, created.Visibility)
+	require.Equal(t, ownerID, created.OwnerID)
+
+	name, visibility := "お気に入り", entity.PlaylistPublic
+	updated, err := uc.UpdatePlaylist(ctx, ownerID, created.PlaylistUUID, model.PlaylistUpdate{Name: &name, Visibility: visibility})
+	require.NoError(t, err)
+	require.Equal(t, "お気に入り", updated.Name)
+	require.Equal(t, entity.PlaylistPublic, updated.Visibility)
+
+	empty := ""
+	_, err = uc.UpdatePlaylist(ctx, ownerID, created.PlaylistUUID, model.PlaylistUpdate{Name: &empty})
+	require.ErrorIs(t, err, ErrInvalidPlaylist)
+
+	playlists, err := uc.ListPlaylists(ctx, ownerID, "")
+	require.NoError(t, err)
+	require.Len(t, playlists, 1)
+
+	require.NoError(t, uc.DeletePlaylist(ctx, ownerID, created.PlaylistUUID))
+	_, err = uc.GetPlaylist(ctx, ownerID, created.PlaylistUUID)
+	require.ErrorIs(t, err, repository.ErrPlaylistNotFound)
+}
+
+func TestPlaylistItems(t *testing.T) {
+	uc := newTestUsecase()
+	ctx := context.Background()
+
+	created, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "list"})
+	require.NoError(t, err)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		_, err := uc.AddPlaylistItem(ctx, ownerID, created.PlaylistUUID, id)
+		require.NoError(t, err)
+	}
+
+	// Adding a video that is already in the playlist keeps its position.
+	item, err := uc.AddPlaylistItem(ctx, ownerID, created.PlaylistUUID, "b")
+	require.NoError(t, err)
+	require.Equal(t, 1, item.Position)
+
+	items, err := uc.MovePlaylistItem(ctx, ownerID, created.PlaylistUUID, "d", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "a", "b", "c"}, videoIDs(items))
+
+	items, err = uc.MovePlaylistItem(ctx, ownerID, created.PlaylistUUID, "a", 99)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "b", "c", "a"}, videoIDs(items))
+
+	require.NoError(t, uc.RemovePlaylistItem(ctx, ownerID, created.PlaylistUUID, "b"))
+	require.ErrorIs(t, uc.RemovePlaylistItem(ctx, ownerID, created.PlaylistUUID, "b"), repository.ErrPlaylistItemNotFound)
+
+	got, err := uc.GetPlaylist(ctx, ownerID, created.PlaylistUUID)
+	require.NoError(t, err)
+	require.Equal(t, 3, got.ItemCount)
+	require.Equal(t, []string{"d", "c", "a"}, videoIDs(got.Items))
+}
+
+func TestPlaylistItems_Full(t *testing.T) {
+	uc := newTestUsecase()
+	ctx := context.Background()
+
+	created, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "list"})
+	require.NoError(t, err)
+	for i := 0; i < maxPlaylistItems; i++ {
+		_, err := uc.AddPlaylistItem(ctx, ownerID, created.PlaylistUUID, fmt.Sprintf("v%d", i))
+		require.NoError(t, err)
+	}
+
+	_, err = uc.AddPlaylistItem(ctx, ownerID, created.PlaylistUUID, "one-too-many")
+	require.ErrorIs(t, err, ErrPlaylistFull)
+
+	// Re-adding a video already in a full playlist still returns the existing item.
+	item, err := uc.AddPlaylistItem(ctx, ownerID, created.PlaylistUUID, "v1")
+	require.NoError(t, err)
+	require.Equal(t, 1, item.Position)
+}
+
+func TestPlaylistVisibility(t *testing.T) {
+	uc := newTestUsecase()
+	ctx := context.Background()
+
+	private, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "private"})
+	require.NoError(t, err)
+	unlisted, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "unlisted", Visibility: entity.PlaylistUnlisted})
+	require.NoError(t, err)
+	public, err := uc.CreatePlaylist(ctx, ownerID, model.PlaylistInput{Name: "public", Visibility: entity.PlaylistPublic})
+	require.NoError(t, err)
+
+	// Other users cannot tell a private playlist exists.
+	_, err = uc.GetPlaylist(ctx, otherID, private.PlaylistUUID)
+	require.ErrorIs(t, err, repository.ErrPlaylistNotFound)
+	_, err = uc.AddPlaylistItem(ctx, otherID, private.PlaylistUUID, "v1")
+	require.ErrorIs(t, err, repository.ErrPlaylistNotFound)
+
+	// Unlisted playlists can be opened by UUID but not modified.
+	_, err = uc.GetPlaylist(ctx, otherID, unlisted.PlaylistUUID)
+	require.NoError(t, err)
+	_, err = uc.AddPlaylistItem(ctx, otherID, unlisted.PlaylistUUID, "v1")
+	require.ErrorIs(t, err, ErrNotPlaylistOwner)
+	require.ErrorIs(t, uc.DeletePlaylist(ctx, otherID, unlisted.PlaylistUUID), ErrNotPlaylistOwner)
+
+	// Only public playlists are listed for other users.
+	listed, err := uc.ListPlaylists(ctx, otherID, ownerID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, public.PlaylistUUID, listed[0].PlaylistUUID)
+
+	own, err := uc.ListPlaylists(ctx, ownerID, ownerID)
+	require.NoError(t, err)
+	require.Len(t, own, 3)
+}
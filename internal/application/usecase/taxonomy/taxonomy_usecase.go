@@ -0,0 +1,120 @@
+package taxonomy
+
+//go:generate mockgen -destination=../mock/mock_taxonomy_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/taxonomy TaxonomyUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+const (
+	maxHits int32 = 500
+	// maxLookupPages は ID 検索で一覧をたどる最大ページ数。分類の総数は数千件程度のため十分な値にしている。
+	maxLookupPages = 20
+)
+
+// ErrLookupLimitExceeded は ID 検索が maxLookupPages ページをたどっても一覧の終わりに届かなかった場合に返す。
+// 分類が存在しないとは限らないため NotFound とは区別する。頭文字を指定すると検索範囲を絞れる。
+var ErrLookupLimitExceeded = errors.New("taxonomy lookup exceeded the page limit")
+
+// TaxonomyUsecase はジャンル・メーカー・シリーズの一覧と参照に関するユースケースを定義するインターフェイス
+type TaxonomyUsecase interface {
+	// List は指定された種類の分類を一覧で取得する
+	List(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error)
+
+	// Get は指定された ID の分類を取得する。initial を指定すると該当する頭文字の範囲だけを探す
+	Get(ctx context.Context, kind model.TaxonomyKind, floorID, id, initial string) (*model.TaxonomyEntry, error)
+}
+
+// taxonomyUsecase は TaxonomyUsecase の実装
+type taxonomyUsecase struct {
+	catalog port.TaxonomyCatalog
+	logger  *slog.Logger
+}
+
+// NewTaxonomyUsecase は TaxonomyCatalog ポートを受け取り TaxonomyUsecase を返す
+func NewTaxonomyUsecase(catalog port.TaxonomyCatalog) TaxonomyUsecase {
+	if catalog == nil {
+		panic("taxonomy catalog must be provided")
+	}
+	return &taxonomyUsecase{
+		catalog: catalog,
+		logger:  slog.Default().With(slog.String("component", "taxonomy_usecase")),
+	}
+}
+
+func (u *taxonomyUsecase) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return u.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// List は件数と開始位置を上限内に収めてから分類の一覧を取得する
+func (u *taxonomyUsecase) List(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error) {
+	logger := u.loggerWithCtx(ctx)
+	query.Hits = model.ClampHits(query.Hits, maxHits)
+	query.Offset = model.ClampOffset(query.Offset)
+	logger.Debug("List called",
+		"kind", kind,
+		"floorID", query.FloorID,
+		"initial", query.Initial,
+		"hits", query.Hits,
+		"offset", query.Offset,
+	)
+	return u.catalog.ListTaxonomy(ctx, kind, query)
+}
+
+// Get は一覧 API をページ単位でたどって指定 ID の分類を探す。
+// DMM には分類を ID で直接引く API がないため、一覧の結果（キャッシュ済みのことが多い）から探す。
+// maxLookupPages ページをたどっても見つからず一覧が続いている場合は ErrLookupLimitExceeded を返す。
+func (u *taxonomyUsecase) Get(ctx context.Context, kind model.TaxonomyKind, floorID, id, initial string) (*model.TaxonomyEntry, error) {
+	logger := u.loggerWithCtx(ctx)
+	logger.Debug("Get called", "kind", kind, "floorID", floorID, "id", id, "initial", initial)
+
+	if id == "" {
+		return nil, &port.CatalogError{
+			Kind: port.ErrCatalogInvalidParameter,
+			Err:  fmt.Errorf("%s の ID が指定されていません", kind),
+		}
+	}
+
+	offset := int32(1)
+	for page := 0; page < maxLookupPages; page++ {
+		entries, metadata, err := u.catalog.ListTaxonomy(ctx, kind, model.TaxonomyQuery{
+			FloorID: floorID,
+			Initial: initial,
+			Hits:    maxHits,
+			Offset:  offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range entries {
+			if entries[i].ID == id {
+				return &entries[i], nil
+			}
+		}
+		if len(entries) == 0 || metadata == nil {
+			break
+		}
+		offset += int32(len(entries))
+		if int(offset) > metadata.TotalCount {
+			break
+		}
+		if page == maxLookupPages-1 {
+			return nil, fmt.Errorf("%w: %s %s (%d 件中 %d 件まで検索)", ErrLookupLimitExceeded, kind, id, metadata.TotalCount, offset-1)
+		}
+	}
+	return nil, &port.CatalogError{
+		Kind: port.ErrCatalogNotFound,
+		Err:  fmt.Errorf("%s が見つかりません: %s", kind, id),
+	}
+}
@@ -0,0 +1,130 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+)
+
+var testGenre = model.TaxonomyEntry{ID: "6003", Name: "ドラマ", Ruby: "どらま"}
+
+func TestList(t *testing.T) {
+	cases := []struct {
+		name   string
+		query  model.TaxonomyQuery
+		expect model.TaxonomyQuery
+	}{
+		{
+			name:   "normal request",
+			query:  model.TaxonomyQuery{FloorID: "43", Initial: "ど", Hits: 100, Offset: 1},
+			expect: model.TaxonomyQuery{FloorID: "43", Initial: "ど", Hits: 100, Offset: 1},
+		},
+		{
+			name:   "clamp hits and offset",
+			query:  model.TaxonomyQuery{Hits: 1000, Offset: -5},
+			expect: model.TaxonomyQuery{Hits: maxHits, Offset: 0},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			metadata := &model.SearchMetadata{ResultCount: 1, TotalCount: 1, FirstPosition: 1}
+			catalog := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+			catalog.EXPECT().
+				ListTaxonomy(gomock.Any(), model.TaxonomyGenre, tt.expect).
+				Return([]model.TaxonomyEntry{testGenre}, metadata, nil)
+
+			entries, md, err := NewTaxonomyUsecase(catalog).List(context.Background(), model.TaxonomyGenre, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, []model.TaxonomyEntry{testGenre}, entries)
+			require.Equal(t, metadata, md)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Run("found on second page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		firstPage := make([]model.TaxonomyEntry, maxHits)
+		for i := range firstPage {
+			firstPage[i] = model.TaxonomyEntry{ID: "x"}
+		}
+		catalog := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+		gomock.InOrder(
+			catalog.EXPECT().
+				ListTaxonomy(gomock.Any(), model.TaxonomyGenre, model.TaxonomyQuery{FloorID: "43", Hits: maxHits, Offset: 1}).
+				Return(firstPage, &model.SearchMetadata{ResultCount: int(maxHits), TotalCount: 501, FirstPosition: 1}, nil),
+			catalog.EXPECT().
+				ListTaxonomy(gomock.Any(), model.TaxonomyGenre, model.TaxonomyQuery{FloorID: "43", Hits: maxHits, Offset: 501}).
+				Return([]model.TaxonomyEntry{testGenre}, &model.SearchMetadata{ResultCount: 1, TotalCount: 501, FirstPosition: 501}, nil),
+		)
+
+		got, err := NewTaxonomyUsecase(catalog).Get(context.Background(), model.TaxonomyGenre, "43", "6003", "")
+		require.NoError(t, err)
+		require.Equal(t, &testGenre, got)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		catalog := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+		catalog.EXPECT().
+			ListTaxonomy(gomock.Any(), model.TaxonomyMaker, model.TaxonomyQuery{Initial: "あ", Hits: maxHits, Offset: 1}).
+			Return([]model.TaxonomyEntry{testGenre}, &model.SearchMetadata{ResultCount: 1, TotalCount: 1, FirstPosition: 1}, nil)
+
+		_, err := NewTaxonomyUsecase(catalog).Get(context.Background(), model.TaxonomyMaker, "", "9999", "あ")
+		require.ErrorIs(t, err, port.ErrCatalogNotFound)
+	})
+
+	t.Run("lookup limit exceeded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		page := make([]model.TaxonomyEntry, maxHits)
+		for i := range page {
+			page[i] = model.TaxonomyEntry{ID: "x"}
+		}
+		catalog := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+		catalog.EXPECT().
+			ListTaxonomy(gomock.Any(), model.TaxonomyMaker, gomock.Any()).
+			Return(page, &model.SearchMetadata{ResultCount: int(maxHits), TotalCount: 100000, FirstPosition: 1}, nil).
+			Times(maxLookupPages)
+
+		_, err := NewTaxonomyUsecase(catalog).Get(context.Background(), model.TaxonomyMaker, "", "9999", "")
+		require.ErrorIs(t, err, ErrLookupLimitExceeded)
+		require.NotErrorIs(t, err, port.ErrCatalogNotFound)
+	})
+
+	t.Run("empty id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := NewTaxonomyUsecase(mockcatalog.NewMockTaxonomyCatalog(ctrl)).Get(context.Background(), model.TaxonomySeries, "", "", "")
+		require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+	})
+
+	t.Run("catalog error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		upstreamErr := errors.New("upstream error")
+		catalog := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+		catalog.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomySeries, gomock.Any()).Return(nil, nil, upstreamErr)
+
+		_, err := NewTaxonomyUsecase(catalog).Get(context.Background(), model.TaxonomySeries, "", "1", "")
+		require.ErrorIs(t, err, upstreamErr)
+	})
+}
@@ -5,6 +5,8 @@ package usecase
 import (
 	"context"
 	"log/slog"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/tikfack/server/internal/application/model"
@@ -17,39 +19,56 @@ const (
 	maxOffset int32 = 50000
 )
 
+// batchLookupConcurrency は GetVideosByDmmIds で同時に取得する動画の上限。
+const batchLookupConcurrency = 8
+
 // VideoUsecase は動画関連のユースケースを定義するインターフェイス
 // DMM のような外部カタログに依存するアクセスをアプリケーション層で
 // 一元的に扱うためのポートを切っている。
+// floor は検索対象のフロアで、空の項目は既定値で補ったうえで FloorList に存在する組み合わせか検証する。
+// 一覧を返すメソッドの sale は、カタログから取得したページ内だけに適用する。
 type VideoUsecase interface {
 	// GetVideosByDate は指定日付の動画一覧を取得する
-	GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error)
 
 	// GetVideoById は指定されたDMMビデオIDの動画を取得する
-	GetVideoById(ctx context.Context, dmmId string) (*model.Video, error)
+	GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error)
 
 	// SearchVideos はキーワードやIDを使って動画を検索する
-	SearchVideos(ctx context.Context, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error)
+	SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error)
 
 	// GetVideosByID は複数ID条件で動画を検索する
-	GetVideosByID(ctx context.Context, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByID(ctx context.Context, floor model.FloorSelector, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string, hits, offset int32, sort, gteDate, lteDate string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error)
 
 	// GetVideosByKeyword はキーワード検索を行う
-	GetVideosByKeyword(ctx context.Context, keyword string, hits, offset int32, sort, gteDate, lteDate, site, service, floor string) ([]model.Video, *model.SearchMetadata, error)
+	GetVideosByKeyword(ctx context.Context, floor model.FloorSelector, keyword string, hits, offset int32, sort, gteDate, lteDate string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error)
+
+	// GetVideosByDmmIds は複数の DMM ビデオ ID の動画を並行して取得し、ID ごとの結果をリクエストと同じ順序で返す。
+	// 見つからない・取得に失敗した ID は呼び出し全体を失敗させず、結果の Status で区別する。
+	GetVideosByDmmIds(ctx context.Context, floor model.FloorSelector, dmmIDs []string) ([]model.VideoLookup, error)
+
+	// ListFloors は検索対象に指定できるフロアの一覧を取得する
+	ListFloors(ctx context.Context) ([]model.Floor, error)
 }
 
 // videoUsecase は VideoUsecase の実装
 type videoUsecase struct {
 	catalog port.VideoCatalog
+	floors  port.FloorCatalog
 	logger  *slog.Logger
 }
 
-// NewVideoUsecase は VideoCatalog ポートを受け取り VideoUsecase を返す
-func NewVideoUsecase(catalog port.VideoCatalog) VideoUsecase {
+// NewVideoUsecase は VideoCatalog・FloorCatalog ポートを受け取り VideoUsecase を返す
+func NewVideoUsecase(catalog port.VideoCatalog, floors port.FloorCatalog) VideoUsecase {
 	if catalog == nil {
 		panic("video catalog must be provided")
 	}
+	if floors == nil {
+		panic("floor catalog must be provided")
+	}
 	return &videoUsecase{
 		catalog: catalog,
+		floors:  floors,
 		logger:  slog.Default().With(slog.String("component", "video_usecase")),
 	}
 }
@@ -63,50 +82,83 @@ func (u *videoUsecase) loggerWithCtx(ctx context.Context) *slog.Logger {
 }
 
 // GetVideosByDate は指定日付の動画一覧を取得する
-func (u *videoUsecase) GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+func (u *videoUsecase) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	logger := u.loggerWithCtx(ctx)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, nil, err
+	}
 	normHits := clampHits(hits)
 	normOffset := clampOffset(offset)
 	logger.Debug("GetVideosByDate called",
+		"floor", floor,
 		"targetDate", targetDate.Format("2006-01-02"),
 		"hits", normHits,
 		"offset", normOffset,
+		"sale", sale,
 	)
-	return u.catalog.GetVideosByDate(ctx, targetDate, normHits, normOffset)
+	videos, md, err := u.catalog.GetVideosByDate(ctx, floor, targetDate, normHits, normOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+	videos, md = applySaleOptions(videos, md, sale)
+	return videos, md, nil
 }
 
 // GetVideoById は、指定された DMMビデオID の動画を取得する
-func (u *videoUsecase) GetVideoById(ctx context.Context, dmmId string) (*model.Video, error) {
+func (u *videoUsecase) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error) {
 	logger := u.loggerWithCtx(ctx)
-	logger.Debug("GetVideoById called", "dmmId", dmmId)
-	return u.catalog.GetVideoById(ctx, dmmId)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("GetVideoById called", "floor", floor, "dmmId", dmmId)
+	return u.catalog.GetVideoById(ctx, floor, dmmId)
 }
 
 // SearchVideos はキーワードやIDを使って動画を検索する
-func (u *videoUsecase) SearchVideos(ctx context.Context, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
+func (u *videoUsecase) SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 	logger := u.loggerWithCtx(ctx)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, nil, err
+	}
 	logger.Debug("SearchVideos called",
+		"floor", floor,
 		"keyword", keyword,
 		"actressID", actressID,
 		"genreID", genreID,
 		"makerID", makerID,
 		"seriesID", seriesID,
 		"directorID", directorID,
+		"sale", sale,
 	)
-	return u.catalog.SearchVideos(ctx, keyword, actressID, genreID, makerID, seriesID, directorID)
+	videos, md, err := u.catalog.SearchVideos(ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	videos, md = applySaleOptions(videos, md, sale)
+	return videos, md, nil
 }
 
 // GetVideosByID は指定されたIDを使って動画を検索する
 func (u *videoUsecase) GetVideosByID(
 	ctx context.Context,
+	floor model.FloorSelector,
 	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
 	hits, offset int32,
-	sort, gteDate, lteDate, site, service, floor string,
+	sort, gteDate, lteDate string,
+	sale model.PageSaleOptions,
 ) ([]model.Video, *model.SearchMetadata, error) {
 	logger := u.loggerWithCtx(ctx)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, nil, err
+	}
 	normHits := clampHits(hits)
 	normOffset := clampOffset(offset)
 	logger.Debug("GetVideosByID called",
+		"floor", floor,
 		"actressIDs", actressIDs,
 		"genreIDs", genreIDs,
 		"makerIDs", makerIDs,
@@ -117,35 +169,143 @@ func (u *videoUsecase) GetVideosByID(
 		"sort", sort,
 		"gteDate", gteDate,
 		"lteDate", lteDate,
-		"site", site,
-		"service", service,
-		"floor", floor,
+		"sale", sale,
 	)
-	return u.catalog.GetVideosByID(ctx, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, normHits, normOffset, sort, gteDate, lteDate, site, service, floor)
+	videos, md, err := u.catalog.GetVideosByID(ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, normHits, normOffset, sort, gteDate, lteDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	videos, md = applySaleOptions(videos, md, sale)
+	return videos, md, nil
 }
 
 // GetVideosByKeyword はキーワードを使って動画を検索する
 func (u *videoUsecase) GetVideosByKeyword(
 	ctx context.Context,
+	floor model.FloorSelector,
 	keyword string,
 	hits, offset int32,
-	sort, gteDate, lteDate, site, service, floor string,
+	sort, gteDate, lteDate string,
+	sale model.PageSaleOptions,
 ) ([]model.Video, *model.SearchMetadata, error) {
 	logger := u.loggerWithCtx(ctx)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, nil, err
+	}
 	normHits := clampHits(hits)
 	normOffset := clampOffset(offset)
 	logger.Debug("GetVideosByKeyword called",
+		"floor", floor,
 		"keyword", keyword,
 		"hits", normHits,
 		"offset", normOffset,
 		"sort", sort,
 		"gteDate", gteDate,
 		"lteDate", lteDate,
-		"site", site,
-		"service", service,
-		"floor", floor,
+		"sale", sale,
 	)
-	return u.catalog.GetVideosByKeyword(ctx, keyword, normHits, normOffset, sort, gteDate, lteDate, site, service, floor)
+	videos, md, err := u.catalog.GetVideosByKeyword(ctx, floor, keyword, normHits, normOffset, sort, gteDate, lteDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	videos, md = applySaleOptions(videos, md, sale)
+	return videos, md, nil
+}
+
+// GetVideosByDmmIds は複数の DMM ビデオ ID の動画を並行して取得する。
+// 1 件ずつ VideoCatalog.GetVideoById を呼ぶため、キャッシュと同時リクエストの集約はカタログ側のものが効く。
+// 同じ ID が複数回指定された場合は 1 回だけ取得し、同時に取得するのは batchLookupConcurrency 件まで。
+func (u *videoUsecase) GetVideosByDmmIds(ctx context.Context, floor model.FloorSelector, dmmIDs []string) ([]model.VideoLookup, error) {
+	logger := u.loggerWithCtx(ctx)
+	floor, err := u.floors.ResolveFloor(ctx, floor)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("GetVideosByDmmIds called", "floor", floor, "count", len(dmmIDs))
+
+	unique := make(map[string]*model.VideoLookup, len(dmmIDs))
+	for _, id := range dmmIDs {
+		if _, ok := unique[id]; !ok {
+			unique[id] = &model.VideoLookup{DmmID: id}
+		}
+	}
+
+	sem := make(chan struct{}, batchLookupConcurrency)
+	var wg sync.WaitGroup
+	for _, lookup := range unique {
+		wg.Add(1)
+		go func(lookup *model.VideoLookup) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+			}
+			// 空きと ctx の終了が同時に成立した場合も、終了後は新たに取得しない
+			if err := ctx.Err(); err != nil {
+				lookup.Status, lookup.Err = model.LookupFailed, err
+				return
+			}
+			u.lookupVideo(ctx, floor, lookup)
+		}(lookup)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]model.VideoLookup, 0, len(dmmIDs))
+	for _, id := range dmmIDs {
+		results = append(results, *unique[id])
+	}
+	return results, nil
+}
+
+// lookupVideo は 1 件の動画を取得し、結果を lookup に設定する。
+func (u *videoUsecase) lookupVideo(ctx context.Context, floor model.FloorSelector, lookup *model.VideoLookup) {
+	video, err := u.catalog.GetVideoById(ctx, floor, lookup.DmmID)
+	lookup.Status = port.LookupStatusOf(video != nil, err)
+	switch lookup.Status {
+	case model.LookupFound:
+		lookup.Video = video
+	case model.LookupFailed:
+		u.loggerWithCtx(ctx).Warn("動画の取得に失敗", "dmmId", lookup.DmmID, "error", err)
+		lookup.Err = err
+	}
+}
+
+// ListFloors は検索対象に指定できるフロアの一覧を取得する
+func (u *videoUsecase) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	logger := u.loggerWithCtx(ctx)
+	logger.Debug("ListFloors called")
+	return u.floors.ListFloors(ctx)
+}
+
+// applySaleOptions は取得したページにセール中の絞り込みと割引率順の並べ替えを適用する。
+// 絞り込んだ場合 ResultCount は返す件数に合わせるが、TotalCount は DMM API の件数のままとする。
+func applySaleOptions(videos []model.Video, md *model.SearchMetadata, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata) {
+	if sale.FilterOnSale {
+		filtered := make([]model.Video, 0, len(videos))
+		for _, v := range videos {
+			if v.Pricing.OnSale() {
+				filtered = append(filtered, v)
+			}
+		}
+		videos = filtered
+		if md != nil {
+			copied := *md
+			copied.ResultCount = len(videos)
+			md = &copied
+		}
+	}
+	if sale.SortByDiscount {
+		videos = slices.Clone(videos)
+		slices.SortStableFunc(videos, func(a, b model.Video) int {
+			return b.Pricing.MaxDiscountPercent() - a.Pricing.MaxDiscountPercent()
+		})
+	}
+	return videos, md
 }
 
 func clampHits(hits int32) int32 {
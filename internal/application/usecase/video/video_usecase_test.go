@@ -3,11 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
 	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -31,14 +34,31 @@ var (
 		Review:       model.Review{Count: 100, Average: 4.5},
 	}
 	testMetadata = &model.SearchMetadata{ResultCount: 10, TotalCount: 100, FirstPosition: 1}
+	defaultFloor = model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoa"}
+	amateurFloor = model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoc"}
 )
 
+// newTestFloors は空の項目を既定値で補うだけの FloorCatalog のモックを返す。
+func newTestFloors(ctrl *gomock.Controller) *mockcatalog.MockFloorCatalog {
+	floors := mockcatalog.NewMockFloorCatalog(ctrl)
+	floors.EXPECT().
+		ResolveFloor(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, selector model.FloorSelector) (model.FloorSelector, error) {
+			if selector == (model.FloorSelector{}) {
+				return defaultFloor, nil
+			}
+			return selector, nil
+		}).
+		AnyTimes()
+	return floors
+}
+
 func TestNewVideoUsecase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockCatalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(mockCatalog)
+	uc := NewVideoUsecase(mockCatalog, newTestFloors(ctrl))
 	require.NotNil(t, uc)
 }
 
@@ -47,7 +67,7 @@ func TestGetVideosByDate(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	cases := []struct {
 		name         string
@@ -76,10 +96,10 @@ func TestGetVideosByDate(t *testing.T) {
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
 			catalog.EXPECT().
-				GetVideosByDate(gomock.Any(), testTime, tt.expectHits, tt.expectOffset).
+				GetVideosByDate(gomock.Any(), defaultFloor, testTime, tt.expectHits, tt.expectOffset).
 				Return([]model.Video{testVideo}, testMetadata, tt.expectErr)
 
-			videos, md, err := uc.GetVideosByDate(context.Background(), testTime, tt.hits, tt.offset)
+			videos, md, err := uc.GetVideosByDate(context.Background(), model.FloorSelector{}, testTime, tt.hits, tt.offset, model.PageSaleOptions{})
 			if tt.expectErr != nil {
 				require.ErrorIs(t, err, tt.expectErr)
 				require.Nil(t, videos)
@@ -99,13 +119,13 @@ func TestGetVideoById(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	catalog.EXPECT().
-		GetVideoById(gomock.Any(), "abc123").
+		GetVideoById(gomock.Any(), defaultFloor, "abc123").
 		Return(&testVideo, nil)
 
-	video, err := uc.GetVideoById(context.Background(), "abc123")
+	video, err := uc.GetVideoById(context.Background(), model.FloorSelector{}, "abc123")
 	require.NoError(t, err)
 	require.Equal(t, &testVideo, video)
 }
@@ -115,13 +135,13 @@ func TestSearchVideos(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	catalog.EXPECT().
-		SearchVideos(gomock.Any(), "keyword", "a", "g", "m", "s", "d").
+		SearchVideos(gomock.Any(), defaultFloor, "keyword", "a", "g", "m", "s", "d").
 		Return([]model.Video{testVideo}, testMetadata, nil)
 
-	videos, md, err := uc.SearchVideos(context.Background(), "keyword", "a", "g", "m", "s", "d")
+	videos, md, err := uc.SearchVideos(context.Background(), model.FloorSelector{}, "keyword", "a", "g", "m", "s", "d", model.PageSaleOptions{})
 	require.NoError(t, err)
 	require.Equal(t, []model.Video{testVideo}, videos)
 	require.Equal(t, testMetadata, md)
@@ -132,7 +152,7 @@ func TestGetVideosByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	actress := []string{"a1"}
 	genre := []string{"g1"}
@@ -141,10 +161,10 @@ func TestGetVideosByID(t *testing.T) {
 	director := []string{"d1"}
 
 	catalog.EXPECT().
-		GetVideosByID(gomock.Any(), actress, genre, maker, series, director, maxHits, int32(0), "popular", "", "", "", "", "").
+		GetVideosByID(gomock.Any(), defaultFloor, actress, genre, maker, series, director, maxHits, int32(0), "popular", "", "").
 		Return([]model.Video{testVideo}, testMetadata, nil)
 
-	videos, md, err := uc.GetVideosByID(context.Background(), actress, genre, maker, series, director, 1000, -10, "popular", "", "", "", "", "")
+	videos, md, err := uc.GetVideosByID(context.Background(), model.FloorSelector{}, actress, genre, maker, series, director, 1000, -10, "popular", "", "", model.PageSaleOptions{})
 	require.NoError(t, err)
 	require.Equal(t, []model.Video{testVideo}, videos)
 	require.Equal(t, testMetadata, md)
@@ -155,13 +175,13 @@ func TestGetVideosByKeyword(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	catalog.EXPECT().
-		GetVideosByKeyword(gomock.Any(), "hello", int32(50), int32(20), "date", "2024-01-01", "", "FANZA", "digital", "videoa").
+		GetVideosByKeyword(gomock.Any(), amateurFloor, "hello", int32(50), int32(20), "date", "2024-01-01", "").
 		Return([]model.Video{testVideo}, testMetadata, nil)
 
-	videos, md, err := uc.GetVideosByKeyword(context.Background(), "hello", 50, 20, "date", "2024-01-01", "", "FANZA", "digital", "videoa")
+	videos, md, err := uc.GetVideosByKeyword(context.Background(), amateurFloor, "hello", 50, 20, "date", "2024-01-01", "", model.PageSaleOptions{})
 	require.NoError(t, err)
 	require.Equal(t, []model.Video{testVideo}, videos)
 	require.Equal(t, testMetadata, md)
@@ -172,14 +192,230 @@ func TestGetVideosByKeywordClamp(t *testing.T) {
 	defer ctrl.Finish()
 
 	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
-	uc := NewVideoUsecase(catalog)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
 
 	catalog.EXPECT().
-		GetVideosByKeyword(gomock.Any(), "hello", maxHits, int32(0), "", "", "", "", "", "").
+		GetVideosByKeyword(gomock.Any(), defaultFloor, "hello", maxHits, int32(0), "", "", "").
 		Return(nil, nil, errors.New("boom"))
 
-	videos, md, err := uc.GetVideosByKeyword(context.Background(), "hello", 200, -30, "", "", "", "", "", "")
+	videos, md, err := uc.GetVideosByKeyword(context.Background(), model.FloorSelector{}, "hello", 200, -30, "", "", "", model.PageSaleOptions{})
 	require.Nil(t, videos)
 	require.Nil(t, md)
 	require.Error(t, err)
 }
+
+func TestSaleOptions(t *testing.T) {
+	onSale := func(id string, price, listPrice int) model.Video {
+		return model.Video{DmmID: id, Pricing: model.Pricing{
+			Currency:   model.CurrencyJPY,
+			Deliveries: []model.DeliveryPrice{model.NewDeliveryPrice(model.DeliveryStream, price, listPrice)},
+		}}
+	}
+	upstream := []model.Video{
+		onSale("regular", 1980, 1980),
+		onSale("half", 990, 1980),
+		onSale("small", 1780, 1980),
+		{DmmID: "unknown"},
+	}
+	md := &model.SearchMetadata{ResultCount: 4, TotalCount: 100, FirstPosition: 1}
+
+	ids := func(videos []model.Video) []string {
+		out := make([]string, 0, len(videos))
+		for _, v := range videos {
+			out = append(out, v.DmmID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		sale        model.PageSaleOptions
+		expectedIDs []string
+		expectedMD  *model.SearchMetadata
+	}{
+		{
+			name:        "指定なしはカタログの結果のまま",
+			expectedIDs: []string{"regular", "half", "small", "unknown"},
+			expectedMD:  md,
+		},
+		{
+			name:        "セール中のみ",
+			sale:        model.PageSaleOptions{FilterOnSale: true},
+			expectedIDs: []string{"half", "small"},
+			expectedMD:  &model.SearchMetadata{ResultCount: 2, TotalCount: 100, FirstPosition: 1},
+		},
+		{
+			name:        "割引率順",
+			sale:        model.PageSaleOptions{SortByDiscount: true},
+			expectedIDs: []string{"half", "small", "regular", "unknown"},
+			expectedMD:  md,
+		},
+		{
+			name:        "セール中のみを割引率順",
+			sale:        model.PageSaleOptions{FilterOnSale: true, SortByDiscount: true},
+			expectedIDs: []string{"half", "small"},
+			expectedMD:  &model.SearchMetadata{ResultCount: 2, TotalCount: 100, FirstPosition: 1},
+		},
+	}
+
+	// 一覧を返すメソッドはいずれも同じようにページ内で適用する
+	lists := []struct {
+		name string
+		call func(uc VideoUsecase, catalog *mockcatalog.MockVideoCatalog, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error)
+	}{
+		{
+			name: "GetVideosByDate",
+			call: func(uc VideoUsecase, catalog *mockcatalog.MockVideoCatalog, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
+				catalog.EXPECT().GetVideosByDate(gomock.Any(), defaultFloor, testTime, int32(20), int32(0)).Return(upstream, md, nil)
+				return uc.GetVideosByDate(context.Background(), model.FloorSelector{}, testTime, 20, 0, sale)
+			},
+		},
+		{
+			name: "SearchVideos",
+			call: func(uc VideoUsecase, catalog *mockcatalog.MockVideoCatalog, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
+				catalog.EXPECT().SearchVideos(gomock.Any(), defaultFloor, "hello", "", "", "", "", "").Return(upstream, md, nil)
+				return uc.SearchVideos(context.Background(), model.FloorSelector{}, "hello", "", "", "", "", "", sale)
+			},
+		},
+		{
+			name: "GetVideosByID",
+			call: func(uc VideoUsecase, catalog *mockcatalog.MockVideoCatalog, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
+				catalog.EXPECT().GetVideosByID(gomock.Any(), defaultFloor, nil, nil, nil, nil, nil, int32(20), int32(0), "rank", "", "").Return(upstream, md, nil)
+				return uc.GetVideosByID(context.Background(), model.FloorSelector{}, nil, nil, nil, nil, nil, 20, 0, "rank", "", "", sale)
+			},
+		},
+		{
+			name: "GetVideosByKeyword",
+			call: func(uc VideoUsecase, catalog *mockcatalog.MockVideoCatalog, sale model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
+				catalog.EXPECT().GetVideosByKeyword(gomock.Any(), defaultFloor, "hello", int32(20), int32(0), "date", "", "").Return(upstream, md, nil)
+				return uc.GetVideosByKeyword(context.Background(), model.FloorSelector{}, "hello", 20, 0, "date", "", "", sale)
+			},
+		},
+	}
+
+	for _, list := range lists {
+		for _, tt := range tests {
+			t.Run(list.name+"/"+tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+				uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
+
+				videos, gotMD, err := list.call(uc, catalog, tt.sale)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedIDs, ids(videos))
+				require.Equal(t, tt.expectedMD, gotMD)
+				require.Equal(t, 4, md.ResultCount, "カタログが返したメタデータは変更しない")
+				require.Equal(t, "regular", upstream[0].DmmID, "カタログが返したスライスは並べ替えない")
+			})
+		}
+	}
+}
+
+func TestGetVideosByDmmIds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
+
+	upstreamErr := &port.CatalogError{Kind: port.ErrCatalogUnavailable, Err: errors.New("timeout")}
+	catalog.EXPECT().GetVideoById(gomock.Any(), defaultFloor, "test123").Return(&testVideo, nil).Times(1)
+	catalog.EXPECT().GetVideoById(gomock.Any(), defaultFloor, "gone").
+		Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound, Err: errors.New("not found")})
+	catalog.EXPECT().GetVideoById(gomock.Any(), defaultFloor, "flaky").Return(nil, upstreamErr)
+
+	results, err := uc.GetVideosByDmmIds(context.Background(), model.FloorSelector{}, []string{"gone", "test123", "flaky", "test123"})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.Equal(t, model.VideoLookup{DmmID: "gone", Status: model.LookupNotFound}, results[0])
+	require.Equal(t, model.VideoLookup{DmmID: "test123", Status: model.LookupFound, Video: &testVideo}, results[1])
+	require.Equal(t, "flaky", results[2].DmmID)
+	require.Equal(t, model.LookupFailed, results[2].Status)
+	require.ErrorIs(t, results[2].Err, port.ErrCatalogUnavailable)
+	require.Equal(t, results[1], results[3], "重複した ID は 1 回だけ取得して同じ結果を返す")
+}
+
+func TestGetVideosByDmmIdsBoundedConcurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
+
+	var inFlight, peak atomic.Int32
+	catalog.EXPECT().
+		GetVideoById(gomock.Any(), defaultFloor, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ model.FloorSelector, id string) (*model.Video, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return &model.Video{DmmID: id}, nil
+		}).
+		Times(40)
+
+	ids := make([]string, 40)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("vid%02d", i)
+	}
+	results, err := uc.GetVideosByDmmIds(context.Background(), model.FloorSelector{}, ids)
+	require.NoError(t, err)
+	for i, r := range results {
+		require.Equal(t, ids[i], r.DmmID, "リクエストと同じ順序で返す")
+		require.Equal(t, ids[i], r.Video.DmmID)
+	}
+	require.LessOrEqual(t, peak.Load(), int32(batchLookupConcurrency))
+}
+
+func TestGetVideosByDmmIdsCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+	uc := NewVideoUsecase(catalog, newTestFloors(ctrl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	catalog.EXPECT().
+		GetVideoById(gomock.Any(), defaultFloor, "test123").
+		DoAndReturn(func(ctx context.Context, _ model.FloorSelector, _ string) (*model.Video, error) {
+			cancel()
+			return nil, ctx.Err()
+		})
+
+	results, err := uc.GetVideosByDmmIds(ctx, model.FloorSelector{}, []string{"test123"})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, results)
+}
+
+func TestInvalidFloorIsRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+	floors := mockcatalog.NewMockFloorCatalog(ctrl)
+	uc := NewVideoUsecase(catalog, floors)
+
+	invalid := model.FloorSelector{Service: "unknown"}
+	floors.EXPECT().
+		ResolveFloor(gomock.Any(), invalid).
+		Return(model.FloorSelector{}, &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Err: errors.New("存在しないフロアです")})
+
+	_, _, err := uc.GetVideosByDate(context.Background(), invalid, testTime, 10, 0, model.PageSaleOptions{})
+	require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+}
+
+func TestListFloors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalog := mockcatalog.NewMockVideoCatalog(ctrl)
+	floors := mockcatalog.NewMockFloorCatalog(ctrl)
+	uc := NewVideoUsecase(catalog, floors)
+
+	expected := []model.Floor{{SiteCode: "FANZA", ServiceCode: "digital", FloorID: "43", FloorName: "ビデオ", FloorCode: "videoa"}}
+	floors.EXPECT().ListFloors(gomock.Any()).Return(expected, nil)
+
+	got, err := uc.ListFloors(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+}
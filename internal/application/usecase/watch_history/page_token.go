@@ -0,0 +1,72 @@
+package watchhistory
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+const (
+	// defaultPageSize is used when a listing does not specify a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the page size a client may request.
+	maxPageSize = 200
+)
+
+// ErrInvalidPageToken is returned when a page token is malformed or was issued for a different filter.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageToken is the decoded form of the opaque token handed to clients.
+// It records the last entry of the previous page and whether the listing was filtered to videos in progress.
+type pageToken struct {
+	LastEventAt    time.Time `json:"t"`
+	VideoID        string    `json:"v"`
+	InProgressOnly bool      `json:"p,omitempty"`
+}
+
+func encodePageToken(token pageToken) string {
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &token); err != nil || token.VideoID == "" || token.LastEventAt.IsZero() {
+		return token, ErrInvalidPageToken
+	}
+	return token, nil
+}
+
+// listOptions translates a page request into repository options and the effective page size.
+// One extra row is requested so the caller can tell whether another page follows.
+func listOptions(query model.WatchHistoryQuery) (repository.WatchHistoryListOptions, int, error) {
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	opts := repository.WatchHistoryListOptions{
+		Limit:          pageSize + 1,
+		InProgressOnly: query.InProgressOnly,
+	}
+	if query.PageToken != "" {
+		token, err := decodePageToken(query.PageToken)
+		if err != nil {
+			return opts, 0, err
+		}
+		if token.InProgressOnly != query.InProgressOnly {
+			return opts, 0, ErrInvalidPageToken
+		}
+		opts.After = &repository.WatchHistoryCursor{LastEventAt: token.LastEventAt, VideoID: token.VideoID}
+	}
+	return opts, pageSize, nil
+}
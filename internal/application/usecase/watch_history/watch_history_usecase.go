@@ -0,0 +1,142 @@
+package watchhistory
+
+//go:generate mockgen -destination=../mock/mock_watch_history_usecase.go -package=mock github.com/tikfack/server/internal/application/usecase/watch_history WatchHistoryUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// WatchHistoryUsecase maintains per-user watch history from playback events and serves it back to the user.
+type WatchHistoryUsecase interface {
+	// ApplyEvents folds playback events into watch history. Events without a user or video, non-playback
+	// events and events older than the stored state are skipped, so the same events can be applied more than once.
+	ApplyEvents(ctx context.Context, events []*entity.EventLog) error
+
+	ListWatchHistory(ctx context.Context, keycloakID string, query model.WatchHistoryQuery) (*model.WatchHistoryPage, error)
+	// GetResumePosition returns where to resume a video; a video never played resumes from 0.
+	GetResumePosition(ctx context.Context, keycloakID, videoID string) (*model.ResumePosition, error)
+	// ClearHistory removes the given videos from the history, or the whole history when videoIDs is empty,
+	// and returns the number of entries removed.
+	ClearHistory(ctx context.Context, keycloakID string, videoIDs []string) (int, error)
+}
+
+// usecase implements WatchHistoryUsecase.
+type usecase struct {
+	historyRepo repository.WatchHistoryRepository
+}
+
+// NewWatchHistoryUsecase constructs a WatchHistoryUsecase.
+func NewWatchHistoryUsecase(historyRepo repository.WatchHistoryRepository) WatchHistoryUsecase {
+	return &usecase{historyRepo: historyRepo}
+}
+
+// historyKey identifies one user's history of one video.
+type historyKey struct {
+	userID  string
+	videoID string
+}
+
+func (u *usecase) ApplyEvents(ctx context.Context, events []*entity.EventLog) error {
+	grouped := make(map[historyKey][]*entity.EventLog)
+	for _, e := range events {
+		if !entity.IsPlaybackEvent(e) {
+			continue
+		}
+		key := historyKey{userID: e.UserID, videoID: e.VideoDmmID}
+		grouped[key] = append(grouped[key], e)
+	}
+
+	var errs []error
+	for key, group := range grouped {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := u.applyGroup(ctx, key, group); err != nil {
+			errs = append(errs, fmt.Errorf("apply events of user %s to video %s: %w", key.userID, key.videoID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyGroup applies one user's events for one video in event time order and stores the result.
+func (u *usecase) applyGroup(ctx context.Context, key historyKey, events []*entity.EventLog) error {
+	history, err := u.historyRepo.Get(ctx, key.userID, key.videoID)
+	if errors.Is(err, repository.ErrWatchHistoryNotFound) {
+		history = &entity.WatchHistory{UserID: key.userID, VideoID: key.videoID}
+	} else if err != nil {
+		return err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].EventTime.Before(events[j].EventTime) })
+	applied := false
+	for _, e := range events {
+		if history.Apply(e) {
+			applied = true
+		}
+	}
+	if !applied {
+		return nil
+	}
+	return u.historyRepo.Upsert(ctx, history)
+}
+
+func (u *usecase) ListWatchHistory(ctx context.Context, keycloakID string, query model.WatchHistoryQuery) (*model.WatchHistoryPage, error) {
+	opts, pageSize, err := listOptions(query)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := u.historyRepo.ListByUserID(ctx, keycloakID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.WatchHistoryPage{}
+	if len(histories) > pageSize {
+		histories = histories[:pageSize]
+		last := histories[pageSize-1]
+		page.NextPageToken = encodePageToken(pageToken{
+			LastEventAt:    last.LastEventAt,
+			VideoID:        last.VideoID,
+			InProgressOnly: query.InProgressOnly,
+		})
+	}
+	page.Entries = make([]model.WatchHistory, 0, len(histories))
+	for _, history := range histories {
+		page.Entries = append(page.Entries, model.NewWatchHistoryFromEntity(history))
+	}
+	return page, nil
+}
+
+func (u *usecase) GetResumePosition(ctx context.Context, keycloakID, videoID string) (*model.ResumePosition, error) {
+	history, err := u.historyRepo.Get(ctx, keycloakID, videoID)
+	if errors.Is(err, repository.ErrWatchHistoryNotFound) {
+		return &model.ResumePosition{VideoID: videoID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.ResumePosition{
+		VideoID:         videoID,
+		PositionSeconds: history.ResumePositionSeconds(),
+		DurationSeconds: history.DurationSeconds,
+		Completed:       history.Completed,
+		HasHistory:      true,
+	}, nil
+}
+
+func (u *usecase) ClearHistory(ctx context.Context, keycloakID string, videoIDs []string) (int, error) {
+	removed, err := u.historyRepo.Delete(ctx, keycloakID, videoIDs)
+	if err != nil {
+		return 0, err
+	}
+	logger.LoggerWithCtx(ctx).Info("watch history cleared", "video_count", len(videoIDs), "removed", removed)
+	return removed, nil
+}
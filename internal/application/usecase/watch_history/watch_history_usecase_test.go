@@ -0,0 +1,145 @@
+package watchhistory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
+	watchhistoryrepo "github.com/tikfack/server/internal/infrastructure/repository/watch_history"
+)
+
+const (
+	userID  = "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"
+	otherID = "9b2d7e10-3c4f-4a6b-8e1d-7f0a2b3c4d5e"
+)
+
+var baseTime = time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+
+func playbackEvent(user, video, eventType string, at time.Duration, position, duration float64) *entity.EventLog {
+	props, _ := json.Marshal(map[string]float64{"position": position, "duration": duration})
+	return &entity.EventLog{
+		UserID:     user,
+		VideoDmmID: video,
+		EventType:  eventType,
+		EventTime:  baseTime.Add(at),
+		Props:      props,
+	}
+}
+
+func TestApplyEvents_TracksPositionAndCompletion(t *testing.T) {
+	uc := NewWatchHistoryUsecase(watchhistoryrepo.NewMemoryWatchHistoryRepository())
+	ctx := context.Background()
+
+	// events arrive out of order and mixed with non-playback and anonymous events
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypePause, 2*time.Minute, 300, 3600),
+		playbackEvent(userID, "abc001", entity.EventTypeStart, time.Minute, 0, 3600),
+		playbackEvent(userID, "abc001", "like", 3*time.Minute, 0, 0),
+		playbackEvent("", "abc001", entity.EventTypePause, 4*time.Minute, 900, 3600),
+	}))
+
+	pos, err := uc.GetResumePosition(ctx, userID, "abc001")
+	require.NoError(t, err)
+	require.True(t, pos.HasHistory)
+	require.Equal(t, 300.0, pos.PositionSeconds)
+	require.Equal(t, 3600.0, pos.DurationSeconds)
+	require.False(t, pos.Completed)
+
+	// an event older than the stored state does not move the position back
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypePause, 90*time.Second, 120, 3600),
+	}))
+	pos, err = uc.GetResumePosition(ctx, userID, "abc001")
+	require.NoError(t, err)
+	require.Equal(t, 300.0, pos.PositionSeconds)
+
+	// completion resumes from the start; a later rewatch keeps the completed flag
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypeComplete, 70*time.Minute, 3600, 3600),
+	}))
+	pos, err = uc.GetResumePosition(ctx, userID, "abc001")
+	require.NoError(t, err)
+	require.True(t, pos.Completed)
+	require.Zero(t, pos.PositionSeconds)
+
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypePause, 80*time.Minute, 600, 3600),
+	}))
+	pos, err = uc.GetResumePosition(ctx, userID, "abc001")
+	require.NoError(t, err)
+	require.True(t, pos.Completed)
+	require.Equal(t, 600.0, pos.PositionSeconds)
+
+	pos, err = uc.GetResumePosition(ctx, otherID, "abc001")
+	require.NoError(t, err)
+	require.False(t, pos.HasHistory)
+	require.Zero(t, pos.PositionSeconds)
+}
+
+func TestListWatchHistory_PagesMostRecentFirst(t *testing.T) {
+	uc := NewWatchHistoryUsecase(watchhistoryrepo.NewMemoryWatchHistoryRepository())
+	ctx := context.Background()
+
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypePause, time.Minute, 100, 1000),
+		playbackEvent(userID, "abc002", entity.EventTypeComplete, 2*time.Minute, 1000, 1000),
+		playbackEvent(userID, "abc003", entity.EventTypePause, 3*time.Minute, 200, 1000),
+		playbackEvent(otherID, "abc004", entity.EventTypePause, 4*time.Minute, 300, 1000),
+	}))
+
+	first, err := uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc003", "abc002"}, entryVideoIDs(first.Entries))
+	require.NotEmpty(t, first.NextPageToken)
+
+	second, err := uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{PageSize: 2, PageToken: first.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc001"}, entryVideoIDs(second.Entries))
+	require.Empty(t, second.NextPageToken)
+	require.Equal(t, 0.1, second.Entries[0].Progress)
+
+	inProgress, err := uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{InProgressOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc003", "abc001"}, entryVideoIDs(inProgress.Entries))
+
+	_, err = uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{PageToken: first.NextPageToken, InProgressOnly: true})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+	_, err = uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{PageToken: "not-a-token"})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestClearHistory(t *testing.T) {
+	uc := NewWatchHistoryUsecase(watchhistoryrepo.NewMemoryWatchHistoryRepository())
+	ctx := context.Background()
+
+	require.NoError(t, uc.ApplyEvents(ctx, []*entity.EventLog{
+		playbackEvent(userID, "abc001", entity.EventTypePause, time.Minute, 100, 1000),
+		playbackEvent(userID, "abc002", entity.EventTypePause, 2*time.Minute, 100, 1000),
+		playbackEvent(userID, "abc003", entity.EventTypePause, 3*time.Minute, 100, 1000),
+	}))
+
+	removed, err := uc.ClearHistory(ctx, userID, []string{"abc001", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	removed, err = uc.ClearHistory(ctx, userID, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	page, err := uc.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{})
+	require.NoError(t, err)
+	require.Empty(t, page.Entries)
+}
+
+func entryVideoIDs(entries []model.WatchHistory) []string {
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.VideoID)
+	}
+	return ids
+}
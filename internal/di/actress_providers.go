@@ -0,0 +1,19 @@
+package di
+
+import (
+	"github.com/bufbuild/connect-go"
+
+	"github.com/tikfack/server/internal/application/port"
+	actressuc "github.com/tikfack/server/internal/application/usecase/actress"
+	actressrepo "github.com/tikfack/server/internal/infrastructure/repository/actress"
+	connecthandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+// provideActressCatalog は DMM API 実装の ActressCatalog を返す。
+func provideActressCatalog() (port.ActressCatalog, error) {
+	return actressrepo.NewActressRepository()
+}
+
+func provideActressHandler(uc actressuc.ActressUsecase, opts []connect.HandlerOption) *connecthandler.ActressServiceServer {
+	return connecthandler.NewActressServiceHandler(uc, opts...)
+}
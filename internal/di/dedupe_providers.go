@@ -0,0 +1,47 @@
+package di
+
+import (
+	"fmt"
+	"os"
+
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	"github.com/tikfack/server/internal/domain/repository"
+	eventlogrepo "github.com/tikfack/server/internal/infrastructure/repository/event_log"
+)
+
+// Values of EVENT_DEDUPE_STORE, which selects where the idempotency keys of recorded events are remembered.
+const (
+	// eventDedupeMemory remembers up to EVENT_DEDUPE_MAX_KEYS keys per server instance;
+	// retries reaching another instance are recorded again.
+	eventDedupeMemory = "memory"
+	// eventDedupePostgres remembers keys in Postgres, shared by all instances.
+	eventDedupePostgres = "postgres"
+	// eventDedupeNone records every event; consumers can still deduplicate by Kafka message key.
+	eventDedupeNone = "none"
+)
+
+// provideEventDedupeStore returns the store remembering keys for EVENT_DEDUPE_WINDOW, or nil when disabled.
+func provideEventDedupeStore() (repository.EventDedupeStore, error) {
+	window, err := durationFromEnv("EVENT_DEDUPE_WINDOW", eventloguc.DefaultDedupeWindow)
+	if err != nil {
+		return nil, err
+	}
+	switch store := os.Getenv("EVENT_DEDUPE_STORE"); store {
+	case "", eventDedupeMemory:
+		maxKeys, err := intFromEnv("EVENT_DEDUPE_MAX_KEYS", eventlogrepo.DefaultDedupeMaxKeys)
+		if err != nil {
+			return nil, err
+		}
+		return eventlogrepo.NewMemoryDedupeStore(window, maxKeys), nil
+	case eventDedupePostgres:
+		db, err := provideDatabase()
+		if err != nil {
+			return nil, err
+		}
+		return eventlogrepo.NewPostgresDedupeStore(db, window), nil
+	case eventDedupeNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid EVENT_DEDUPE_STORE %q", store)
+	}
+}
@@ -3,13 +3,17 @@ package di
 import (
 	"github.com/bufbuild/connect-go"
 
+	"github.com/tikfack/server/internal/application/port"
 	favoriteuc "github.com/tikfack/server/internal/application/usecase/favorite"
+	videouc "github.com/tikfack/server/internal/application/usecase/video"
 	favoriterepo "github.com/tikfack/server/internal/infrastructure/repository/favorite"
 	userrepo "github.com/tikfack/server/internal/infrastructure/repository/user"
 	favoritehandler "github.com/tikfack/server/internal/presentation/connect"
 )
 
-func provideFavoriteUsecase() (favoriteuc.FavoriteUsecase, error) {
+// provideFavoriteUsecase wires the favorite repositories together with the lookups
+// used to embed video and actress details in favorite listings.
+func provideFavoriteUsecase(videos videouc.VideoUsecase, actressCatalog port.ActressCatalog) (favoriteuc.FavoriteUsecase, error) {
 	db, err := provideDatabase()
 	if err != nil {
 		return nil, err
@@ -17,7 +21,8 @@ func provideFavoriteUsecase() (favoriteuc.FavoriteUsecase, error) {
 	userRepository := userrepo.NewPostgresUserRepository(db)
 	videoRepository := favoriterepo.NewPostgresFavoriteVideoRepository(db)
 	actorRepository := favoriterepo.NewPostgresFavoriteActorRepository(db)
-	return favoriteuc.NewFavoriteUsecase(userRepository, videoRepository, actorRepository), nil
+	articleRepository := favoriterepo.NewPostgresFavoriteArticleRepository(db)
+	return favoriteuc.NewFavoriteUsecase(userRepository, videoRepository, actorRepository, articleRepository, videos, actressCatalog), nil
 }
 
 func provideFavoriteHandler(uc favoriteuc.FavoriteUsecase, opts []connect.HandlerOption) *favoritehandler.FavoriteServiceServer {
@@ -7,11 +7,16 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/google/wire"
 	favoriteuc "github.com/tikfack/server/internal/application/usecase/favorite"
+	video "github.com/tikfack/server/internal/application/usecase/video"
 	favoritehandler "github.com/tikfack/server/internal/presentation/connect"
 )
 
 func InitializeFavoriteHandler(opts []connect.HandlerOption) (*favoritehandler.FavoriteServiceServer, error) {
 	wire.Build(
+		provideVideoCatalog,
+		provideFloorCatalog,
+		video.NewVideoUsecase,
+		provideActressCatalog,
 		provideFavoriteUsecase,
 		provideFavoriteHandler,
 	)
@@ -19,4 +24,11 @@ func InitializeFavoriteHandler(opts []connect.HandlerOption) (*favoritehandler.F
 }
 
 // favoriteSet can be used to compose favorite dependencies elsewhere.
-var favoriteSet = wire.NewSet(provideFavoriteUsecase, provideFavoriteHandler)
+var favoriteSet = wire.NewSet(
+	provideVideoCatalog,
+	provideFloorCatalog,
+	video.NewVideoUsecase,
+	provideActressCatalog,
+	provideFavoriteUsecase,
+	provideFavoriteHandler,
+)
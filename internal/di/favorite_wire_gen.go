@@ -8,13 +8,27 @@ package di
 
 import (
 	"github.com/bufbuild/connect-go"
+	video "github.com/tikfack/server/internal/application/usecase/video"
 	connect2 "github.com/tikfack/server/internal/presentation/connect"
 )
 
 // Injectors from favorite_wire.go:
 
 func InitializeFavoriteHandler(opts []connect.HandlerOption) (*connect2.FavoriteServiceServer, error) {
-	favoriteUsecase, err := provideFavoriteUsecase()
+	videoCatalog, err := provideVideoCatalog()
+	if err != nil {
+		return nil, err
+	}
+	floorCatalog, err := provideFloorCatalog()
+	if err != nil {
+		return nil, err
+	}
+	videoUsecase := video.NewVideoUsecase(videoCatalog, floorCatalog)
+	actressCatalog, err := provideActressCatalog()
+	if err != nil {
+		return nil, err
+	}
+	favoriteUsecase, err := provideFavoriteUsecase(videoUsecase, actressCatalog)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,84 @@
+package di
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/tikfack/server/internal/domain/repository"
+	eventlogrepo "github.com/tikfack/server/internal/infrastructure/repository/event_log"
+)
+
+// Values of EVENT_LOG_DELIVERY, which selects how recorded events reach Kafka.
+const (
+	// eventLogDeliveryOutbox stores events in the Postgres outbox and relays them to Kafka in the background.
+	eventLogDeliveryOutbox = "outbox"
+	// eventLogDeliveryDirect produces events to Kafka within the request; they are lost while Kafka is down.
+	eventLogDeliveryDirect = "direct"
+)
+
+func eventLogDelivery() (string, error) {
+	switch delivery := os.Getenv("EVENT_LOG_DELIVERY"); delivery {
+	case "", eventLogDeliveryOutbox:
+		return eventLogDeliveryOutbox, nil
+	case eventLogDeliveryDirect:
+		return eventLogDeliveryDirect, nil
+	default:
+		return "", fmt.Errorf("invalid EVENT_LOG_DELIVERY %q", delivery)
+	}
+}
+
+func provideEventLogRepository(writer *kafka.Writer) (repository.EventLogRepository, error) {
+	delivery, err := eventLogDelivery()
+	if err != nil {
+		return nil, err
+	}
+	if delivery == eventLogDeliveryDirect {
+		return eventlogrepo.NewKafkaEventLogRepository(writer), nil
+	}
+	db, err := provideDatabase()
+	if err != nil {
+		return nil, err
+	}
+	return eventlogrepo.NewPostgresOutbox(db), nil
+}
+
+// provideOutboxRelay returns the relay that drains the outbox, or nil when events are produced directly.
+func provideOutboxRelay(writer *kafka.Writer) (*eventlogrepo.OutboxRelay, error) {
+	delivery, err := eventLogDelivery()
+	if err != nil || delivery == eventLogDeliveryDirect {
+		return nil, err
+	}
+	batchSize, err := intFromEnv("OUTBOX_RELAY_BATCH_SIZE", 0)
+	if err != nil {
+		return nil, err
+	}
+	pollInterval, err := durationFromEnv("OUTBOX_RELAY_POLL_INTERVAL", 0)
+	if err != nil {
+		return nil, err
+	}
+	baseDelay, err := durationFromEnv("OUTBOX_RELAY_RETRY_BASE_DELAY", 0)
+	if err != nil {
+		return nil, err
+	}
+	maxDelay, err := durationFromEnv("OUTBOX_RELAY_RETRY_MAX_DELAY", 0)
+	if err != nil {
+		return nil, err
+	}
+	retention, err := durationFromEnv("OUTBOX_RETENTION", 0)
+	if err != nil {
+		return nil, err
+	}
+	db, err := provideDatabase()
+	if err != nil {
+		return nil, err
+	}
+	return eventlogrepo.NewOutboxRelay(eventlogrepo.NewPostgresOutbox(db), writer, eventlogrepo.OutboxRelayConfig{
+		BatchSize:      batchSize,
+		PollInterval:   pollInterval,
+		RetryBaseDelay: baseDelay,
+		RetryMaxDelay:  maxDelay,
+		Retention:      retention,
+	}), nil
+}
@@ -0,0 +1,17 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"github.com/google/wire"
+	eventlogrepo "github.com/tikfack/server/internal/infrastructure/repository/event_log"
+)
+
+func InitializeOutboxRelay() (*eventlogrepo.OutboxRelay, error) {
+	wire.Build(
+		provideKafkaWriter,
+		provideOutboxRelay,
+	)
+	return nil, nil
+}
@@ -0,0 +1,24 @@
+package di
+
+import (
+	"github.com/bufbuild/connect-go"
+
+	playlistuc "github.com/tikfack/server/internal/application/usecase/playlist"
+	playlistrepo "github.com/tikfack/server/internal/infrastructure/repository/playlist"
+	userrepo "github.com/tikfack/server/internal/infrastructure/repository/user"
+	playlisthandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+func providePlaylistUsecase() (playlistuc.PlaylistUsecase, error) {
+	db, err := provideDatabase()
+	if err != nil {
+		return nil, err
+	}
+	userRepository := userrepo.NewPostgresUserRepository(db)
+	playlistRepository := playlistrepo.NewPostgresPlaylistRepository(db)
+	return playlistuc.NewPlaylistUsecase(userRepository, playlistRepository), nil
+}
+
+func providePlaylistHandler(uc playlistuc.PlaylistUsecase, opts []connect.HandlerOption) *playlisthandler.PlaylistServiceServer {
+	return playlisthandler.NewPlaylistServiceHandler(uc, opts...)
+}
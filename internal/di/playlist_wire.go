@@ -0,0 +1,18 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"github.com/bufbuild/connect-go"
+	"github.com/google/wire"
+	playlisthandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+func InitializePlaylistHandler(opts []connect.HandlerOption) (*playlisthandler.PlaylistServiceServer, error) {
+	wire.Build(
+		providePlaylistUsecase,
+		providePlaylistHandler,
+	)
+	return nil, nil
+}
@@ -8,12 +8,16 @@ import (
 	"github.com/segmentio/kafka-go"
 	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
 	video "github.com/tikfack/server/internal/application/usecase/video"
-	eventlogrepo "github.com/tikfack/server/internal/infrastructure/repository/event_log"
+	"github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/infrastructure/util"
 	connecthandler "github.com/tikfack/server/internal/presentation/connect"
 )
 
-func provideVideoHandler(vu video.VideoUsecase, opts []connect.HandlerOption) *connecthandler.VideoServiceServer {
-	return connecthandler.NewVideoServiceHandler(vu, opts...)
+// eventLogTopic is the Kafka topic event logs are produced to and consumed from.
+const eventLogTopic = "event-logs"
+
+func provideVideoHandler(vu video.VideoUsecase, resolver *util.DirectURLResolver, opts []connect.HandlerOption) *connecthandler.VideoServiceServer {
+	return connecthandler.NewVideoServiceHandler(vu, resolver, opts...)
 }
 
 func provideEventLogHandler(uc eventloguc.EventLogUsecase, opts []connect.HandlerOption) *connecthandler.EventLogServiceServer {
@@ -21,18 +25,54 @@ func provideEventLogHandler(uc eventloguc.EventLogUsecase, opts []connect.Handle
 }
 
 func provideKafkaWriter() *kafka.Writer {
-	brokers := parseKafkaBrokers(os.Getenv("KAFKA_BROKER_ADDRESSES"))
-	if len(brokers) == 0 {
-		brokers = []string{"localhost:9094"}
-	}
 	return kafka.NewWriter(kafka.WriterConfig{
-		Brokers: brokers,
-		Topic:   "event-logs",
+		Brokers: kafkaBrokers(),
+		Topic:   eventLogTopic,
 	})
 }
 
-func provideEventLogUsecase(writer *kafka.Writer) eventloguc.EventLogUsecase {
-	return eventloguc.NewEventLogService(eventlogrepo.NewKafkaEventLogRepository(writer))
+func provideEventLogUsecase(r repository.EventLogRepository, registry *eventloguc.EventTypeRegistry, dedupe repository.EventDedupeStore, stream eventloguc.StreamConfig, subscribers []eventloguc.EventSubscriber) eventloguc.EventLogUsecase {
+	return eventloguc.NewEventLogService(r, registry, dedupe, stream, subscribers...)
+}
+
+// provideStreamConfig reads how RecordStream micro-batches events from EVENT_STREAM_* variables.
+func provideStreamConfig() (eventloguc.StreamConfig, error) {
+	batchSize, err := intFromEnv("EVENT_STREAM_MAX_BATCH_SIZE", eventloguc.DefaultStreamConfig.MaxBatchSize)
+	if err != nil {
+		return eventloguc.StreamConfig{}, err
+	}
+	batchDelay, err := durationFromEnv("EVENT_STREAM_MAX_BATCH_DELAY", eventloguc.DefaultStreamConfig.MaxBatchDelay)
+	if err != nil {
+		return eventloguc.StreamConfig{}, err
+	}
+	maxPending, err := intFromEnv("EVENT_STREAM_MAX_PENDING", eventloguc.DefaultStreamConfig.MaxPending)
+	if err != nil {
+		return eventloguc.StreamConfig{}, err
+	}
+	return eventloguc.StreamConfig{MaxBatchSize: batchSize, MaxBatchDelay: batchDelay, MaxPending: maxPending}, nil
+}
+
+// provideEventTypeRegistry returns the known event types, accepting event times within
+// EVENT_TIME_MAX_AGE in the past and EVENT_TIME_MAX_SKEW in the future.
+func provideEventTypeRegistry() (*eventloguc.EventTypeRegistry, error) {
+	maxAge, err := durationFromEnv("EVENT_TIME_MAX_AGE", eventloguc.DefaultTimeWindow.MaxAge)
+	if err != nil {
+		return nil, err
+	}
+	maxSkew, err := durationFromEnv("EVENT_TIME_MAX_SKEW", eventloguc.DefaultTimeWindow.MaxSkew)
+	if err != nil {
+		return nil, err
+	}
+	return eventloguc.DefaultEventTypeRegistry(eventloguc.TimeWindow{MaxAge: maxAge, MaxSkew: maxSkew}), nil
+}
+
+// kafkaBrokers returns the brokers in KAFKA_BROKER_ADDRESSES, defaulting to a local broker.
+func kafkaBrokers() []string {
+	brokers := parseKafkaBrokers(os.Getenv("KAFKA_BROKER_ADDRESSES"))
+	if len(brokers) == 0 {
+		brokers = []string{"localhost:9094"}
+	}
+	return brokers
 }
 
 func parseKafkaBrokers(raw string) []string {
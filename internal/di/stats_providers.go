@@ -0,0 +1,54 @@
+package di
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/tikfack/server/internal/infrastructure/dmmapi"
+	videorepo "github.com/tikfack/server/internal/infrastructure/repository/video"
+	"github.com/tikfack/server/internal/infrastructure/statslog"
+)
+
+const defaultStatsLogInterval = time.Minute
+
+// provideStatsReporter は DMM API のレートリミッターと動画キャッシュの統計を定期的にログへ記録する Reporter を返す。
+// 間隔は STATS_LOG_INTERVAL（既定 1m）で変更でき、0 の場合は記録しないため nil を返す。
+func provideStatsReporter() (*statslog.Reporter, error) {
+	interval, err := durationFromEnv("STATS_LOG_INTERVAL", defaultStatsLogInterval)
+	if err != nil || interval <= 0 {
+		return nil, err
+	}
+	limiter, err := dmmapi.SharedRateLimiter()
+	if err != nil {
+		return nil, err
+	}
+	sources := []statslog.Source{{
+		Name:  "dmm_rate_limiter",
+		Attrs: func() []any { return limiter.Stats().LogAttrs() },
+	}}
+
+	catalog, err := provideVideoCatalog()
+	if err != nil {
+		return nil, err
+	}
+	// キャッシュが無効（VIDEO_CACHE_ENABLED=false）の場合は記録しない
+	if cached, ok := catalog.(*videorepo.CachedVideoRepository); ok {
+		sources = append(sources, statslog.Source{Name: "video_cache", Attrs: func() []any { return cacheStatsAttrs(cached.Stats()) }})
+	}
+	return statslog.NewReporter(interval, sources...), nil
+}
+
+// cacheStatsAttrs はメソッドごとのヒット・ミス数を、メソッド名のグループにまとめたログの属性で返す。
+func cacheStatsAttrs(stats map[string]videorepo.CacheStats) []any {
+	methods := make([]string, 0, len(stats))
+	for method := range stats {
+		methods = append(methods, method)
+	}
+	slices.Sort(methods)
+	attrs := make([]any, 0, len(methods))
+	for _, method := range methods {
+		attrs = append(attrs, slog.Group(method, "hits", stats[method].Hits, "misses", stats[method].Misses))
+	}
+	return attrs
+}
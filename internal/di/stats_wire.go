@@ -0,0 +1,16 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"github.com/google/wire"
+	"github.com/tikfack/server/internal/infrastructure/statslog"
+)
+
+func InitializeStatsReporter() (*statslog.Reporter, error) {
+	wire.Build(
+		provideStatsReporter,
+	)
+	return nil, nil
+}
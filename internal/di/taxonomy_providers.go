@@ -0,0 +1,41 @@
+package di
+
+import (
+	"log/slog"
+
+	"github.com/bufbuild/connect-go"
+
+	"github.com/tikfack/server/internal/application/port"
+	taxonomyuc "github.com/tikfack/server/internal/application/usecase/taxonomy"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+	taxonomyrepo "github.com/tikfack/server/internal/infrastructure/repository/taxonomy"
+	connecthandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+// provideTaxonomyCatalog は DMM API 実装の TaxonomyCatalog をインメモリ LRU のキャッシュで包んで返す。
+// 一覧はほとんど変わらないため TAXONOMY_CACHE_TTL（既定 24h、0 で無効）の間保持する。
+func provideTaxonomyCatalog() (port.TaxonomyCatalog, error) {
+	catalog, err := taxonomyrepo.NewTaxonomyRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := durationFromEnv("TAXONOMY_CACHE_TTL", taxonomyrepo.DefaultCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		return catalog, nil
+	}
+	maxEntries, err := intFromEnv("TAXONOMY_CACHE_MAX_ENTRIES", cache.DefaultMaxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("taxonomy catalog cache enabled", "max_entries", maxEntries, "ttl", ttl)
+	return taxonomyrepo.NewCachedTaxonomyRepository(catalog, cache.NewLRU(maxEntries), ttl), nil
+}
+
+func provideTaxonomyHandler(uc taxonomyuc.TaxonomyUsecase, opts []connect.HandlerOption) *connecthandler.CatalogTaxonomyServiceServer {
+	return connecthandler.NewCatalogTaxonomyServiceHandler(uc, opts...)
+}
@@ -0,0 +1,178 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+	videorepo "github.com/tikfack/server/internal/infrastructure/repository/video"
+	"github.com/tikfack/server/internal/infrastructure/util"
+)
+
+const (
+	defaultVideoCacheTTL    = 5 * time.Minute
+	defaultFloorListTimeout = 10 * time.Second
+)
+
+// provideVideoCatalog はプロセス全体で共有する VideoCatalog を返す。
+// 動画とお気に入りのハンドラで同じキャッシュと同時リクエストの集約を使うため、1 度だけ作る。
+func provideVideoCatalog() (port.VideoCatalog, error) {
+	return sharedVideoCatalog()
+}
+
+var sharedVideoCatalog = sync.OnceValues(newVideoCatalog)
+
+// newVideoCatalog は DMM API 実装の VideoCatalog を返す。
+// 同時に実行中の同一クエリは 1 回の呼び出しにまとめ（VIDEO_COALESCING_ENABLED=false で無効）、
+// VIDEO_CACHE_ENABLED=true の場合はさらにインメモリ LRU のキャッシュで包む。
+// 呼び出し順はキャッシュ → 集約 → DMM API となる。
+func newVideoCatalog() (port.VideoCatalog, error) {
+	catalog, err := videorepo.NewVideoRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	coalescing := true
+	if raw := strings.TrimSpace(os.Getenv("VIDEO_COALESCING_ENABLED")); raw != "" {
+		coalescing, err = strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VIDEO_COALESCING_ENABLED: %w", err)
+		}
+	}
+	if coalescing {
+		catalog = videorepo.NewCoalescingVideoRepository(catalog)
+	}
+
+	enabled, _ := strconv.ParseBool(os.Getenv("VIDEO_CACHE_ENABLED"))
+	if !enabled {
+		return catalog, nil
+	}
+
+	maxEntries := cache.DefaultMaxEntries
+	if raw := strings.TrimSpace(os.Getenv("VIDEO_CACHE_MAX_ENTRIES")); raw != "" {
+		maxEntries, err = strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VIDEO_CACHE_MAX_ENTRIES: %w", err)
+		}
+	}
+	config, err := parseVideoCacheConfig(os.Getenv("VIDEO_CACHE_TTL"), os.Getenv("VIDEO_CACHE_METHOD_TTLS"))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("video catalog cache enabled", "max_entries", maxEntries, "default_ttl", config.DefaultTTL, "method_ttls", config.MethodTTLs)
+	return videorepo.NewCachedVideoRepository(catalog, cache.NewLRU(maxEntries), config), nil
+}
+
+// provideFloorCatalog は起動時に DMM FloorList API を読み込んだ FloorCatalog を返す。
+// FloorList の読み込みはプロセス全体で 1 度だけ行い、各ハンドラで共有する。
+func provideFloorCatalog() (port.FloorCatalog, error) {
+	return sharedFloorCatalog()
+}
+
+var sharedFloorCatalog = sync.OnceValues(loadFloorCatalog)
+
+// loadFloorCatalog は DMM FloorList API を読み込む。
+// 読み込みに失敗した場合はフロアを検証できないため起動を中止する。
+// タイムアウトは FLOOR_LIST_TIMEOUT（既定 10s）で上書きできる。
+func loadFloorCatalog() (port.FloorCatalog, error) {
+	timeout, err := durationFromEnv("FLOOR_LIST_TIMEOUT", defaultFloorListTimeout)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	floors, err := videorepo.NewFloorCatalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load floor list: %w", err)
+	}
+	return floors, nil
+}
+
+// provideDirectURLResolver は動画の DirectURL を解決する共有リゾルバを返す。
+// 同時実行数・HEAD のタイムアウト・キャッシュ TTL は DIRECT_URL_* 環境変数で上書きできる。
+func provideDirectURLResolver() (*util.DirectURLResolver, error) {
+	config := util.DefaultDirectURLResolverConfig()
+	var err error
+	if config.Concurrency, err = intFromEnv("DIRECT_URL_CONCURRENCY", config.Concurrency); err != nil {
+		return nil, err
+	}
+	if config.HeadTimeout, err = durationFromEnv("DIRECT_URL_HEAD_TIMEOUT", config.HeadTimeout); err != nil {
+		return nil, err
+	}
+	if config.PositiveTTL, err = durationFromEnv("DIRECT_URL_CACHE_TTL", config.PositiveTTL); err != nil {
+		return nil, err
+	}
+	if config.NegativeTTL, err = durationFromEnv("DIRECT_URL_NEGATIVE_CACHE_TTL", config.NegativeTTL); err != nil {
+		return nil, err
+	}
+	if config.MaxEntries, err = intFromEnv("DIRECT_URL_CACHE_MAX_ENTRIES", config.MaxEntries); err != nil {
+		return nil, err
+	}
+	return util.NewDirectURLResolver(config), nil
+}
+
+func intFromEnv(key string, def int) (int, error) {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return def, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return v, nil
+}
+
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return def, nil
+	}
+	v, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return v, nil
+}
+
+// parseVideoCacheConfig は既定 TTL とメソッド別 TTL（"GetVideosByDate=1m,GetVideoById=1h" 形式）を解釈する。
+func parseVideoCacheConfig(rawDefault, rawMethods string) (videorepo.CacheConfig, error) {
+	config := videorepo.CacheConfig{
+		DefaultTTL: defaultVideoCacheTTL,
+		MethodTTLs: map[string]time.Duration{},
+	}
+	if rawDefault = strings.TrimSpace(rawDefault); rawDefault != "" {
+		ttl, err := time.ParseDuration(rawDefault)
+		if err != nil {
+			return config, fmt.Errorf("invalid VIDEO_CACHE_TTL: %w", err)
+		}
+		config.DefaultTTL = ttl
+	}
+	for _, pair := range strings.Split(rawMethods, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		method, rawTTL, ok := strings.Cut(pair, "=")
+		if !ok {
+			return config, fmt.Errorf("invalid VIDEO_CACHE_METHOD_TTLS entry: %q", pair)
+		}
+		ttl, err := time.ParseDuration(strings.TrimSpace(rawTTL))
+		if err != nil {
+			return config, fmt.Errorf("invalid VIDEO_CACHE_METHOD_TTLS entry %q: %w", pair, err)
+		}
+		config.MethodTTLs[strings.TrimSpace(method)] = ttl
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("invalid VIDEO_CACHE_METHOD_TTLS: %w", err)
+	}
+	return config, nil
+}
@@ -0,0 +1,49 @@
+package di
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bufbuild/connect-go"
+
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	watchhistoryuc "github.com/tikfack/server/internal/application/usecase/watch_history"
+	watchhistoryrepo "github.com/tikfack/server/internal/infrastructure/repository/watch_history"
+	watchhistoryhandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+// Values of WATCH_HISTORY_SOURCE, which selects where watch history is fed from.
+const (
+	// watchHistorySourceInProcess applies events to watch history as EventLogService records them.
+	watchHistorySourceInProcess = "inprocess"
+	// watchHistorySourceKafka leaves watch history to a consumer of the event-logs topic.
+	watchHistorySourceKafka = "kafka"
+)
+
+func provideWatchHistoryUsecase() (watchhistoryuc.WatchHistoryUsecase, error) {
+	db, err := provideDatabase()
+	if err != nil {
+		return nil, err
+	}
+	return watchhistoryuc.NewWatchHistoryUsecase(watchhistoryrepo.NewPostgresWatchHistoryRepository(db)), nil
+}
+
+func provideWatchHistoryHandler(uc watchhistoryuc.WatchHistoryUsecase, opts []connect.HandlerOption) *watchhistoryhandler.WatchHistoryServiceServer {
+	return watchhistoryhandler.NewWatchHistoryServiceHandler(uc, opts...)
+}
+
+// provideEventSubscribers returns the in-process consumers of recorded events.
+func provideEventSubscribers() ([]eventloguc.EventSubscriber, error) {
+	switch source := os.Getenv("WATCH_HISTORY_SOURCE"); source {
+	case "", watchHistorySourceInProcess:
+		uc, err := provideWatchHistoryUsecase()
+		if err != nil {
+			return nil, err
+		}
+		return []eventloguc.EventSubscriber{uc.ApplyEvents}, nil
+	case watchHistorySourceKafka:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid WATCH_HISTORY_SOURCE %q", source)
+	}
+}
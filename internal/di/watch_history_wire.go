@@ -0,0 +1,18 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"github.com/bufbuild/connect-go"
+	"github.com/google/wire"
+	watchhistoryhandler "github.com/tikfack/server/internal/presentation/connect"
+)
+
+func InitializeWatchHistoryHandler(opts []connect.HandlerOption) (*watchhistoryhandler.WatchHistoryServiceServer, error) {
+	wire.Build(
+		provideWatchHistoryUsecase,
+		provideWatchHistoryHandler,
+	)
+	return nil, nil
+}
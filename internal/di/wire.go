@@ -6,15 +6,18 @@ package di
 import (
 	"github.com/bufbuild/connect-go"
 	"github.com/google/wire"
+	actress "github.com/tikfack/server/internal/application/usecase/actress"
+	taxonomy "github.com/tikfack/server/internal/application/usecase/taxonomy"
 	video "github.com/tikfack/server/internal/application/usecase/video"
-	videorepo "github.com/tikfack/server/internal/infrastructure/repository/video"
 	connecthandler "github.com/tikfack/server/internal/presentation/connect"
 )
 
 func InitializeVideoHandler(opts []connect.HandlerOption) (*connecthandler.VideoServiceServer, error) {
 	wire.Build(
-		videorepo.NewVideoRepository,
+		provideVideoCatalog,
+		provideFloorCatalog,
 		video.NewVideoUsecase,
+		provideDirectURLResolver,
 		provideVideoHandler,
 	)
 	return nil, nil
@@ -23,8 +26,31 @@ func InitializeVideoHandler(opts []connect.HandlerOption) (*connecthandler.Video
 func InitializeEventLogHandler(opts []connect.HandlerOption) (*connecthandler.EventLogServiceServer, error) {
 	wire.Build(
 		provideKafkaWriter,
+		provideEventLogRepository,
+		provideEventTypeRegistry,
+		provideEventDedupeStore,
+		provideStreamConfig,
+		provideEventSubscribers,
 		provideEventLogUsecase,
 		provideEventLogHandler,
 	)
 	return nil, nil
 }
+
+func InitializeActressHandler(opts []connect.HandlerOption) (*connecthandler.ActressServiceServer, error) {
+	wire.Build(
+		provideActressCatalog,
+		actress.NewActressUsecase,
+		provideActressHandler,
+	)
+	return nil, nil
+}
+
+func InitializeTaxonomyHandler(opts []connect.HandlerOption) (*connecthandler.CatalogTaxonomyServiceServer, error) {
+	wire.Build(
+		provideTaxonomyCatalog,
+		taxonomy.NewTaxonomyUsecase,
+		provideTaxonomyHandler,
+	)
+	return nil, nil
+}
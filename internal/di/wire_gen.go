@@ -8,26 +8,75 @@ package di
 
 import (
 	"github.com/bufbuild/connect-go"
+	"github.com/tikfack/server/internal/application/usecase/actress"
+	"github.com/tikfack/server/internal/application/usecase/taxonomy"
 	video "github.com/tikfack/server/internal/application/usecase/video"
-	videorepo "github.com/tikfack/server/internal/infrastructure/repository/video"
 	connect2 "github.com/tikfack/server/internal/presentation/connect"
 )
 
 // Injectors from wire.go:
 
 func InitializeVideoHandler(opts []connect.HandlerOption) (*connect2.VideoServiceServer, error) {
-	videoCatalog, err := videorepo.NewVideoRepository()
+	videoCatalog, err := provideVideoCatalog()
 	if err != nil {
 		return nil, err
 	}
-	videoUsecase := video.NewVideoUsecase(videoCatalog)
-	videoServiceServer := provideVideoHandler(videoUsecase, opts)
+	floorCatalog, err := provideFloorCatalog()
+	if err != nil {
+		return nil, err
+	}
+	videoUsecase := video.NewVideoUsecase(videoCatalog, floorCatalog)
+	directURLResolver, err := provideDirectURLResolver()
+	if err != nil {
+		return nil, err
+	}
+	videoServiceServer := provideVideoHandler(videoUsecase, directURLResolver, opts)
 	return videoServiceServer, nil
 }
 
 func InitializeEventLogHandler(opts []connect.HandlerOption) (*connect2.EventLogServiceServer, error) {
 	kafkaWriter := provideKafkaWriter()
-	eventLogUsecase := provideEventLogUsecase(kafkaWriter)
+	eventLogRepository, err := provideEventLogRepository(kafkaWriter)
+	if err != nil {
+		return nil, err
+	}
+	eventTypeRegistry, err := provideEventTypeRegistry()
+	if err != nil {
+		return nil, err
+	}
+	eventDedupeStore, err := provideEventDedupeStore()
+	if err != nil {
+		return nil, err
+	}
+	streamConfig, err := provideStreamConfig()
+	if err != nil {
+		return nil, err
+	}
+	v, err := provideEventSubscribers()
+	if err != nil {
+		return nil, err
+	}
+	eventLogUsecase := provideEventLogUsecase(eventLogRepository, eventTypeRegistry, eventDedupeStore, streamConfig, v)
 	eventLogServiceServer := provideEventLogHandler(eventLogUsecase, opts)
 	return eventLogServiceServer, nil
 }
+
+func InitializeActressHandler(opts []connect.HandlerOption) (*connect2.ActressServiceServer, error) {
+	actressCatalog, err := provideActressCatalog()
+	if err != nil {
+		return nil, err
+	}
+	actressUsecase := actress.NewActressUsecase(actressCatalog)
+	actressServiceServer := provideActressHandler(actressUsecase, opts)
+	return actressServiceServer, nil
+}
+
+func InitializeTaxonomyHandler(opts []connect.HandlerOption) (*connect2.CatalogTaxonomyServiceServer, error) {
+	taxonomyCatalog, err := provideTaxonomyCatalog()
+	if err != nil {
+		return nil, err
+	}
+	taxonomyUsecase := taxonomy.NewTaxonomyUsecase(taxonomyCatalog)
+	catalogTaxonomyServiceServer := provideTaxonomyHandler(taxonomyUsecase, opts)
+	return catalogTaxonomyServiceServer, nil
+}
@@ -0,0 +1,63 @@
+package di
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+
+	watchhistoryuc "github.com/tikfack/server/internal/application/usecase/watch_history"
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/infrastructure/messaging"
+)
+
+const defaultConsumerGroup = "tikfack-worker"
+
+// provideEventReader joins the worker's consumer group on the event log topic.
+// Offsets are committed synchronously after each message is handled.
+func provideEventReader() messaging.Reader {
+	groupID := strings.TrimSpace(os.Getenv("KAFKA_CONSUMER_GROUP"))
+	if groupID == "" {
+		groupID = defaultConsumerGroup
+	}
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers: kafkaBrokers(),
+		GroupID: groupID,
+		Topic:   eventLogTopic,
+	})
+}
+
+func provideConsumerConfig() (messaging.ConsumerConfig, error) {
+	maxAttempts, err := intFromEnv("WORKER_MAX_ATTEMPTS", 0)
+	if err != nil {
+		return messaging.ConsumerConfig{}, err
+	}
+	baseDelay, err := durationFromEnv("WORKER_RETRY_BASE_DELAY", 0)
+	if err != nil {
+		return messaging.ConsumerConfig{}, err
+	}
+	maxDelay, err := durationFromEnv("WORKER_RETRY_MAX_DELAY", 0)
+	if err != nil {
+		return messaging.ConsumerConfig{}, err
+	}
+	shutdownTimeout, err := durationFromEnv("WORKER_SHUTDOWN_TIMEOUT", 0)
+	if err != nil {
+		return messaging.ConsumerConfig{}, err
+	}
+	return messaging.ConsumerConfig{
+		MaxAttempts:     maxAttempts,
+		RetryBaseDelay:  baseDelay,
+		RetryMaxDelay:   maxDelay,
+		ShutdownTimeout: shutdownTimeout,
+	}, nil
+}
+
+// provideEventHandlerRegistry registers the downstream aggregations the worker drives.
+func provideEventHandlerRegistry(watchHistory watchhistoryuc.WatchHistoryUsecase) *messaging.Registry {
+	registry := messaging.NewRegistry()
+	registry.Register(messaging.HandlerFunc(func(ctx context.Context, e *entity.EventLog) error {
+		return watchHistory.ApplyEvents(ctx, []*entity.EventLog{e})
+	}), entity.EventTypeStart, entity.EventTypePause, entity.EventTypeSkip, entity.EventTypeComplete)
+	return registry
+}
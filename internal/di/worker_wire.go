@@ -0,0 +1,20 @@
+//go:build wireinject
+// +build wireinject
+
+package di
+
+import (
+	"github.com/google/wire"
+	"github.com/tikfack/server/internal/infrastructure/messaging"
+)
+
+func InitializeEventConsumer() (*messaging.Consumer, error) {
+	wire.Build(
+		provideEventReader,
+		provideWatchHistoryUsecase,
+		provideEventHandlerRegistry,
+		provideConsumerConfig,
+		messaging.NewConsumer,
+	)
+	return nil, nil
+}
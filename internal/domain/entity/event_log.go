@@ -5,19 +5,37 @@ import (
 	"time"
 )
 
+// MaxIdempotencyKeyLength is the maximum length of EventLog.IdempotencyKey in bytes.
+const MaxIdempotencyKeyLength = 128
+
 // EventLog represents a user event log entity
 type EventLog struct {
-	EventLogID  string 			//EventLog ID (UUID format)
-	UserID      string          // User ID (UUID format)
-	SessionID   string          // Session identifier (e.g., "550e8400-e29b-41d4-a716-446655440000")
-	TraceID     string          // Trace ID (e.g., "550e8400-e29b-41d4-a716-446655440000")
-	VideoDmmID  string          // DMM video ID (e.g., "abc123")
-	ActressIDs  []string        // Actress IDs (e.g., ["123", "456"])
-	DirectorIDs []string        // Director IDs (e.g., ["123", "456"])
-	GenreIDs    []string        // Genre IDs (e.g., ["123", "456"])
-	MakerIDs    []string        // Maker IDs (e.g., ["123", "456"])
-	SeriesIDs   []string        // Series IDs (e.g., ["123", "456"])
-	EventType   string          // Event type (e.g., "start", "pause", "skip", "complete", "like", "share")
-	EventTime   time.Time       // Event timestamp (e.g., "2025-05-26T10:00:00Z" converted to time.Time)
-	Props       json.RawMessage // Additional properties (e.g., { "position": 0 } stored as JSONB)
-} 
\ No newline at end of file
+	EventLogID     string          //EventLog ID (UUID format)
+	IdempotencyKey string          // Client-generated key identifying the event across retries; optional
+	UserID         string          // User ID (UUID format); set by the server from the verified token, empty for anonymous events
+	DeviceID       string          // Anonymous device identifier supplied by the client; required when UserID is empty
+	SessionID      string          // Session identifier (e.g., "550e8400-e29b-41d4-a716-446655440000")
+	TraceID        string          // Trace ID (e.g., "550e8400-e29b-41d4-a716-446655440000")
+	VideoDmmID     string          // DMM video ID (e.g., "abc123")
+	ActressIDs     []string        // Actress IDs (e.g., ["123", "456"])
+	DirectorIDs    []string        // Director IDs (e.g., ["123", "456"])
+	GenreIDs       []string        // Genre IDs (e.g., ["123", "456"])
+	MakerIDs       []string        // Maker IDs (e.g., ["123", "456"])
+	SeriesIDs      []string        // Series IDs (e.g., ["123", "456"])
+	EventType      string          // Event type (e.g., "start", "pause", "skip", "complete", "like", "share")
+	EventTime      time.Time       // Event timestamp (e.g., "2025-05-26T10:00:00Z" converted to time.Time)
+	Props          json.RawMessage // Additional properties (e.g., { "position": 0 } stored as JSONB)
+}
+
+// DedupeKey returns the key identifying the event across client retries, or "" when the client sent no
+// idempotency key. Keys are scoped to the user, or to the device for anonymous events, so that one client
+// cannot suppress the events of another. It is only meaningful once the event has been attributed.
+func (e *EventLog) DedupeKey() string {
+	if e.IdempotencyKey == "" {
+		return ""
+	}
+	if e.UserID != "" {
+		return "user:" + e.UserID + ":" + e.IdempotencyKey
+	}
+	return "device:" + e.DeviceID + ":" + e.IdempotencyKey
+}
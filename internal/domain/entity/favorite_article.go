@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleType is the kind of DMM article (catalog classification) a favorite refers to.
+type ArticleType string
+
+const (
+	ArticleGenre    ArticleType = "genre"
+	ArticleMaker    ArticleType = "maker"
+	ArticleSeries   ArticleType = "series"
+	ArticleDirector ArticleType = "director"
+)
+
+// Valid reports whether t is one of the supported article types.
+func (t ArticleType) Valid() bool {
+	switch t {
+	case ArticleGenre, ArticleMaker, ArticleSeries, ArticleDirector:
+		return true
+	}
+	return false
+}
+
+// FavoriteArticle represents a user's favorite genre, maker, series or director.
+type FavoriteArticle struct {
+	FavoriteArticleUUID string
+	UserID              string
+	ArticleType         ArticleType
+	ArticleID           string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// NewFavoriteArticle creates a new FavoriteArticle with a generated UUID.
+func NewFavoriteArticle(userID string, articleType ArticleType, articleID string) (*FavoriteArticle, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user id is required")
+	}
+	if !articleType.Valid() {
+		return nil, fmt.Errorf("unsupported article type %q", articleType)
+	}
+	if articleID == "" {
+		return nil, fmt.Errorf("article id is required")
+	}
+	now := time.Now().UTC()
+	return &FavoriteArticle{
+		FavoriteArticleUUID: uuid.NewString(),
+		UserID:              userID,
+		ArticleType:         articleType,
+		ArticleID:           articleID,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}, nil
+}
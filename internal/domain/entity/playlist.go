@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxPlaylistNameLength is the maximum number of characters in a playlist name.
+	MaxPlaylistNameLength = 100
+	// MaxPlaylistDescriptionLength is the maximum number of characters in a playlist description.
+	MaxPlaylistDescriptionLength = 1000
+)
+
+// PlaylistVisibility controls who can see a playlist.
+type PlaylistVisibility string
+
+const (
+	// PlaylistPrivate playlists are visible to their owner only.
+	PlaylistPrivate PlaylistVisibility = "private"
+	// PlaylistUnlisted playlists are visible to anyone who knows their UUID.
+	PlaylistUnlisted PlaylistVisibility = "unlisted"
+	// PlaylistPublic playlists are also listed on the owner's profile.
+	PlaylistPublic PlaylistVisibility = "public"
+)
+
+// Valid reports whether v is a supported visibility.
+func (v PlaylistVisibility) Valid() bool {
+	switch v {
+	case PlaylistPrivate, PlaylistUnlisted, PlaylistPublic:
+		return true
+	}
+	return false
+}
+
+// Playlist is a user-defined, ordered collection of videos.
+type Playlist struct {
+	PlaylistUUID string
+	UserID       string
+	Name         string
+	Description  string
+	Visibility   PlaylistVisibility
+	// ItemCount is the number of videos in the playlist; it is maintained by the repository.
+	ItemCount int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPlaylist creates a new, empty Playlist with a generated UUID.
+func NewPlaylist(userID, name, description string, visibility PlaylistVisibility) (*Playlist, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user id is required")
+	}
+	playlist := &Playlist{
+		PlaylistUUID: uuid.NewString(),
+		UserID:       userID,
+	}
+	if err := playlist.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := playlist.Describe(description); err != nil {
+		return nil, err
+	}
+	if err := playlist.SetVisibility(visibility); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	playlist.CreatedAt = now
+	playlist.UpdatedAt = now
+	return playlist, nil
+}
+
+// Rename changes the playlist name after validating it.
+func (p *Playlist) Rename(name string) error {
+	if name == "" {
+		return fmt.Errorf("playlist name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxPlaylistNameLength {
+		return fmt.Errorf("playlist name must be at most %d characters", MaxPlaylistNameLength)
+	}
+	p.Name = name
+	return nil
+}
+
+// Describe changes the playlist description after validating it. An empty description is allowed.
+func (p *Playlist) Describe(description string) error {
+	if utf8.RuneCountInString(description) > MaxPlaylistDescriptionLength {
+		return fmt.Errorf("playlist description must be at most %d characters", MaxPlaylistDescriptionLength)
+	}
+	p.Description = description
+	return nil
+}
+
+// SetVisibility changes the playlist visibility after validating it.
+func (p *Playlist) SetVisibility(visibility PlaylistVisibility) error {
+	if !visibility.Valid() {
+		return fmt.Errorf("unsupported playlist visibility %q", visibility)
+	}
+	p.Visibility = visibility
+	return nil
+}
+
+// VisibleTo reports whether userID may view the playlist when it is opened by UUID.
+func (p *Playlist) VisibleTo(userID string) bool {
+	return p.UserID == userID || p.Visibility != PlaylistPrivate
+}
+
+// PlaylistItem is a video placed in a playlist.
+// Positions are 0-based and contiguous within a playlist.
+type PlaylistItem struct {
+	PlaylistItemUUID string
+	PlaylistUUID     string
+	VideoID          string
+	Position         int
+	CreatedAt        time.Time
+}
+
+// NewPlaylistItem creates a new PlaylistItem with a generated UUID.
+// The repository assigns its position when it is added.
+func NewPlaylistItem(playlistUUID, videoID string) (*PlaylistItem, error) {
+	if playlistUUID == "" {
+		return nil, fmt.Errorf("playlist uuid is required")
+	}
+	if videoID == "" {
+		return nil, fmt.Errorf("video id is required")
+	}
+	return &PlaylistItem{
+		PlaylistItemUUID: uuid.NewString(),
+		PlaylistUUID:     playlistUUID,
+		VideoID:          videoID,
+		CreatedAt:        time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Playback event types that update watch history. Other event types (like, share, ...) are ignored.
+const (
+	EventTypeStart    = "start"
+	EventTypePause    = "pause"
+	EventTypeSkip     = "skip"
+	EventTypeComplete = "complete"
+)
+
+// CompletedProgress is the progress ratio at or above which a video counts as watched to the end.
+const CompletedProgress = 0.95
+
+// WatchHistory is a user's playback state for one video, derived from playback events.
+type WatchHistory struct {
+	UserID  string
+	VideoID string
+	// PositionSeconds is the last known playback position.
+	PositionSeconds float64
+	// DurationSeconds is the video length reported by the player; 0 when unknown.
+	DurationSeconds float64
+	// Completed is set once the video has been watched to the end and stays set when it is rewatched.
+	Completed bool
+	// LastEventAt is the time of the latest event applied; older events are ignored.
+	LastEventAt time.Time
+	UpdatedAt   time.Time
+}
+
+// playbackProps holds the props of playback events that watch history reads.
+type playbackProps struct {
+	Position *float64 `json:"position"`
+	Duration *float64 `json:"duration"`
+}
+
+// IsPlaybackEvent reports whether the event carries playback progress for a signed-in user.
+func IsPlaybackEvent(e *EventLog) bool {
+	if e == nil || e.UserID == "" || e.VideoDmmID == "" {
+		return false
+	}
+	switch e.EventType {
+	case EventTypeStart, EventTypePause, EventTypeSkip, EventTypeComplete:
+		return true
+	}
+	return false
+}
+
+// Apply updates the history with a playback event for the same user and video.
+// It returns false when the event is older than the last one applied or is not a playback event.
+func (h *WatchHistory) Apply(e *EventLog) bool {
+	if !IsPlaybackEvent(e) || e.EventTime.Before(h.LastEventAt) {
+		return false
+	}
+
+	var props playbackProps
+	if len(e.Props) > 0 {
+		// Malformed props still advance LastEventAt; only the position is unknown.
+		_ = json.Unmarshal(e.Props, &props)
+	}
+	if props.Duration != nil && *props.Duration > 0 {
+		h.DurationSeconds = *props.Duration
+	}
+	if props.Position != nil && *props.Position >= 0 {
+		h.PositionSeconds = *props.Position
+	}
+	if e.EventType == EventTypeComplete {
+		if h.DurationSeconds > 0 {
+			h.PositionSeconds = h.DurationSeconds
+		}
+		h.Completed = true
+	}
+	if h.Progress() >= CompletedProgress {
+		h.Completed = true
+	}
+	h.LastEventAt = e.EventTime
+	return true
+}
+
+// Progress returns the watched ratio in [0, 1], or 0 when the duration is unknown.
+func (h *WatchHistory) Progress() float64 {
+	if h.DurationSeconds <= 0 {
+		return 0
+	}
+	return min(h.PositionSeconds/h.DurationSeconds, 1)
+}
+
+// ResumePositionSeconds returns where playback should resume: the last position,
+// or 0 when the video was last watched to (nearly) the end.
+func (h *WatchHistory) ResumePositionSeconds() float64 {
+	if h.Progress() >= CompletedProgress {
+		return 0
+	}
+	return h.PositionSeconds
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// EventDedupeStore remembers the dedupe keys of recorded events for a time window,
+// so that events resent by clients are recorded only once.
+type EventDedupeStore interface {
+	// Claim marks keys as seen and reports for each key whether it had already been seen within the window.
+	// A key repeated within keys is reported as seen from its second occurrence.
+	Claim(ctx context.Context, keys []string) ([]bool, error)
+
+	// Release forgets claimed keys whose events could not be persisted, so that a retry is recorded.
+	Release(ctx context.Context, keys []string) error
+}
@@ -12,7 +12,10 @@ type FavoriteActorRepository interface {
 	Add(ctx context.Context, favorite *entity.FavoriteActor) error
 	RemoveByActorID(ctx context.Context, userID, actorID string) (*entity.FavoriteActor, error)
 	FindByUserAndActorID(ctx context.Context, userID, actorID string) (*entity.FavoriteActor, error)
-	ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteActor, error)
+	// ListByUserID lists a user's favorite actors in the order and window given by opts.
+	ListByUserID(ctx context.Context, userID string, opts FavoriteListOptions) ([]entity.FavoriteActor, error)
+	// CountByUserID returns the total number of favorite actors a user has.
+	CountByUserID(ctx context.Context, userID string) (int, error)
 }
 
 var (
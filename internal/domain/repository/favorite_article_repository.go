@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// FavoriteArticleRepository defines persistence behavior for favorite genres, makers, series and directors.
+type FavoriteArticleRepository interface {
+	// Add stores a favorite; when the user already has it, favorite is overwritten with the stored record.
+	Add(ctx context.Context, favorite *entity.FavoriteArticle) error
+	RemoveByArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error)
+	FindByUserAndArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error)
+	// ListByUserID lists a user's favorite articles in the order and window given by opts.
+	// An empty articleType lists every type.
+	ListByUserID(ctx context.Context, userID string, articleType entity.ArticleType, opts FavoriteListOptions) ([]entity.FavoriteArticle, error)
+	// CountByUserID returns the number of favorite articles a user has; an empty articleType counts every type.
+	CountByUserID(ctx context.Context, userID string, articleType entity.ArticleType) (int, error)
+}
+
+var (
+	// ErrFavoriteArticleNotFound indicates the requested favorite article could not be located.
+	ErrFavoriteArticleNotFound = errors.New("favorite article not found")
+)
@@ -0,0 +1,20 @@
+package repository
+
+import "time"
+
+// FavoriteCursor identifies a favorite's position in the (created_at, uuid) ordering used for listing.
+type FavoriteCursor struct {
+	CreatedAt time.Time
+	UUID      string
+}
+
+// FavoriteListOptions controls how favorites are listed.
+// Favorites are ordered by created_at with the favorite UUID as a tie-breaker.
+type FavoriteListOptions struct {
+	// Limit caps the number of rows returned; 0 means no limit.
+	Limit int
+	// Descending lists the newest favorites first.
+	Descending bool
+	// After, when set, returns only favorites positioned after the cursor in the chosen order.
+	After *FavoriteCursor
+}
@@ -12,7 +12,10 @@ type FavoriteVideoRepository interface {
 	Add(ctx context.Context, favorite *entity.FavoriteVideo) error
 	RemoveByVideoID(ctx context.Context, userID, videoID string) (*entity.FavoriteVideo, error)
 	FindByUserAndVideoID(ctx context.Context, userID, videoID string) (*entity.FavoriteVideo, error)
-	ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteVideo, error)
+	// ListByUserID lists a user's favorite videos in the order and window given by opts.
+	ListByUserID(ctx context.Context, userID string, opts FavoriteListOptions) ([]entity.FavoriteVideo, error)
+	// CountByUserID returns the total number of favorite videos a user has.
+	CountByUserID(ctx context.Context, userID string) (int, error)
 }
 
 var (
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// PlaylistRepository defines persistence behavior for playlists and their items.
+type PlaylistRepository interface {
+	Create(ctx context.Context, playlist *entity.Playlist) error
+	// Get returns a playlist with its ItemCount populated.
+	Get(ctx context.Context, playlistUUID string) (*entity.Playlist, error)
+	// Update stores the playlist's name, description and visibility and refreshes UpdatedAt.
+	Update(ctx context.Context, playlist *entity.Playlist) error
+	// Delete removes a playlist together with its items.
+	Delete(ctx context.Context, playlistUUID string) error
+	// ListByUserID lists a user's playlists, most recently updated first.
+	// A non-empty visibility restricts the result to playlists with that visibility.
+	ListByUserID(ctx context.Context, userID string, visibility entity.PlaylistVisibility) ([]entity.Playlist, error)
+
+	// ListItems returns a playlist's items ordered by position.
+	ListItems(ctx context.Context, playlistUUID string) ([]entity.PlaylistItem, error)
+	// AddItem appends item to the end of its playlist and sets its Position, or returns ErrPlaylistFull
+	// when the playlist already holds maxItems videos.
+	// When the video is already in the playlist, item is overwritten with the stored record instead, even when full.
+	AddItem(ctx context.Context, item *entity.PlaylistItem, maxItems int) error
+	// RemoveItem removes a video from a playlist and closes the gap in positions.
+	RemoveItem(ctx context.Context, playlistUUID, videoID string) error
+	// MoveItem moves a video to position, shifting the items in between.
+	// Positions past the end move the item to the end.
+	MoveItem(ctx context.Context, playlistUUID, videoID string, position int) error
+}
+
+var (
+	// ErrPlaylistNotFound indicates the requested playlist could not be located.
+	ErrPlaylistNotFound = errors.New("playlist not found")
+	// ErrPlaylistItemNotFound indicates the video is not in the playlist.
+	ErrPlaylistItemNotFound = errors.New("playlist item not found")
+	// ErrPlaylistFull indicates the playlist cannot take another video.
+	ErrPlaylistFull = errors.New("playlist is full")
+)
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// WatchHistoryCursor identifies an entry's position in the (last_event_at DESC, video_id) ordering.
+type WatchHistoryCursor struct {
+	LastEventAt time.Time
+	VideoID     string
+}
+
+// WatchHistoryListOptions controls how watch history is listed, most recently watched first.
+type WatchHistoryListOptions struct {
+	// Limit caps the number of rows returned; 0 means no limit.
+	Limit int
+	// After, when set, returns only entries positioned after the cursor.
+	After *WatchHistoryCursor
+	// InProgressOnly keeps only videos that can be resumed: started, and not left at the end.
+	InProgressOnly bool
+}
+
+// WatchHistoryRepository defines persistence behavior for per-user watch history.
+type WatchHistoryRepository interface {
+	// Get returns the history of a video for a user.
+	Get(ctx context.Context, userID, videoID string) (*entity.WatchHistory, error)
+	// Upsert stores the history unless a newer event has already been stored for the same user and video.
+	Upsert(ctx context.Context, history *entity.WatchHistory) error
+	ListByUserID(ctx context.Context, userID string, opts WatchHistoryListOptions) ([]entity.WatchHistory, error)
+	// Delete removes the given videos from a user's history, or the whole history when videoIDs is empty,
+	// and returns the number of entries removed.
+	Delete(ctx context.Context, userID string, videoIDs []string) (int, error)
+}
+
+var (
+	// ErrWatchHistoryNotFound indicates the user has no history for the video.
+	ErrWatchHistoryNotFound = errors.New("watch history not found")
+)
@@ -0,0 +1,20 @@
+// Package cache は外部 API のレスポンスを保持するキャッシュ基盤を提供する。
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Backend はキャッシュの保存先を抽象化する。
+// 値はシリアライズ済みのバイト列で受け渡すため、Redis などの外部ストアにも差し替えられる。
+type Backend interface {
+	// Get はキーに対応する値を返す。存在しないか期限切れの場合は found=false を返す。
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+
+	// Set は値を ttl の間だけ保持する。ttl が 0 以下の場合は保存しない。
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete はキーに対応する値を削除する。
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+// LoadJSON はキャッシュから値を読み出して v にデコードする。
+// バックエンドの障害はミスとして扱い、デコードできない値は削除してミスとして扱う。
+func LoadJSON(ctx context.Context, backend Backend, log *slog.Logger, key string, v any) bool {
+	raw, found, err := backend.Get(ctx, key)
+	if err != nil {
+		log.Warn("cache get failed", "key", key, "error", err)
+		return false
+	}
+	if !found {
+		return false
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		log.Warn("cache decode failed", "key", key, "error", err)
+		_ = backend.Delete(ctx, key)
+		return false
+	}
+	log.Debug("cache hit", "key", key)
+	return true
+}
+
+// StoreJSON は値をエンコードしてキャッシュへ保存する。失敗してもリクエスト自体は成功させるため、ログに残すだけにする。
+func StoreJSON(ctx context.Context, backend Backend, log *slog.Logger, key string, v any, ttl time.Duration) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		log.Warn("cache encode failed", "key", key, "error", err)
+		return
+	}
+	if err := backend.Set(ctx, key, raw, ttl); err != nil {
+		log.Warn("cache set failed", "key", key, "error", err)
+	}
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMaxEntries は LRU の既定の最大エントリ数。
+const DefaultMaxEntries = 1000
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// LRU は TTL 付きのインメモリ LRU キャッシュ。Backend を実装する。
+type LRU struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+	now        func() time.Time
+}
+
+// NewLRU は最大 maxEntries 件を保持する LRU を返す。0 以下の場合は DefaultMaxEntries を使う。
+func NewLRU(maxEntries int) *LRU {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	return &LRU{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+		now:        time.Now,
+	}
+}
+
+// Get はキーに対応する値を返す。期限切れのエントリはその場で破棄する。
+func (c *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := elem.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.removeElement(elem)
+		return nil, false, nil
+	}
+	c.ll.MoveToFront(elem)
+	return entry.value, true, nil
+}
+
+// Set は値を保存し、上限を超えた場合は最も古いエントリを追い出す。
+func (c *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(elem)
+		return nil
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+	return nil
+}
+
+// Delete はキーに対応するエントリを削除する。
+func (c *LRU) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+	return nil
+}
+
+// RemoveExpired は期限切れのエントリをすべて破棄し、破棄した件数を返す。
+// Get されないまま期限切れになったエントリは、上限に達するまで残るため定期的に呼び出す。
+func (c *LRU) RemoveExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	removed := 0
+	for elem := c.ll.Back(); elem != nil; {
+		prev := elem.Prev()
+		if !now.Before(elem.Value.(*lruEntry).expiresAt) {
+			c.removeElement(elem)
+			removed++
+		}
+		elem = prev
+	}
+	return removed
+}
+
+// Len は現在保持しているエントリ数を返す（期限切れで未破棄のものを含む）。
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRU) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*lruEntry).key)
+}
+
+// ensure interface compliance
+var _ Backend = (*LRU)(nil)
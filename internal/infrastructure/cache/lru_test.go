@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU_GetSet(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	v, found, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("1"), v)
+
+	_, found, err = c.Get(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestLRU_Expiration(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	now = now.Add(59 * time.Second)
+	_, found, _ := c.Get(ctx, "a")
+	require.True(t, found)
+
+	now = now.Add(time.Second)
+	_, found, _ = c.Get(ctx, "a")
+	require.False(t, found)
+	require.Equal(t, 0, c.Len())
+}
+
+func TestLRU_Eviction(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, c.Set(ctx, "b", []byte("2"), time.Minute))
+	// a を参照して最近使ったものにする
+	_, _, _ = c.Get(ctx, "a")
+	require.NoError(t, c.Set(ctx, "c", []byte("3"), time.Minute))
+
+	_, found, _ := c.Get(ctx, "b")
+	require.False(t, found, "最も古い b が追い出されるべき")
+	_, found, _ = c.Get(ctx, "a")
+	require.True(t, found)
+	_, found, _ = c.Get(ctx, "c")
+	require.True(t, found)
+}
+
+func TestLRU_NonPositiveTTLIsNotStored(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), 0))
+	_, found, _ := c.Get(ctx, "a")
+	require.False(t, found)
+}
+
+func TestLRU_Delete(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, c.Delete(ctx, "a"))
+	_, found, _ := c.Get(ctx, "a")
+	require.False(t, found)
+}
+
+func TestLRU_RemoveExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(10)
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, c.Set(ctx, "b", []byte("2"), time.Hour))
+	require.NoError(t, c.Set(ctx, "c", []byte("3"), time.Minute))
+
+	now = now.Add(time.Minute)
+	require.Equal(t, 2, c.RemoveExpired())
+	require.Equal(t, 1, c.Len())
+	_, found, _ := c.Get(ctx, "b")
+	require.True(t, found)
+}
@@ -0,0 +1,131 @@
+package dmmapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// actressSearchPath は DMM 女優検索 API のパス。
+const actressSearchPath = "/v3/ActressSearch"
+
+// actressBirthdayLayout は gte_birthday / lte_birthday に指定する日付の書式。
+const actressBirthdayLayout = "2006-01-02"
+
+// maxActressSearchHits は 1 リクエストで取得できる最大件数。
+const maxActressSearchHits = 100
+
+// validActressSorts は ActressSearch の sort パラメータに指定できる値。
+var validActressSorts = map[string]struct{}{
+	"name":      {},
+	"-name":     {},
+	"bust":      {},
+	"-bust":     {},
+	"waist":     {},
+	"-waist":    {},
+	"hip":       {},
+	"-hip":      {},
+	"height":    {},
+	"-height":   {},
+	"birthday":  {},
+	"-birthday": {},
+	"id":        {},
+	"-id":       {},
+}
+
+// ActressSearchQuery は ActressSearch API の検索条件。ゼロ値のフィールドはクエリに含めない。
+// 検索条件は model.ActressSearchQuery をそのまま使い、ID 指定の取得のために ActressID を加える。
+type ActressSearchQuery struct {
+	model.ActressSearchQuery
+	ActressID string
+}
+
+// actressRange は上下限の組。検証とクエリへの変換で共通に扱う。
+type actressRange struct {
+	name     string
+	gte, lte int32
+}
+
+func (q ActressSearchQuery) ranges() []actressRange {
+	return []actressRange{
+		{name: "bust", gte: q.GteBust, lte: q.LteBust},
+		{name: "waist", gte: q.GteWaist, lte: q.LteWaist},
+		{name: "hip", gte: q.GteHip, lte: q.LteHip},
+		{name: "height", gte: q.GteHeight, lte: q.LteHeight},
+	}
+}
+
+// Validate は列挙値や範囲を検証する。
+func (q ActressSearchQuery) Validate() error {
+	if q.Initial != "" && utf8.RuneCountInString(q.Initial) != 1 {
+		return fmt.Errorf("頭文字は 1 文字で指定してください: %q", q.Initial)
+	}
+	if q.Sort != "" {
+		if _, ok := validActressSorts[q.Sort]; !ok {
+			return fmt.Errorf("不正なソート順です: %q", q.Sort)
+		}
+	}
+	if q.Hits < 0 || q.Hits > maxActressSearchHits {
+		return fmt.Errorf("hits は 0 以上 %d 以下で指定してください: %d", maxActressSearchHits, q.Hits)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("offset は 0 以上で指定してください: %d", q.Offset)
+	}
+	for _, r := range q.ranges() {
+		if r.gte < 0 || r.lte < 0 {
+			return fmt.Errorf("%s は 0 以上で指定してください", r.name)
+		}
+		if r.gte > 0 && r.lte > 0 && r.gte > r.lte {
+			return fmt.Errorf("%s の下限が上限を超えています: %d > %d", r.name, r.gte, r.lte)
+		}
+	}
+	var birthdays [2]time.Time
+	for i, date := range []string{q.GteBirthday, q.LteBirthday} {
+		if date == "" {
+			continue
+		}
+		t, err := time.Parse(actressBirthdayLayout, date)
+		if err != nil {
+			return fmt.Errorf("不正な生年月日の形式です（%s 形式で指定してください）: %q", actressBirthdayLayout, date)
+		}
+		birthdays[i] = t
+	}
+	if !birthdays[0].IsZero() && !birthdays[1].IsZero() && birthdays[0].After(birthdays[1]) {
+		return fmt.Errorf("生年月日の下限が上限を超えています: %s > %s", q.GteBirthday, q.LteBirthday)
+	}
+	return nil
+}
+
+// Values は検証済みの検索条件を url.Values に変換する。
+func (q ActressSearchQuery) Values() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	setIfNotEmpty(v, "actress_id", q.ActressID)
+	setIfNotEmpty(v, "keyword", q.Keyword)
+	setIfNotEmpty(v, "initial", q.Initial)
+	for _, r := range q.ranges() {
+		if r.gte > 0 {
+			v.Set("gte_"+r.name, strconv.Itoa(int(r.gte)))
+		}
+		if r.lte > 0 {
+			v.Set("lte_"+r.name, strconv.Itoa(int(r.lte)))
+		}
+	}
+	setIfNotEmpty(v, "gte_birthday", q.GteBirthday)
+	setIfNotEmpty(v, "lte_birthday", q.LteBirthday)
+	setIfNotEmpty(v, "sort", q.Sort)
+	if q.Hits > 0 {
+		v.Set("hits", strconv.Itoa(int(q.Hits)))
+	}
+	if q.Offset > 0 {
+		v.Set("offset", strconv.Itoa(int(q.Offset)))
+	}
+	return v, nil
+}
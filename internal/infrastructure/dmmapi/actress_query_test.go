@@ -0,0 +1,92 @@
+package dmmapi
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+func TestActressSearchQueryValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    ActressSearchQuery
+		expected url.Values
+	}{
+		{
+			name:     "未指定の場合は何も含めない",
+			query:    ActressSearchQuery{},
+			expected: url.Values{},
+		},
+		{
+			name: "指定した条件をすべて含める",
+			query: ActressSearchQuery{
+				ActressID: "1011199",
+				ActressSearchQuery: model.ActressSearchQuery{
+					Keyword:     "あさみ",
+					Initial:     "あ",
+					GteBust:     80,
+					LteBust:     95,
+					GteWaist:    55,
+					LteHip:      90,
+					GteHeight:   150,
+					LteHeight:   165,
+					GteBirthday: "1990-01-01",
+					LteBirthday: "1999-12-31",
+					Sort:        "-birthday",
+					Hits:        20,
+					Offset:      21,
+				},
+			},
+			expected: url.Values{
+				"actress_id":   {"1011199"},
+				"keyword":      {"あさみ"},
+				"initial":      {"あ"},
+				"gte_bust":     {"80"},
+				"lte_bust":     {"95"},
+				"gte_waist":    {"55"},
+				"lte_hip":      {"90"},
+				"gte_height":   {"150"},
+				"lte_height":   {"165"},
+				"gte_birthday": {"1990-01-01"},
+				"lte_birthday": {"1999-12-31"},
+				"sort":         {"-birthday"},
+				"hits":         {"20"},
+				"offset":       {"21"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := tt.query.Values()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestActressSearchQueryValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		query model.ActressSearchQuery
+	}{
+		{name: "頭文字が 2 文字以上", query: model.ActressSearchQuery{Initial: "あい"}},
+		{name: "不正なソート順", query: model.ActressSearchQuery{Sort: "date"}},
+		{name: "hits が上限を超える", query: model.ActressSearchQuery{Hits: 101}},
+		{name: "offset が負数", query: model.ActressSearchQuery{Offset: -1}},
+		{name: "サイズが負数", query: model.ActressSearchQuery{GteWaist: -1}},
+		{name: "サイズの下限が上限を超える", query: model.ActressSearchQuery{GteBust: 100, LteBust: 80}},
+		{name: "生年月日の書式が不正", query: model.ActressSearchQuery{GteBirthday: "1990/01/01"}},
+		{name: "生年月日の下限が上限を超える", query: model.ActressSearchQuery{GteBirthday: "2000-01-01", LteBirthday: "1990-01-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ActressSearchQuery{ActressSearchQuery: tt.query}.Values()
+			require.Error(t, err)
+		})
+	}
+}
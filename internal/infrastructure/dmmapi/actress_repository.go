@@ -0,0 +1,78 @@
+package dmmapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// ActressRepository は ActressSearch API を使った port.ActressCatalog の実装。
+type ActressRepository struct {
+	client ClientInterface
+}
+
+// NewActressRepository は環境変数の設定で DMM API を呼び出す ActressCatalog を返す。
+// クライアントは動画用の Repository と共有し、レート制限を API ID 単位で守る。
+func NewActressRepository() (port.ActressCatalog, error) {
+	c, err := sharedClient()
+	if err != nil {
+		return nil, err
+	}
+	return &ActressRepository{client: c}, nil
+}
+
+// NewActressRepositoryWithClient テストやモック注入用のコンストラクタ
+func NewActressRepositoryWithClient(client ClientInterface) port.ActressCatalog {
+	return &ActressRepository{client: client}
+}
+
+// GetActress は指定 ID の女優情報を取得する
+func (r *ActressRepository) GetActress(ctx context.Context, actressID string) (*model.ActressProfile, error) {
+	if actressID == "" {
+		return nil, invalidParameterError(errors.New("女優IDが指定されていません"))
+	}
+	resp, err := r.actressSearch(ctx, ActressSearchQuery{ActressID: actressID})
+	if err != nil {
+		return nil, err
+	}
+	actresses, _ := ConvertActressesFromDMM(resp.Result)
+	for i := range actresses {
+		if actresses[i].ID == actressID {
+			return &actresses[i], nil
+		}
+	}
+	return nil, actressNotFoundError(actressID)
+}
+
+// SearchActresses は条件に一致する女優を検索する。該当なしの場合も件数情報は返す。
+func (r *ActressRepository) SearchActresses(ctx context.Context, query model.ActressSearchQuery) ([]model.ActressProfile, *model.SearchMetadata, error) {
+	resp, err := r.actressSearch(ctx, ActressSearchQuery{ActressSearchQuery: query})
+	if err != nil {
+		return nil, nil, err
+	}
+	actresses, metadata := ConvertActressesFromDMM(resp.Result)
+	return actresses, metadata, nil
+}
+
+// actressSearch は検索条件を検証して ActressSearch API を呼び出し、失敗を port.CatalogError に分類して返す。
+func (r *ActressRepository) actressSearch(ctx context.Context, query ActressSearchQuery) (*ActressResponse, error) {
+	values, err := query.Values()
+	if err != nil {
+		return nil, invalidParameterError(err)
+	}
+
+	logger := logger.LoggerWithCtx(ctx)
+	logger.Debug("calling API", "path", actressSearchPath, "query", values.Encode())
+	var resp ActressResponse
+	if err := r.client.Call(ctx, actressSearchPath, values, &resp); err != nil {
+		return nil, classifyCallError(err)
+	}
+	if int(resp.Result.Status) != http.StatusOK {
+		return nil, resultStatusError(int(resp.Result.Status), resp.Result.Message)
+	}
+	return &resp, nil
+}
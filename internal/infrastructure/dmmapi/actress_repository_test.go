@@ -0,0 +1,238 @@
+package dmmapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+)
+
+// actressSearchJSON は ActressSearch API のレスポンス例。status や件数が文字列で、未登録の項目は null になる。
+const actressSearchJSON = `{
+  "result": {
+    "status": "200",
+    "result_count": 2,
+    "total_count": "2",
+    "first_position": 1,
+    "actress": [
+      {
+        "id": "1011199",
+        "name": "テスト女優",
+        "ruby": "てすとじょゆう",
+        "bust": "88",
+        "cup": "E",
+        "waist": "58",
+        "hip": "86",
+        "height": "160",
+        "birthday": "1995-04-01",
+        "blood_type": "A",
+        "hobby": "読書",
+        "prefectures": "東京都",
+        "imageURL": {
+          "small": "https://example.com/small.jpg",
+          "large": "https://example.com/large.jpg"
+        },
+        "listURL": {
+          "digital": "https://example.com/digital",
+          "monthly": "https://example.com/monthly",
+          "mono": "https://example.com/mono"
+        }
+      },
+      {
+        "id": 1011200,
+        "name": "未登録女優",
+        "ruby": "みとうろくじょゆう",
+        "bust": null,
+        "cup": null,
+        "waist": "",
+        "hip": null,
+        "height": null,
+        "birthday": null,
+        "blood_type": null,
+        "hobby": null,
+        "prefectures": null
+      }
+    ]
+  }
+}`
+
+func decodeActressResponse(t *testing.T) ActressResponse {
+	t.Helper()
+	var resp ActressResponse
+	require.NoError(t, json.Unmarshal([]byte(actressSearchJSON), &resp))
+	return resp
+}
+
+func TestConvertActressesFromDMM(t *testing.T) {
+	resp := decodeActressResponse(t)
+
+	actresses, metadata := ConvertActressesFromDMM(resp.Result)
+
+	require.Equal(t, &model.SearchMetadata{ResultCount: 2, TotalCount: 2, FirstPosition: 1}, metadata)
+	require.Equal(t, []model.ActressProfile{
+		{
+			ID:          "1011199",
+			Name:        "テスト女優",
+			Ruby:        "てすとじょゆう",
+			Bust:        88,
+			Cup:         "E",
+			Waist:       58,
+			Hip:         86,
+			Height:      160,
+			Birthday:    "1995-04-01",
+			BloodType:   "A",
+			Hobby:       "読書",
+			Prefectures: "東京都",
+			ImageURL: model.ActressImage{
+				Small: "https://example.com/small.jpg",
+				Large: "https://example.com/large.jpg",
+			},
+			ListURL: model.ActressListURL{
+				Digital: "https://example.com/digital",
+				Monthly: "https://example.com/monthly",
+				Mono:    "https://example.com/mono",
+			},
+		},
+		{
+			ID:   "1011200",
+			Name: "未登録女優",
+			Ruby: "みとうろくじょゆう",
+		},
+	}, actresses)
+}
+
+func TestGetActress(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		actressID   string
+		expectedID  string
+		expectedErr error
+	}{
+		{name: "ID で女優が見つかった場合はプロフィールを返す", actressID: "1011199", expectedID: "1011199"},
+		{name: "結果に指定 ID が含まれない場合は見つからないエラーを返す", actressID: "999", expectedErr: port.ErrCatalogNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockClientInterface(ctrl)
+			mockClient.EXPECT().
+				Call(gomock.Any(), actressSearchPath, url.Values{"actress_id": {tt.actressID}}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+					*v.(*ActressResponse) = decodeActressResponse(t)
+					return nil
+				})
+
+			repo := NewActressRepositoryWithClient(mockClient)
+			actress, err := repo.GetActress(ctx, tt.actressID)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, actress)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedID, actress.ID)
+		})
+	}
+}
+
+func TestGetActressRequiresID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	mockClient.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewActressRepositoryWithClient(mockClient).GetActress(context.Background(), "")
+	require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+}
+
+func TestSearchActresses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	expected := url.Values{
+		"initial":  {"て"},
+		"gte_bust": {"85"},
+		"sort":     {"name"},
+		"hits":     {"10"},
+	}
+	mockClient.EXPECT().
+		Call(gomock.Any(), actressSearchPath, expected, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+			*v.(*ActressResponse) = decodeActressResponse(t)
+			return nil
+		})
+
+	repo := NewActressRepositoryWithClient(mockClient)
+	actresses, metadata, err := repo.SearchActresses(context.Background(), model.ActressSearchQuery{
+		Initial: "て",
+		GteBust: 85,
+		Sort:    "name",
+		Hits:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, actresses, 2)
+	require.Equal(t, 2, metadata.TotalCount)
+}
+
+func TestSearchActressesErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("不正な条件は API を呼ばずに拒否する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockClientInterface(ctrl)
+		mockClient.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, _, err := NewActressRepositoryWithClient(mockClient).SearchActresses(ctx, model.ActressSearchQuery{Sort: "date"})
+		require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+	})
+
+	t.Run("result.status が 200 以外なら上流ステータスエラー", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockClientInterface(ctrl)
+		mockClient.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+				return json.Unmarshal([]byte(`{"result":{"status":"400","message":"parameter error"}}`), v)
+			})
+
+		_, _, err := NewActressRepositoryWithClient(mockClient).SearchActresses(ctx, model.ActressSearchQuery{})
+		require.ErrorIs(t, err, port.ErrCatalogUpstreamStatus)
+		require.ErrorIs(t, err, ErrAPIError)
+
+		var catalogErr *port.CatalogError
+		require.ErrorAs(t, err, &catalogErr)
+		require.Equal(t, 400, catalogErr.Status)
+	})
+
+	t.Run("クライアント呼び出しの失敗を分類する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := NewMockClientInterface(ctrl)
+		mockClient.EXPECT().
+			Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&StatusError{StatusCode: 503})
+
+		_, _, err := NewActressRepositoryWithClient(mockClient).SearchActresses(ctx, model.ActressSearchQuery{})
+		require.ErrorIs(t, err, port.ErrCatalogUnavailable)
+		require.True(t, port.IsRetryable(err))
+	})
+}
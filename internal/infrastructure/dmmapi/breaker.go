@@ -0,0 +1,108 @@
+package dmmapi
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen はサーキットブレーカーが開いており、上流への呼び出しを行わずに失敗したことを表す。
+var ErrCircuitOpen = errors.New("dmm api circuit breaker is open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreaker は連続失敗回数に基づくサーキットブレーカー。
+// Threshold 回連続で失敗すると Cooldown の間は呼び出しを遮断し、その後 1 件だけ試行を許可する。
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+// NewCircuitBreaker は新しい CircuitBreaker を返す。threshold が 0 以下の場合は常に閉じたままになる。
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow は呼び出しを許可するかどうかを判定する。許可しない場合は ErrCircuitOpen を返す。
+func (b *CircuitBreaker) Allow() error {
+	if b == nil || b.threshold <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return nil
+	case breakerHalfOpen:
+		// 試行中のリクエストの結果が出るまでは他のリクエストを通さない
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success は呼び出しの成功を記録し、ブレーカーを閉じる。
+func (b *CircuitBreaker) Success() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+// Failure は上流の障害を記録し、閾値に達した場合はブレーカーを開く。
+func (b *CircuitBreaker) Failure() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+		b.probing = false
+	}
+}
+
+// Release は結果を判定しないまま試行を終えたことを記録する（呼び出し元のキャンセルなど）。
+func (b *CircuitBreaker) Release() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
@@ -3,15 +3,57 @@ package dmmapi
 //go:generate mockgen -destination=mock_client.go -package=dmmapi github.com/tikfack/server/internal/infrastructure/dmmapi ClientInterface
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout          = 10 * time.Second
+	defaultMaxRetries       = 2
+	defaultRetryBaseDelay   = 200 * time.Millisecond
+	defaultRetryMaxDelay    = 2 * time.Second
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+	defaultRateLimitQPS     = 10
+	defaultRateLimitBurst   = 10
+	defaultRateLimitMaxWait = 2 * time.Second
 )
 
 type ClientInterface interface {
-	Call(path string, v interface{}) error
+	Call(ctx context.Context, path string, query url.Values, v interface{}) error
+}
+
+// ErrDecode は DMM API のレスポンスを解釈できなかったことを表す。
+var ErrDecode = errors.New("dmm api response decode failed")
+
+// StatusError は DMM API が 2xx 以外の HTTP ステータスを返したことを表す。
+type StatusError struct {
+	StatusCode int
+	// RetryAfter は Retry-After ヘッダで指示された待機時間。指示がない場合は 0。
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("dmm api returned status %d", e.StatusCode)
+}
+
+// RetryPolicy はリトライ回数とバックオフ間隔の設定。MaxRetries が 0 の場合はリトライしない。
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
 }
 
 // Client は DMM API へのリクエストを行う
@@ -20,6 +62,14 @@ type Client struct {
 	APIID       string
 	AffiliateID string
 	HTTPClient  *http.Client
+	// Timeout は 1 回の試行あたりのタイムアウト。0 の場合は呼び出し元のコンテキストに従う。
+	Timeout time.Duration
+	Retry   RetryPolicy
+	Breaker *CircuitBreaker
+	// Limiter は API ID ごとのリクエスト上限を超えないよう、リトライを含むすべての試行を制限する。
+	Limiter *RateLimiter
+
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
 // NewClient 環境変数から設定を読み込み、新規 Client を返す
@@ -30,25 +80,294 @@ func NewClient() (*Client, error) {
 	if base == "" || id == "" || aff == "" {
 		return nil, fmt.Errorf("DMM API credentials not set")
 	}
+
+	timeout, err := durationFromEnv("DMM_API_TIMEOUT", defaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	maxRetries, err := intFromEnv("DMM_API_MAX_RETRIES", defaultMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+	baseDelay, err := durationFromEnv("DMM_API_RETRY_BASE_DELAY", defaultRetryBaseDelay)
+	if err != nil {
+		return nil, err
+	}
+	maxDelay, err := durationFromEnv("DMM_API_RETRY_MAX_DELAY", defaultRetryMaxDelay)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := intFromEnv("DMM_API_BREAKER_THRESHOLD", defaultBreakerThreshold)
+	if err != nil {
+		return nil, err
+	}
+	cooldown, err := durationFromEnv("DMM_API_BREAKER_COOLDOWN", defaultBreakerCooldown)
+	if err != nil {
+		return nil, err
+	}
+	qps, err := floatFromEnv("DMM_API_RATE_LIMIT_QPS", defaultRateLimitQPS)
+	if err != nil {
+		return nil, err
+	}
+	burst, err := intFromEnv("DMM_API_RATE_LIMIT_BURST", defaultRateLimitBurst)
+	if err != nil {
+		return nil, err
+	}
+	maxWait, err := durationFromEnv("DMM_API_RATE_LIMIT_MAX_WAIT", defaultRateLimitMaxWait)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
 		BaseURL:     base,
 		APIID:       id,
 		AffiliateID: aff,
-		HTTPClient:  http.DefaultClient,
+		HTTPClient:  &http.Client{},
+		Timeout:     timeout,
+		Retry: RetryPolicy{
+			MaxRetries: maxRetries,
+			BaseDelay:  baseDelay,
+			MaxDelay:   maxDelay,
+		},
+		Breaker: NewCircuitBreaker(threshold, cooldown),
+		Limiter: NewRateLimiter(qps, burst, maxWait),
 	}, nil
 }
 
-// Call makes a GET request to the specified path and unmarshals into v
-func (c *Client) Call(path string, v interface{}) error {
-	url := fmt.Sprintf("%s%s&api_id=%s&affiliate_id=%s&output=json", c.BaseURL, path, c.APIID, c.AffiliateID)
-	resp, err := c.HTTPClient.Get(url)
+// sharedClient は環境変数から作った Client をプロセス全体で 1 つだけ返す。
+// DMM のレート制限は API ID 単位のため、ItemList と ActressSearch などの API 間でリミッターとブレーカーを共有する。
+var sharedClient = sync.OnceValues(NewClient)
+
+// SharedRateLimiter は API 間で共有するクライアントのレートリミッターを返す。統計の記録に使う。
+func SharedRateLimiter() (*RateLimiter, error) {
+	c, err := sharedClient()
+	if err != nil {
+		return nil, err
+	}
+	return c.Limiter, nil
+}
+
+// Call makes a GET request to the specified path and unmarshals into v.
+// クエリには認証情報と output=json を付与し、url.Values でエンコードする。
+// 5xx・429・通信エラー・試行ごとのタイムアウトは指数バックオフ（ジッター付き）でリトライし、
+// Retry-After が指示された場合はその時間以上待ってから再試行する。
+// 待機が RetryPolicy.MaxDelay や呼び出し元の期限を超える場合は待たずに最後のエラーを返す。
+// 上流の障害が続く場合はサーキットブレーカーにより ErrCircuitOpen で即座に失敗する。
+// 各試行の前にレートリミッターでトークンを取得し、待機時間が上限を超える場合は *RateLimitError を返す。
+func (c *Client) Call(ctx context.Context, path string, query url.Values, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.requestURL(path, query), nil)
+	if err != nil {
+		// BaseURL の設定不備などで上流には届かないため、リトライもブレーカーへの計上もしない
+		return fmt.Errorf("build dmm api request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if err := c.Breaker.Allow(); err != nil {
+			if lastErr != nil {
+				return fmt.Errorf("%w (last error: %v)", err, lastErr)
+			}
+			return err
+		}
+		if err := c.Limiter.Wait(ctx); err != nil {
+			// 上流を呼んでいないのでブレーカーの成否には数えない
+			c.Breaker.Release()
+			if lastErr != nil {
+				return fmt.Errorf("%w (last error: %v)", err, lastErr)
+			}
+			return err
+		}
+
+		body, err := c.do(ctx, req)
+		switch {
+		case err == nil:
+			c.Breaker.Success()
+			if err := json.Unmarshal(body, v); err != nil {
+				return fmt.Errorf("%w: %w", ErrDecode, err)
+			}
+			return nil
+		case ctx.Err() != nil:
+			// 呼び出し元のキャンセル・期限切れは上流の障害として扱わない
+			c.Breaker.Release()
+			return err
+		case isUpstreamFailure(err):
+			c.Breaker.Failure()
+		default:
+			c.Breaker.Success()
+		}
+
+		lastErr = err
+		if !isRetryable(err) || attempt >= c.Retry.MaxRetries {
+			return err
+		}
+		delay, ok := c.retryDelay(ctx, attempt, err)
+		if !ok {
+			return err
+		}
+		if err := c.wait(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// requestURL は呼び出し元のクエリに認証情報を加えたリクエスト URL を組み立てる。
+func (c *Client) requestURL(path string, query url.Values) string {
+	params := make(url.Values, len(query)+3)
+	for key, values := range query {
+		params[key] = append([]string(nil), values...)
+	}
+	params.Set("api_id", c.APIID)
+	params.Set("affiliate_id", c.AffiliateID)
+	params.Set("output", "json")
+	return c.BaseURL + path + "?" + params.Encode()
+}
+
+// do は 1 回分の HTTP リクエストを実行し、2xx の場合はレスポンスボディを返す。
+func (c *Client) do(ctx context.Context, req *http.Request) ([]byte, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// コネクションを再利用できるようにボディを読み捨てる
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// backoff は attempt 回目の失敗後に待機する時間を返す（上限付き指数バックオフ + ジッター）。
+func (c *Client) backoff(attempt int) time.Duration {
+	base := c.Retry.BaseDelay
+	if base <= 0 {
+		return 0
+	}
+	d := base << attempt
+	if c.Retry.MaxDelay > 0 && (d > c.Retry.MaxDelay || d <= 0) {
+		d = c.Retry.MaxDelay
+	}
+	// 待機時間の半分を固定、残り半分をランダムにして同時リトライの集中を避ける
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// retryDelay は attempt 回目の失敗後に待機する時間を返す。
+// Retry-After が指示されていればバックオフより長い方を採用し、
+// それが MaxDelay を超える、または呼び出し元の期限までに収まらない場合は false を返してリトライを諦める。
+func (c *Client) retryDelay(ctx context.Context, attempt int, err error) (time.Duration, bool) {
+	d := c.backoff(attempt)
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		if c.Retry.MaxDelay > 0 && statusErr.RetryAfter > c.Retry.MaxDelay {
+			return 0, false
+		}
+		d = max(d, statusErr.RetryAfter)
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return 0, false
+	}
+	return d, true
+}
+
+func (c *Client) wait(ctx context.Context, d time.Duration) error {
+	if c.sleep != nil {
+		return c.sleep(ctx, d)
+	}
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isRetryable はリトライで回復しうるエラーかどうかを判定する。
+func isRetryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+	return isTransportError(err)
+}
+
+// isUpstreamFailure はサーキットブレーカーの失敗として数えるエラーかどうかを判定する。
+// 429 は上流が稼働している証拠なので数えない。
+func isUpstreamFailure(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	return isTransportError(err)
+}
+
+// isTransportError はステータスを受け取る前の通信の失敗（接続エラー・試行ごとのタイムアウトなど）かどうかを判定する。
+// http.Client.Do のエラーは *url.Error（net.Error を実装する）で返る。
+func isTransportError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// parseRetryAfter は秒数形式の Retry-After ヘッダを解釈する。日付形式や不正な値は 0 とする。
+func parseRetryAfter(raw string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+func floatFromEnv(key string, def float64) (float64, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return f, nil
+}
+
+func intFromEnv(key string, def int) (int, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(raw)
 	if err != nil {
-		return err
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
 	}
-	return json.Unmarshal(body, v)
+	return n, nil
 }
@@ -1,12 +1,17 @@
 package dmmapi
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
-	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -32,9 +37,16 @@ func TestNewClientMissingEnv(t *testing.T) {
 
 func TestClientCall(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if !strings.Contains(r.URL.RawQuery, "api_id=id") || !strings.Contains(r.URL.RawQuery, "affiliate_id=aff") {
+		if r.URL.Path != "/path" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("api_id") != "id" || q.Get("affiliate_id") != "aff" || q.Get("output") != "json" {
 			t.Fatalf("missing query params: %s", r.URL.RawQuery)
 		}
+		if q.Get("keyword") != "女優 名前&x=1" {
+			t.Fatalf("keyword is not encoded: %s", r.URL.RawQuery)
+		}
 		io.WriteString(w, `{"key":"value"}`)
 	}))
 	defer ts.Close()
@@ -43,7 +55,321 @@ func TestClientCall(t *testing.T) {
 	var v struct {
 		Key string `json:"key"`
 	}
-	err := c.Call("/path?x=1", &v)
+	query := url.Values{"keyword": {"女優 名前&x=1"}}
+	err := c.Call(context.Background(), "/path", query, &v)
 	require.NoError(t, err)
 	require.Equal(t, "value", v.Key)
+	require.Equal(t, url.Values{"keyword": {"女優 名前&x=1"}}, query, "呼び出し元のクエリを書き換えない")
+}
+
+// newTestClient はリトライ待機を行わないテスト用 Client を返す。
+func newTestClient(ts *httptest.Server, maxRetries int, breaker *CircuitBreaker) *Client {
+	return &Client{
+		BaseURL:     ts.URL,
+		APIID:       "id",
+		AffiliateID: "aff",
+		HTTPClient:  ts.Client(),
+		Retry:       RetryPolicy{MaxRetries: maxRetries, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+		Breaker:     breaker,
+		sleep: func(ctx context.Context, _ time.Duration) error {
+			return ctx.Err()
+		},
+	}
+}
+
+func TestClientCall_RetryOnServerError(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"key":"value"}`)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 2, nil)
+	var v struct {
+		Key string `json:"key"`
+	}
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.NoError(t, err)
+	require.Equal(t, "value", v.Key)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientCall_RetryOnTooManyRequests(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 1, nil)
+	var v map[string]any
+	require.NoError(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v))
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestClientCall_RetriesExhausted(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 2, nil)
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientCall_NoRetryOnClientError(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 2, nil)
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusBadRequest, statusErr.StatusCode)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientCall_Timeout(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	c := newTestClient(ts, 1, nil)
+	c.Timeout = 20 * time.Millisecond
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(2), calls.Load(), "試行ごとのタイムアウトはリトライ対象")
+}
+
+func TestClientCall_CallerCancellationIsNotRetried(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	breaker := NewCircuitBreaker(1, time.Minute)
+	c := newTestClient(ts, 3, breaker)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var v map[string]any
+	err := c.Call(ctx, "/path", url.Values{"x": {"1"}}, &v)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, calls.Load())
+	require.NoError(t, breaker.Allow(), "呼び出し元のキャンセルでブレーカーを開かない")
+}
+
+func TestClientCall_DecodeError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `not json`)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 2, nil)
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.ErrorIs(t, err, ErrDecode)
+	var syntaxErr *json.SyntaxError
+	require.ErrorAs(t, err, &syntaxErr)
+}
+
+func TestClientCall_RetryAfterIsRecorded(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 0, nil)
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, 7*time.Second, statusErr.RetryAfter)
+}
+
+func TestClientCall_WaitsForRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts, 1, nil)
+	c.Retry.MaxDelay = 5 * time.Second
+	var waited []time.Duration
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		waited = append(waited, d)
+		return ctx.Err()
+	}
+	var v map[string]any
+	require.NoError(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v))
+	require.Equal(t, int32(2), calls.Load())
+	require.Len(t, waited, 1)
+	require.GreaterOrEqual(t, waited[0], time.Second, "Retry-After より短い間隔で再試行しない")
+}
+
+func TestClientCall_GivesUpWhenRetryAfterIsTooLong(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxDelay time.Duration
+		timeout  time.Duration
+	}{
+		{name: "MaxDelay を超える", maxDelay: 10 * time.Millisecond, timeout: time.Minute},
+		{name: "呼び出し元の期限を超える", maxDelay: time.Minute, timeout: 500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer ts.Close()
+
+			c := newTestClient(ts, 2, nil)
+			c.Retry.MaxDelay = tt.maxDelay
+			c.sleep = func(context.Context, time.Duration) error {
+				t.Fatal("リトライを諦める場合は待機しない")
+				return nil
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			var v map[string]any
+			err := c.Call(ctx, "/path", url.Values{"x": {"1"}}, &v)
+			var statusErr *StatusError
+			require.ErrorAs(t, err, &statusErr)
+			require.Equal(t, 30*time.Second, statusErr.RetryAfter)
+			require.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestClientCall_MalformedBaseURLIsNotRetried(t *testing.T) {
+	breaker := NewCircuitBreaker(1, time.Minute)
+	c := &Client{
+		BaseURL:     "http://bad host",
+		APIID:       "id",
+		AffiliateID: "aff",
+		Retry:       RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond},
+		Breaker:     breaker,
+		sleep: func(context.Context, time.Duration) error {
+			t.Fatal("リクエストを組み立てられない場合はリトライしない")
+			return nil
+		},
+	}
+
+	var v map[string]any
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.Error(t, err)
+	var statusErr *StatusError
+	require.False(t, errors.As(err, &statusErr))
+	require.NoError(t, breaker.Allow(), "設定不備でブレーカーを開かない")
+}
+
+func TestClientCall_CircuitBreakerOpens(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	breaker := NewCircuitBreaker(2, time.Minute)
+	c := newTestClient(ts, 5, breaker)
+	var v map[string]any
+
+	err := c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, int32(2), calls.Load(), "閾値に達した時点でリトライを打ち切る")
+
+	err = c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, int32(2), calls.Load(), "ブレーカーが開いている間は上流を呼ばない")
+}
+
+func TestClientCall_CircuitBreakerHalfOpenRecovers(t *testing.T) {
+	var healthy atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+	c := newTestClient(ts, 0, breaker)
+	var v map[string]any
+
+	require.Error(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v))
+	require.ErrorIs(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v), ErrCircuitOpen)
+
+	// クールダウン経過後は試行を 1 件だけ許可し、成功すれば閉じる
+	now = now.Add(time.Minute)
+	healthy.Store(true)
+	require.NoError(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v))
+	require.NoError(t, c.Call(context.Background(), "/path", url.Values{"x": {"1"}}, &v))
+}
+
+func TestCircuitBreaker_HalfOpenFailureReopens(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(3, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, breaker.Allow())
+		breaker.Failure()
+	}
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow())
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen, "試行中は他のリクエストを通さない")
+	breaker.Failure()
+	require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen, "試行が失敗したら再び開く")
 }
@@ -3,9 +3,8 @@ package dmmapi
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
-	"strings"
+	"net/http"
 	"time"
 
 	"github.com/tikfack/server/internal/application/model"
@@ -27,7 +26,7 @@ func (m defaultMapper) ConvertEntityFromDMM(result Result) ([]model.Video, *mode
 
 // NewRepository 新しい DMM 用 Repository を返す
 func NewRepository() (port.VideoCatalog, error) {
-	c, err := NewClient()
+	c, err := sharedClient()
 	if err != nil {
 		return nil, err
 	}
@@ -61,27 +60,17 @@ func (r *Repository) SetLogger(logger *slog.Logger) {
 	logger = logger.With(slog.String("component", "dmmapi"))
 }
 
-var ErrAPIError = errors.New("API error")
-
 // GetVideosByDate は指定日付の動画一覧を取得する
-func (r *Repository) GetVideosByDate(ctx context.Context, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
-	path := fmt.Sprintf(
-		"/v3/ItemList?site=FANZA&service=digital&floor=videoa&sort=date&hits=%d&offset=%d&gte_date=%s&lte_date=%s",
-		hits,
-		offset,
-		targetDate.Format("2006-01-02T15:04:05"),
-		time.Date(targetDate.Year(), targetDate.Month(), targetDate.Day(), 23, 59, 0, 0, targetDate.Location()).Format("2006-01-02T15:04:05"),
-	)
-	logger := logger.LoggerWithCtx(ctx)
-	logger.Debug("calling API", "path", path)
-	var resp Response
-	if err := r.client.Call(path, &resp); err != nil {
-		return nil, nil, fmt.Errorf("%w: %v", ErrAPIError, err)
+func (r *Repository) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+	resp, err := r.itemList(ctx, VideosByDateQuery(floor, targetDate, hits, offset))
+	if err != nil {
+		return nil, nil, err
 	}
 
 	videos, metadata := r.mapper.ConvertEntityFromDMM(resp.Result)
 
 	// 先頭5件のみを抽出してログ出力
+	logger := logger.LoggerWithCtx(ctx)
 	if len(videos) > 0 {
 		sampleSize := min(5, len(videos))
 		sample := videos[:sampleSize]
@@ -94,19 +83,16 @@ func (r *Repository) GetVideosByDate(ctx context.Context, targetDate time.Time,
 }
 
 // GetVideoById は指定 ID の動画情報を取得する
-func (r *Repository) GetVideoById(ctx context.Context, dmmID string) (*model.Video, error) {
-	path := fmt.Sprintf(
-		"/v3/ItemList?site=FANZA&service=digital&floor=videoa&cid=%s",
-		dmmID,
-	)
-	logger := logger.LoggerWithCtx(ctx)
-	logger.Debug("calling API", "path", path)
-	var resp Response
-	if err := r.client.Call(path, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrAPIError, err)
+func (r *Repository) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmID string) (*model.Video, error) {
+	if dmmID == "" {
+		return nil, invalidParameterError(errors.New("動画IDが指定されていません"))
+	}
+	resp, err := r.itemList(ctx, VideoByIdQuery(floor, dmmID))
+	if err != nil {
+		return nil, err
 	}
 	if len(resp.Result.Items) == 0 {
-		return nil, fmt.Errorf("動画ID %s が見つかりませんでした", dmmID)
+		return nil, notFoundError(dmmID)
 	}
 	videos, _ := r.mapper.ConvertEntityFromDMM(resp.Result)
 	return &videos[0], nil
@@ -115,190 +101,81 @@ func (r *Repository) GetVideoById(ctx context.Context, dmmID string) (*model.Vid
 // SearchVideos はキーワードやIDを使って動画を検索する
 func (r *Repository) SearchVideos(
 	ctx context.Context,
+	floor model.FloorSelector,
 	keyword, actressID, genreID, makerID, seriesID, directorID string,
 ) ([]model.Video, *model.SearchMetadata, error) {
-	params := []string{"site=FANZA", "service=digital", "floor=videoa"}
-	if keyword != "" {
-		params = append(params, fmt.Sprintf("keyword=%s", keyword))
-	}
-
-	articleIdx := 0
-	if actressID != "" {
-		params = append(params, fmt.Sprintf("article[%d]=actress", articleIdx))
-		params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, actressID))
-		articleIdx++
-	}
-	if genreID != "" {
-		params = append(params, fmt.Sprintf("article[%d]=genre", articleIdx))
-		params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, genreID))
-		articleIdx++
-	}
-	if makerID != "" {
-		params = append(params, fmt.Sprintf("article[%d]=maker", articleIdx))
-		params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, makerID))
-		articleIdx++
-	}
-	if seriesID != "" {
-		params = append(params, fmt.Sprintf("article[%d]=series", articleIdx))
-		params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, seriesID))
-		articleIdx++
-	}
-	if directorID != "" {
-		params = append(params, fmt.Sprintf("article[%d]=director", articleIdx))
-		params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, directorID))
-		articleIdx++
-	}
-
-	path := "/v3/ItemList?" + strings.Join(params, "&")
-	logger := logger.LoggerWithCtx(ctx)
-	logger.Debug("calling API", "path", path)
-	var resp Response
-	if err := r.client.Call(path, &resp); err != nil {
-		return nil, nil, fmt.Errorf("%w: %v", ErrAPIError, err)
-	}
-
-	if len(resp.Result.Items) == 0 {
-		md := &model.SearchMetadata{
-			ResultCount:   resp.Result.ResultCount,
-			TotalCount:    resp.Result.TotalCount,
-			FirstPosition: resp.Result.FirstPosition,
-		}
-		return []model.Video{}, md, nil
+	resp, err := r.itemList(ctx, SearchVideosQuery(floor, keyword, actressID, genreID, makerID, seriesID, directorID))
+	if err != nil {
+		return nil, nil, err
 	}
-
-	videos, metadata := r.mapper.ConvertEntityFromDMM(resp.Result)
-	return videos, metadata, nil
+	return r.convertList(resp.Result)
 }
 
 // GetVideosByID は複数のIDで動画を検索する
 func (r *Repository) GetVideosByID(
 	ctx context.Context,
+	floor model.FloorSelector,
 	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
 	hits, offset int32,
-	sort, gteDate, lteDate, site, service, floor string,
+	sort, gteDate, lteDate string,
 ) ([]model.Video, *model.SearchMetadata, error) {
-	params := []string{
-		fmt.Sprintf("site=%s", defaultIfEmpty(site, "FANZA")),
-		fmt.Sprintf("service=%s", defaultIfEmpty(service, "digital")),
-		fmt.Sprintf("floor=%s", defaultIfEmpty(floor, "videoa")),
-	}
-
-	if hits > 0 {
-		params = append(params, fmt.Sprintf("hits=%d", hits))
-	}
-	if offset > 0 {
-		params = append(params, fmt.Sprintf("offset=%d", offset))
-	}
-	if sort != "" {
-		params = append(params, fmt.Sprintf("sort=%s", sort))
-	}
-	if gteDate != "" {
-		params = append(params, fmt.Sprintf("gte_date=%s", gteDate))
-	}
-	if lteDate != "" {
-		params = append(params, fmt.Sprintf("lte_date=%s", lteDate))
+	query := VideosByIDQuery(floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
+	resp, err := r.itemList(ctx, query)
+	if err != nil {
+		return nil, nil, err
 	}
+	return r.convertList(resp.Result)
+}
 
-	articleIdx := 0
-	for _, id := range actressIDs {
-		if id != "" {
-			params = append(params, fmt.Sprintf("article[%d]=actress", articleIdx))
-			params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, id))
-			articleIdx++
-		}
-	}
-	for _, id := range genreIDs {
-		if id != "" {
-			params = append(params, fmt.Sprintf("article[%d]=genre", articleIdx))
-			params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, id))
-			articleIdx++
-		}
-	}
-	for _, id := range makerIDs {
-		if id != "" {
-			params = append(params, fmt.Sprintf("article[%d]=maker", articleIdx))
-			params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, id))
-			articleIdx++
-		}
-	}
-	for _, id := range seriesIDs {
-		if id != "" {
-			params = append(params, fmt.Sprintf("article[%d]=series", articleIdx))
-			params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, id))
-			articleIdx++
-		}
-	}
-	for _, id := range directorIDs {
-		if id != "" {
-			params = append(params, fmt.Sprintf("article[%d]=director", articleIdx))
-			params = append(params, fmt.Sprintf("article_id[%d]=%s", articleIdx, id))
-			articleIdx++
-		}
+// GetVideosByKeyword はキーワードで動画を検索する
+func (r *Repository) GetVideosByKeyword(
+	ctx context.Context,
+	floor model.FloorSelector,
+	keyword string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ([]model.Video, *model.SearchMetadata, error) {
+	resp, err := r.itemList(ctx, VideosByKeywordQuery(floor, keyword, hits, offset, sort, gteDate, lteDate))
+	if err != nil {
+		return nil, nil, err
 	}
 
-	path := "/v3/ItemList?" + strings.Join(params, "&")
-	logger := logger.LoggerWithCtx(ctx)
-	logger.Debug("calling API", "path", path)
-	var resp Response
-	if err := r.client.Call(path, &resp); err != nil {
-		return nil, nil, fmt.Errorf("%w: %v", ErrAPIError, err)
-	}
+	videos, metadata := r.mapper.ConvertEntityFromDMM(resp.Result)
+	return videos, metadata, nil
+}
 
-	if len(resp.Result.Items) == 0 {
+// convertList は検索結果を変換する。該当なしの場合も件数情報は返す。
+func (r *Repository) convertList(result Result) ([]model.Video, *model.SearchMetadata, error) {
+	if len(result.Items) == 0 {
 		md := &model.SearchMetadata{
-			ResultCount:   resp.Result.ResultCount,
-			TotalCount:    resp.Result.TotalCount,
-			FirstPosition: resp.Result.FirstPosition,
+			ResultCount:   result.ResultCount,
+			TotalCount:    result.TotalCount,
+			FirstPosition: result.FirstPosition,
 		}
 		return []model.Video{}, md, nil
 	}
 
-	videos, metadata := r.mapper.ConvertEntityFromDMM(resp.Result)
+	videos, metadata := r.mapper.ConvertEntityFromDMM(result)
 	return videos, metadata, nil
 }
 
-// GetVideosByKeyword はキーワードで動画を検索する
-func (r *Repository) GetVideosByKeyword(
-	ctx context.Context,
-	keyword string,
-	hits, offset int32,
-	sort, gteDate, lteDate, site, service, floor string,
-) ([]model.Video, *model.SearchMetadata, error) {
-	params := []string{
-		fmt.Sprintf("site=%s", defaultIfEmpty(site, "FANZA")),
-		fmt.Sprintf("service=%s", defaultIfEmpty(service, "digital")),
-		fmt.Sprintf("floor=%s", defaultIfEmpty(floor, "videoa")),
-	}
-
-	if keyword != "" {
-		params = append(params, fmt.Sprintf("keyword=%s", keyword))
-	}
-	if hits > 0 {
-		params = append(params, fmt.Sprintf("hits=%d", hits))
-	}
-	if offset > 0 {
-		params = append(params, fmt.Sprintf("offset=%d", offset))
-	}
-	if sort != "" {
-		params = append(params, fmt.Sprintf("sort=%s", sort))
-	}
-	if gteDate != "" {
-		params = append(params, fmt.Sprintf("gte_date=%s", gteDate))
-	}
-	if lteDate != "" {
-		params = append(params, fmt.Sprintf("lte_date=%s", lteDate))
+// itemList は検索条件を検証して ItemList API を呼び出し、失敗を port.CatalogError に分類して返す。
+func (r *Repository) itemList(ctx context.Context, query ItemListQuery) (*Response, error) {
+	values, err := query.Values()
+	if err != nil {
+		return nil, invalidParameterError(err)
 	}
 
-	path := "/v3/ItemList?" + strings.Join(params, "&")
 	logger := logger.LoggerWithCtx(ctx)
-	logger.Debug("calling API", "path", path)
+	logger.Debug("calling API", "path", itemListPath, "query", values.Encode())
 	var resp Response
-	if err := r.client.Call(path, &resp); err != nil {
-		return nil, nil, fmt.Errorf("%w: %v", ErrAPIError, err)
+	if err := r.client.Call(ctx, itemListPath, values, &resp); err != nil {
+		return nil, classifyCallError(err)
 	}
-
-	videos, metadata := r.mapper.ConvertEntityFromDMM(resp.Result)
-	return videos, metadata, nil
+	if resp.Result.Status != http.StatusOK {
+		return nil, resultStatusError(resp.Result.Status, resp.Result.Message)
+	}
+	return &resp, nil
 }
 
 // defaultIfEmpty は空文字列のときデフォルト値を返す
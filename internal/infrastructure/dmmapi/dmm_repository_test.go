@@ -3,6 +3,8 @@ package dmmapi
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
 )
 
 func TestGetVideosByDate(t *testing.T) {
@@ -21,10 +24,7 @@ func TestGetVideosByDate(t *testing.T) {
 		ContentID: "vid1",
 		Title:     "テスト動画",
 		Date:      "2024-01-01 00:00:00",
-		Review: &struct {
-			Count   int    `json:"count"`
-			Average string `json:"average"`
-		}{
+		Review: &ItemReview{
 			Count:   5,
 			Average: "4.0",
 		},
@@ -57,8 +57,8 @@ func TestGetVideosByDate(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -83,7 +83,7 @@ func TestGetVideosByDate(t *testing.T) {
 			date: fakeDate,
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("API error"))
 				mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
 			},
@@ -102,8 +102,8 @@ func TestGetVideosByDate(t *testing.T) {
 				resp.Result.FirstPosition = 0
 				resp.Result.Items = nil
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -136,7 +136,7 @@ func TestGetVideosByDate(t *testing.T) {
 			tt.setupMock(mockClient, mockMapper)
 
 			repo := NewRepositoryWithDeps(mockClient, mockMapper)
-			videos, metadata, err := repo.GetVideosByDate(ctx, tt.date, 10, 0)
+			videos, metadata, err := repo.GetVideosByDate(ctx, model.FloorSelector{}, tt.date, 10, 0)
 
 			if tt.expectedErr != nil {
 				require.Error(t, err)
@@ -159,10 +159,7 @@ func TestGetVideoById(t *testing.T) {
 		ContentID: "vid1",
 		Title:     "テスト動画",
 		Date:      "2024-01-01 00:00:00",
-		Review: &struct {
-			Count   int    `json:"count"`
-			Average string `json:"average"`
-		}{
+		Review: &ItemReview{
 			Count:   10,
 			Average: "4.5",
 		},
@@ -191,10 +188,11 @@ func TestGetVideoById(t *testing.T) {
 			videoID: "vid1",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -210,7 +208,7 @@ func TestGetVideoById(t *testing.T) {
 			videoID: "vid1",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("API error"))
 				mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
 			},
@@ -222,10 +220,11 @@ func TestGetVideoById(t *testing.T) {
 			videoID: "nonexistent",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -247,13 +246,14 @@ func TestGetVideoById(t *testing.T) {
 			tt.setupMock(mockClient, mockMapper)
 
 			repo := NewRepositoryWithDeps(mockClient, mockMapper)
-			video, err := repo.GetVideoById(ctx, tt.videoID)
+			video, err := repo.GetVideoById(ctx, model.FloorSelector{}, tt.videoID)
 
 			if tt.expectedErr != nil {
 				require.Error(t, err)
 				if errors.Is(tt.expectedErr, ErrAPIError) {
 					require.ErrorIs(t, err, ErrAPIError)
 				} else {
+					require.ErrorIs(t, err, port.ErrCatalogNotFound)
 					require.ErrorContains(t, err, "見つかりませんでした")
 				}
 			} else {
@@ -271,10 +271,7 @@ func TestSearchVideos(t *testing.T) {
 		ContentID: "vid1",
 		Title:     "テスト動画",
 		Date:      "2024-01-01 00:00:00",
-		Review: &struct {
-			Count   int    `json:"count"`
-			Average string `json:"average"`
-		}{
+		Review: &ItemReview{
 			Count:   15,
 			Average: "3.5",
 		},
@@ -317,8 +314,8 @@ func TestSearchVideos(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -348,10 +345,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -381,10 +379,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -414,10 +413,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -447,10 +447,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -480,10 +481,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "12345",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -513,7 +515,7 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("API error"))
 				mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
 			},
@@ -531,10 +533,11 @@ func TestSearchVideos(t *testing.T) {
 			directorID: "",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -561,7 +564,7 @@ func TestSearchVideos(t *testing.T) {
 			tt.setupMock(mockClient, mockMapper)
 
 			repo := NewRepositoryWithDeps(mockClient, mockMapper)
-			videos, metadata, err := repo.SearchVideos(ctx, tt.keyword, tt.actressID, tt.genreID, tt.makerID, tt.seriesID, tt.directorID)
+			videos, metadata, err := repo.SearchVideos(ctx, model.FloorSelector{}, tt.keyword, tt.actressID, tt.genreID, tt.makerID, tt.seriesID, tt.directorID)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -584,10 +587,7 @@ func TestGetVideosByID(t *testing.T) {
 		ContentID: "vid1",
 		Title:     "テスト動画",
 		Date:      "2024-01-01 00:00:00",
-		Review: &struct {
-			Count   int    `json:"count"`
-			Average string `json:"average"`
-		}{
+		Review: &ItemReview{
 			Count:   20,
 			Average: "4.2",
 		},
@@ -638,10 +638,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -678,10 +679,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -718,10 +720,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -758,10 +761,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -798,10 +802,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -838,10 +843,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -878,10 +884,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -918,10 +925,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -958,7 +966,7 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("API error"))
 				mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
 			},
@@ -983,10 +991,11 @@ func TestGetVideosByID(t *testing.T) {
 			floor:       "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				resp := &Response{}
+				resp.Result.Status = 200
 				resp.Result.Items = []Item{}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1013,9 +1022,9 @@ func TestGetVideosByID(t *testing.T) {
 			tt.setupMock(mockClient, mockMapper)
 
 			repo := NewRepositoryWithDeps(mockClient, mockMapper)
-			videos, metadata, err := repo.GetVideosByID(ctx,
+			videos, metadata, err := repo.GetVideosByID(ctx, model.FloorSelector{Site: tt.site, Service: tt.service, Floor: tt.floor},
 				tt.actressIDs, tt.genreIDs, tt.makerIDs, tt.seriesIDs, tt.directorIDs,
-				tt.hits, tt.offset, tt.sort, tt.gteDate, tt.lteDate, tt.site, tt.service, tt.floor)
+				tt.hits, tt.offset, tt.sort, tt.gteDate, tt.lteDate)
 
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
@@ -1037,10 +1046,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 		ContentID: "vid1",
 		Title:     "テスト動画",
 		Date:      "2024-01-01 00:00:00",
-		Review: &struct {
-			Count   int    `json:"count"`
-			Average string `json:"average"`
-		}{
+		Review: &ItemReview{
 			Count:   25,
 			Average: "3.8",
 		},
@@ -1089,8 +1095,8 @@ func TestGetVideosByKeyword(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1129,8 +1135,8 @@ func TestGetVideosByKeyword(t *testing.T) {
 				resp.Result.FirstPosition = 6
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1169,8 +1175,8 @@ func TestGetVideosByKeyword(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1209,8 +1215,8 @@ func TestGetVideosByKeyword(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1249,8 +1255,8 @@ func TestGetVideosByKeyword(t *testing.T) {
 				resp.Result.FirstPosition = 1
 				resp.Result.Items = []Item{item}
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ string, v interface{}) error {
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
 						*v.(*Response) = *resp
 						return nil
 					})
@@ -1283,7 +1289,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 			floor:   "videoa",
 			setupMock: func(mockClient *MockClientInterface, mockMapper *MockMapperInterface) {
 				mockClient.EXPECT().
-					Call(gomock.Any(), gomock.Any()).
+					Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("API error"))
 				mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
 			},
@@ -1304,7 +1310,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 
 			tt.setupMock(mockClient, mockMapper)
 
-			videos, metadata, err := repo.GetVideosByKeyword(ctx, tt.keyword, tt.hits, tt.offset, tt.sort, tt.gteDate, tt.lteDate, tt.site, tt.service, tt.floor)
+			videos, metadata, err := repo.GetVideosByKeyword(ctx, model.FloorSelector{Site: tt.site, Service: tt.service, Floor: tt.floor}, tt.keyword, tt.hits, tt.offset, tt.sort, tt.gteDate, tt.lteDate)
 			if tt.expectedErr != nil {
 				assert.Error(t, err)
 				assert.ErrorIs(t, err, tt.expectedErr)
@@ -1347,3 +1353,162 @@ func TestDefaultIfEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoryErrorClassification(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		callErr       error
+		resultStatus  int
+		expectedKind  error
+		expectedRetry bool
+	}{
+		{
+			name:          "429 はレート制限として再試行可能",
+			callErr:       &StatusError{StatusCode: 429, RetryAfter: 3 * time.Second},
+			expectedKind:  port.ErrCatalogRateLimited,
+			expectedRetry: true,
+		},
+		{
+			name:          "400 はパラメータ不正として再試行不可",
+			callErr:       &StatusError{StatusCode: 400},
+			expectedKind:  port.ErrCatalogInvalidParameter,
+			expectedRetry: false,
+		},
+		{
+			name:          "5xx は上流障害として再試行可能",
+			callErr:       &StatusError{StatusCode: 503},
+			expectedKind:  port.ErrCatalogUnavailable,
+			expectedRetry: true,
+		},
+		{
+			name:          "その他の 4xx は上流ステータスエラー",
+			callErr:       &StatusError{StatusCode: 403},
+			expectedKind:  port.ErrCatalogUpstreamStatus,
+			expectedRetry: false,
+		},
+		{
+			name:          "クライアント側のレート制限は上流のレート制限と区別する",
+			callErr:       &RateLimitError{RetryAfter: time.Second},
+			expectedKind:  port.ErrCatalogThrottled,
+			expectedRetry: true,
+		},
+		{
+			name:          "ブレーカーが開いている場合は上流障害",
+			callErr:       ErrCircuitOpen,
+			expectedKind:  port.ErrCatalogUnavailable,
+			expectedRetry: true,
+		},
+		{
+			name:          "デコード失敗は再試行不可",
+			callErr:       fmt.Errorf("%w: %w", ErrDecode, errors.New("unexpected end of JSON input")),
+			expectedKind:  port.ErrCatalogDecode,
+			expectedRetry: false,
+		},
+		{
+			name:          "result.status が 200 以外なら上流ステータスエラー",
+			resultStatus:  400,
+			expectedKind:  port.ErrCatalogUpstreamStatus,
+			expectedRetry: false,
+		},
+		{
+			name:          "result.status が 5xx なら再試行可能",
+			resultStatus:  500,
+			expectedKind:  port.ErrCatalogUpstreamStatus,
+			expectedRetry: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockClientInterface(ctrl)
+			mockMapper := NewMockMapperInterface(ctrl)
+			mockClient.EXPECT().
+				Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+					if tt.callErr != nil {
+						return tt.callErr
+					}
+					v.(*Response).Result.Status = tt.resultStatus
+					v.(*Response).Result.Message = "error"
+					return nil
+				})
+			mockMapper.EXPECT().ConvertEntityFromDMM(gomock.Any()).Times(0)
+
+			repo := NewRepositoryWithDeps(mockClient, mockMapper)
+			_, _, err := repo.GetVideosByKeyword(ctx, model.FloorSelector{}, "kw", 10, 0, "", "", "")
+
+			require.ErrorIs(t, err, tt.expectedKind)
+			require.ErrorIs(t, err, ErrAPIError)
+			require.Equal(t, tt.expectedRetry, port.IsRetryable(err))
+
+			var catalogErr *port.CatalogError
+			require.ErrorAs(t, err, &catalogErr)
+			if statusErr, ok := tt.callErr.(*StatusError); ok {
+				require.Equal(t, statusErr.StatusCode, catalogErr.Status)
+				require.Equal(t, statusErr.RetryAfter, catalogErr.RetryAfter)
+			}
+			if tt.resultStatus != 0 {
+				require.Equal(t, tt.resultStatus, catalogErr.Status)
+			}
+		})
+	}
+}
+
+func TestGetVideoByIdRequiresID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	mockClient.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	repo := NewRepositoryWithDeps(mockClient, NewMockMapperInterface(ctrl))
+	_, err := repo.GetVideoById(context.Background(), model.FloorSelector{}, "")
+	require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+	require.False(t, port.IsRetryable(err))
+}
+
+func TestSearchVideosBuildsQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	mockMapper := NewMockMapperInterface(ctrl)
+	expected := url.Values{
+		"site":          {"FANZA"},
+		"service":       {"digital"},
+		"floor":         {"videoa"},
+		"keyword":       {"女優 名前&sort=rank"},
+		"article[0]":    {"actress"},
+		"article_id[0]": {"1"},
+		"article[1]":    {"series"},
+		"article_id[1]": {"4"},
+	}
+	mockClient.EXPECT().
+		Call(gomock.Any(), itemListPath, expected, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+			v.(*Response).Result.Status = 200
+			return nil
+		})
+
+	repo := NewRepositoryWithDeps(mockClient, mockMapper)
+	videos, _, err := repo.SearchVideos(context.Background(), model.FloorSelector{}, "女優 名前&sort=rank", "1", "", "", "4", "")
+	require.NoError(t, err)
+	require.Empty(t, videos)
+}
+
+func TestInvalidQueryIsRejectedBeforeCall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	mockClient.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	repo := NewRepositoryWithDeps(mockClient, NewMockMapperInterface(ctrl))
+	_, _, err := repo.GetVideosByKeyword(context.Background(), model.FloorSelector{}, "kw", 10, 0, "newest", "", "")
+	require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+}
@@ -0,0 +1,79 @@
+package dmmapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tikfack/server/internal/application/port"
+)
+
+// ErrAPIError は DMM API 呼び出しの失敗を表す。
+// 返却するエラーは port.CatalogError で分類したうえで、このエラーでも判定できるようにしている。
+var ErrAPIError = errors.New("API error")
+
+// classifyCallError は Client.Call の失敗を port.CatalogError に分類する。
+func classifyCallError(err error) error {
+	catalogErr := &port.CatalogError{
+		Kind: port.ErrCatalogUnavailable,
+		Err:  fmt.Errorf("%w: %w", ErrAPIError, err),
+	}
+
+	var statusErr *StatusError
+	var rateLimitErr *RateLimitError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		catalogErr.Kind = port.ErrCatalogThrottled
+		catalogErr.RetryAfter = rateLimitErr.RetryAfter
+	case errors.As(err, &statusErr):
+		catalogErr.Status = statusErr.StatusCode
+		catalogErr.RetryAfter = statusErr.RetryAfter
+		switch {
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			catalogErr.Kind = port.ErrCatalogRateLimited
+		case statusErr.StatusCode == http.StatusBadRequest:
+			catalogErr.Kind = port.ErrCatalogInvalidParameter
+		case statusErr.StatusCode >= 500:
+			catalogErr.Kind = port.ErrCatalogUnavailable
+		default:
+			catalogErr.Kind = port.ErrCatalogUpstreamStatus
+		}
+	case errors.Is(err, ErrDecode):
+		catalogErr.Kind = port.ErrCatalogDecode
+	}
+	// ErrCircuitOpen・接続エラー・タイムアウトは上流の一時的な障害として扱う
+	return catalogErr
+}
+
+// resultStatusError は HTTP としては成功したが result.status が 200 以外だった応答をエラーにする。
+func resultStatusError(status int, message string) error {
+	return &port.CatalogError{
+		Kind:   port.ErrCatalogUpstreamStatus,
+		Status: status,
+		Err:    fmt.Errorf("%w: result.status=%d message=%q", ErrAPIError, status, message),
+	}
+}
+
+// notFoundError は指定 ID の動画が存在しないことを表すエラーを返す。
+func notFoundError(dmmID string) error {
+	return &port.CatalogError{
+		Kind: port.ErrCatalogNotFound,
+		Err:  fmt.Errorf("動画ID %s が見つかりませんでした", dmmID),
+	}
+}
+
+// actressNotFoundError は指定 ID の女優が存在しないことを表すエラーを返す。
+func actressNotFoundError(actressID string) error {
+	return &port.CatalogError{
+		Kind: port.ErrCatalogNotFound,
+		Err:  fmt.Errorf("女優ID %s が見つかりませんでした", actressID),
+	}
+}
+
+// invalidParameterError は DMM API を呼び出す前に検出したパラメータ不正を表すエラーを返す。
+func invalidParameterError(err error) error {
+	return &port.CatalogError{
+		Kind: port.ErrCatalogInvalidParameter,
+		Err:  err,
+	}
+}
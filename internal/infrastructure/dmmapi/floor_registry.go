@@ -0,0 +1,87 @@
+package dmmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// floorListPath は DMM フロア一覧 API のパス。
+const floorListPath = "/v3/FloorList"
+
+// FloorRegistry は FloorList API から読み込んだフロアの一覧を保持する port.FloorCatalog の実装。
+// フロアの構成はほとんど変わらないため起動時に一度だけ読み込み、以降は API を呼び出さない。
+type FloorRegistry struct {
+	floors []model.Floor
+	index  map[model.FloorSelector]struct{}
+}
+
+// NewFloorRegistry は環境変数の設定で FloorList API を呼び出し、FloorRegistry を返す。
+// クライアントは動画用の Repository と共有し、レート制限を API ID 単位で守る。
+func NewFloorRegistry(ctx context.Context) (*FloorRegistry, error) {
+	c, err := sharedClient()
+	if err != nil {
+		return nil, err
+	}
+	return LoadFloorRegistry(ctx, c)
+}
+
+// LoadFloorRegistry は client で FloorList API を呼び出し、FloorRegistry を返す。
+func LoadFloorRegistry(ctx context.Context, client ClientInterface) (*FloorRegistry, error) {
+	logger := logger.LoggerWithCtx(ctx)
+	logger.Debug("calling API", "path", floorListPath)
+	var resp FloorListResponse
+	if err := client.Call(ctx, floorListPath, url.Values{}, &resp); err != nil {
+		return nil, classifyCallError(err)
+	}
+	if status := int(resp.Result.Status); status != 0 && status != http.StatusOK {
+		return nil, resultStatusError(status, resp.Result.Message)
+	}
+	floors := ConvertFloorsFromDMM(resp.Result)
+	if len(floors) == 0 {
+		return nil, &port.CatalogError{
+			Kind: port.ErrCatalogDecode,
+			Err:  fmt.Errorf("%w: フロア一覧が空です", ErrAPIError),
+		}
+	}
+	return NewFloorRegistryFromFloors(floors), nil
+}
+
+// NewFloorRegistryFromFloors は与えられたフロアで FloorRegistry を返す。テストや固定の構成で使う。
+func NewFloorRegistryFromFloors(floors []model.Floor) *FloorRegistry {
+	index := make(map[model.FloorSelector]struct{}, len(floors))
+	for _, f := range floors {
+		index[f.Selector()] = struct{}{}
+	}
+	return &FloorRegistry{floors: floors, index: index}
+}
+
+// ListFloors は読み込み済みのフロアの一覧を返す
+func (r *FloorRegistry) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	floors := make([]model.Floor, len(r.floors))
+	copy(floors, r.floors)
+	return floors, nil
+}
+
+// ResolveFloor は空の項目を既定値（FANZA / digital / videoa）で補い、FloorList に存在する組み合わせか検証する
+func (r *FloorRegistry) ResolveFloor(ctx context.Context, selector model.FloorSelector) (model.FloorSelector, error) {
+	resolved := model.FloorSelector{
+		Site:    defaultIfEmpty(strings.TrimSpace(selector.Site), defaultSite),
+		Service: defaultIfEmpty(strings.TrimSpace(selector.Service), defaultService),
+		Floor:   defaultIfEmpty(strings.TrimSpace(selector.Floor), defaultFloor),
+	}
+	if _, ok := r.index[resolved]; !ok {
+		return model.FloorSelector{}, invalidParameterError(fmt.Errorf(
+			"存在しないフロアです: site=%q service=%q floor=%q", resolved.Site, resolved.Service, resolved.Floor))
+	}
+	return resolved, nil
+}
+
+// ensure interface compliance
+var _ port.FloorCatalog = (*FloorRegistry)(nil)
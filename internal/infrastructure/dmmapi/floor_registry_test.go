@@ -0,0 +1,143 @@
+package dmmapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+)
+
+// floorListJSON は FloorList API のレスポンス例。status は返らず、フロア ID は文字列で返る。
+const floorListJSON = `{"result":{"site":[
+	{"name":"DMM.com（一般）","code":"DMM.com","service":[
+		{"name":"動画","code":"digital","floor":[{"id":"6","name":"アニメ","code":"anime"}]}]},
+	{"name":"FANZA（アダルト）","code":"FANZA","service":[
+		{"name":"動画","code":"digital","floor":[
+			{"id":"43","name":"ビデオ","code":"videoa"},
+			{"id":"44","name":"素人","code":"videoc"}]},
+		{"name":"通販","code":"mono","floor":[{"id":"74","name":"DVD","code":"dvd"}]}]}]}}`
+
+func loadTestFloorRegistry(t *testing.T) *FloorRegistry {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockClient := NewMockClientInterface(ctrl)
+	mockClient.EXPECT().
+		Call(gomock.Any(), "/v3/FloorList", url.Values{}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+			return json.Unmarshal([]byte(floorListJSON), v)
+		})
+
+	registry, err := LoadFloorRegistry(context.Background(), mockClient)
+	require.NoError(t, err)
+	return registry
+}
+
+func TestLoadFloorRegistry(t *testing.T) {
+	registry := loadTestFloorRegistry(t)
+
+	floors, err := registry.ListFloors(context.Background())
+	require.NoError(t, err)
+	require.Len(t, floors, 4)
+	require.Equal(t, model.Floor{
+		SiteName:    "FANZA（アダルト）",
+		SiteCode:    "FANZA",
+		ServiceName: "動画",
+		ServiceCode: "digital",
+		FloorID:     "44",
+		FloorName:   "素人",
+		FloorCode:   "videoc",
+	}, floors[2])
+}
+
+func TestLoadFloorRegistryErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(v interface{}) error
+		kind error
+	}{
+		{
+			name: "API の呼び出しに失敗",
+			call: func(v interface{}) error { return &StatusError{StatusCode: 503} },
+			kind: port.ErrCatalogUnavailable,
+		},
+		{
+			name: "result.status が 200 以外",
+			call: func(v interface{}) error {
+				return json.Unmarshal([]byte(`{"result":{"status":400,"message":"bad request"}}`), v)
+			},
+			kind: port.ErrCatalogUpstreamStatus,
+		},
+		{
+			name: "フロアが空",
+			call: func(v interface{}) error { return json.Unmarshal([]byte(`{"result":{"site":[]}}`), v) },
+			kind: port.ErrCatalogDecode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockClientInterface(ctrl)
+			mockClient.EXPECT().
+				Call(gomock.Any(), "/v3/FloorList", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+					return tt.call(v)
+				})
+
+			_, err := LoadFloorRegistry(context.Background(), mockClient)
+			require.ErrorIs(t, err, tt.kind)
+		})
+	}
+}
+
+func TestResolveFloor(t *testing.T) {
+	registry := loadTestFloorRegistry(t)
+
+	tests := []struct {
+		name     string
+		selector model.FloorSelector
+		expected model.FloorSelector
+		invalid  bool
+	}{
+		{
+			name:     "未指定の場合は既定のフロア",
+			selector: model.FloorSelector{},
+			expected: model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoa"},
+		},
+		{
+			name:     "フロアだけ指定",
+			selector: model.FloorSelector{Floor: " videoc "},
+			expected: model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoc"},
+		},
+		{
+			name:     "別サイトのフロア",
+			selector: model.FloorSelector{Site: "DMM.com", Service: "digital", Floor: "anime"},
+			expected: model.FloorSelector{Site: "DMM.com", Service: "digital", Floor: "anime"},
+		},
+		{
+			name:     "存在しない組み合わせ",
+			selector: model.FloorSelector{Service: "mono"},
+			invalid:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := registry.ResolveFloor(context.Background(), tt.selector)
+			if tt.invalid {
+				require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package dmmapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded はクライアント側のレート制限により、上流を呼ばずに失敗したことを表す。
+var ErrRateLimitExceeded = errors.New("dmm api client rate limit exceeded")
+
+// RateLimitError は待機時間の上限を超えるためリクエストを拒否したことを表す。
+// errors.Is(err, ErrRateLimitExceeded) で判定できる。
+type RateLimitError struct {
+	// RetryAfter はトークンが補充されるまでの見込み時間。
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", ErrRateLimitExceeded, e.RetryAfter)
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimitExceeded
+}
+
+// RateLimiterStats は待機状況の統計。
+type RateLimiterStats struct {
+	// Acquired はトークンを取得できたリクエスト数（待機したものを含み、待機中のキャンセルは除く）。
+	Acquired uint64
+	// Waited は取得までに待機したリクエスト数。
+	Waited uint64
+	// Rejected は待機時間の上限を超えるため拒否したリクエスト数。
+	Rejected uint64
+	// Canceled は待機中に呼び出し元がキャンセルしたリクエスト数。
+	Canceled uint64
+	// TotalWait・MaxWait は待機したリクエストの合計・最大待機時間。
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// LogAttrs は統計をログの属性（キーと値の組）で返す。待機時間の合計と平均から送信枠の不足を判断できる。
+func (s RateLimiterStats) LogAttrs() []any {
+	var avgWait time.Duration
+	if s.Waited > 0 {
+		avgWait = s.TotalWait / time.Duration(s.Waited)
+	}
+	return []any{
+		"acquired_total", s.Acquired,
+		"waited_total", s.Waited,
+		"rejected_total", s.Rejected,
+		"canceled_total", s.Canceled,
+		"total_wait", s.TotalWait,
+		"avg_wait", avgWait,
+		"max_wait", s.MaxWait,
+	}
+}
+
+// RateLimiter はトークンバケット方式のレートリミッター。複数のゴルーチンから共有できる。
+// 1 秒あたり qps 個のトークンを補充し、最大 burst 個まで貯める。
+type RateLimiter struct {
+	mu      sync.Mutex
+	qps     float64
+	burst   float64
+	maxWait time.Duration
+	tokens  float64
+	last    time.Time
+	stats   RateLimiterStats
+	now     func() time.Time
+}
+
+// NewRateLimiter は新しい RateLimiter を返す。qps が 0 以下の場合は制限しない。
+// maxWait が 0 以下の場合は待機時間に上限を設けない。
+func NewRateLimiter(qps float64, burst int, maxWait time.Duration) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		qps:     qps,
+		burst:   float64(burst),
+		maxWait: maxWait,
+		tokens:  float64(burst),
+		now:     time.Now,
+	}
+}
+
+// Wait はトークンを 1 つ取得できるまで待機する。
+// 待機時間が上限を超える場合は *RateLimitError を、待機中に ctx が終了した場合は ctx.Err() を返す。
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.qps <= 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait, err := l.reserve()
+	if err != nil || wait <= 0 {
+		return err
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve はトークンを 1 つ予約し、利用可能になるまでの待機時間を返す。
+func (l *RateLimiter) reserve() (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.qps)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		l.stats.Acquired++
+		return 0, nil
+	}
+
+	wait := time.Duration(-l.tokens / l.qps * float64(time.Second))
+	if l.maxWait > 0 && wait > l.maxWait {
+		l.tokens++
+		l.stats.Rejected++
+		return 0, &RateLimitError{RetryAfter: wait}
+	}
+	l.stats.Acquired++
+	l.stats.Waited++
+	l.stats.TotalWait += wait
+	if wait > l.stats.MaxWait {
+		l.stats.MaxWait = wait
+	}
+	return wait, nil
+}
+
+// cancel は待機中にキャンセルされた予約のトークンを返却する。
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+	l.stats.Acquired--
+	l.stats.Canceled++
+}
+
+// Stats は現在までの待機状況の統計を返す。
+func (l *RateLimiter) Stats() RateLimiterStats {
+	if l == nil {
+		return RateLimiterStats{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
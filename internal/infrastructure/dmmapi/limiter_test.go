@@ -0,0 +1,126 @@
+package dmmapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Burst(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(1, 3, time.Millisecond)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+	err := limiter.Wait(context.Background())
+	require.ErrorIs(t, err, ErrRateLimitExceeded)
+
+	var rateLimitErr *RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	require.Equal(t, time.Second, rateLimitErr.RetryAfter)
+
+	// 1 秒経過すれば 1 トークン補充される
+	now = now.Add(time.Second)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	stats := limiter.Stats()
+	require.Equal(t, uint64(4), stats.Acquired)
+	require.Equal(t, uint64(1), stats.Rejected)
+	require.Zero(t, stats.Waited)
+}
+
+func TestRateLimiter_WaitsForToken(t *testing.T) {
+	limiter := NewRateLimiter(50, 1, time.Second)
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(context.Background()))
+	require.NoError(t, limiter.Wait(context.Background()))
+	require.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond, "2 件目は補充を待つ")
+
+	stats := limiter.Stats()
+	require.Equal(t, uint64(2), stats.Acquired)
+	require.Equal(t, uint64(1), stats.Waited)
+	require.Greater(t, stats.TotalWait, time.Duration(0))
+	require.Equal(t, stats.TotalWait, stats.MaxWait)
+}
+
+func TestRateLimiter_CancelReturnsToken(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(1, 1, 0)
+	limiter.now = func() time.Time { return now }
+
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+
+	// キャンセルした予約のトークンは返却され、次の補充で取得できる
+	now = now.Add(time.Second)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	stats := limiter.Stats()
+	require.Equal(t, uint64(1), stats.Canceled)
+	require.Equal(t, uint64(2), stats.Acquired)
+}
+
+func TestRateLimiter_SharedAcrossGoroutines(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(1, 5, time.Millisecond)
+	limiter.now = func() time.Time { return now }
+
+	var allowed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if limiter.Wait(context.Background()) == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(5), allowed.Load())
+	require.Equal(t, uint64(15), limiter.Stats().Rejected)
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	var limiter *RateLimiter
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	limiter = NewRateLimiter(0, 1, time.Millisecond)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+}
+
+func TestClientCall_RateLimitExceeded(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		io.WriteString(w, `{}`)
+	}))
+	defer ts.Close()
+
+	breaker := NewCircuitBreaker(1, time.Minute)
+	c := newTestClient(ts, 0, breaker)
+	c.Limiter = NewRateLimiter(0.001, 1, time.Millisecond)
+	var v map[string]any
+
+	require.NoError(t, c.Call(context.Background(), "/path", url.Values{}, &v))
+	err := c.Call(context.Background(), "/path", url.Values{}, &v)
+	require.ErrorIs(t, err, ErrRateLimitExceeded)
+	require.Equal(t, int32(1), calls.Load(), "上限を超える場合は上流を呼ばない")
+	require.NoError(t, breaker.Allow(), "レート制限はブレーカーの失敗に数えない")
+}
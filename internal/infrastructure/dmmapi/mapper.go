@@ -8,18 +8,14 @@ import (
 	"time"
 
 	"github.com/tikfack/server/internal/application/model"
-	"github.com/tikfack/server/internal/infrastructure/util"
 )
 
-var resolveDirectURL = func(dmmID string) (string, error) {
-	return util.GetValidVideoUrl(dmmID)
-}
-
 type MapperInterface interface {
 	ConvertEntityFromDMM(Result) ([]model.Video, *model.SearchMetadata)
 }
 
 // ConvertEntityFromDMM は DMM API のレスポンス結果を model.Video と model.SearchMetadata に変換する
+// DirectURL は外部への HEAD リクエストが必要なため、ここでは解決せずプレゼンテーション層に任せる
 func ConvertEntityFromDMM(result Result) ([]model.Video, *model.SearchMetadata) {
 	metadata := &model.SearchMetadata{
 		ResultCount:   result.ResultCount,
@@ -64,15 +60,21 @@ func ConvertEntityFromDMM(result Result) ([]model.Video, *model.SearchMetadata)
 			directors = append(directors, model.Director{ID: strconv.Itoa(d.ID), Name: d.Name})
 		}
 
-		// サンプル動画URL
-		var sampleURL string
-		if item.SampleMovieURL != nil {
-			sampleURL = item.SampleMovieURL.Size720480
+		// labels変換
+		labels := make([]model.Label, 0, len(item.ItemInfo.Label))
+		for _, l := range item.ItemInfo.Label {
+			labels = append(labels, model.Label{ID: strconv.Itoa(l.ID), Name: l.Name})
 		}
 
-		directURL := ""
-		if resolved, err := resolveDirectURL(item.ContentID); err == nil && resolved != "" {
-			directURL = resolved
+		// サンプル動画URL（一覧・再生用には最も大きいサイズを使う）
+		var sampleMovies model.SampleMovies
+		if item.SampleMovieURL != nil {
+			sampleMovies = model.SampleMovies{
+				Size476x306: item.SampleMovieURL.Size476306,
+				Size560x360: item.SampleMovieURL.Size560360,
+				Size644x414: item.SampleMovieURL.Size644414,
+				Size720x480: item.SampleMovieURL.Size720480,
+			}
 		}
 
 		// レビュー情報
@@ -88,21 +90,29 @@ func ConvertEntityFromDMM(result Result) ([]model.Video, *model.SearchMetadata)
 		}
 
 		video := model.Video{
-			DmmID:        item.ContentID,
-			Title:        item.Title,
-			DirectURL:    directURL,
-			URL:          item.URL,
-			SampleURL:    sampleURL,
-			ThumbnailURL: item.ImageURL.Large,
-			CreatedAt:    created,
-			Price:        price,
-			LikesCount:   0,
-			Actresses:    actresses,
-			Genres:       genres,
-			Makers:       makers,
-			Series:       series,
-			Directors:    directors,
-			Review:       review,
+			DmmID:          item.ContentID,
+			Title:          item.Title,
+			URL:            item.URL,
+			SampleURL:      largestSampleMovie(sampleMovies),
+			ThumbnailURL:   item.ImageURL.Large,
+			CreatedAt:      created,
+			Price:          price,
+			LikesCount:     0,
+			ProductID:      item.ProductID,
+			MakerProduct:   item.MakerProduct,
+			RuntimeMinutes: parseVolume(item.Volume),
+			ListPrice:      parseYen(item.Prices.ListPrice),
+			Pricing:        convertPricing(item.Prices.Deliveries),
+			SampleImages:   convertSampleImages(item.SampleImageURL),
+			SampleMovies:   sampleMovies,
+			Campaigns:      convertCampaigns(item.Campaign),
+			Actresses:      actresses,
+			Genres:         genres,
+			Makers:         makers,
+			Series:         series,
+			Directors:      directors,
+			Labels:         labels,
+			Review:         review,
 		}
 		videos = append(videos, video)
 	}
@@ -111,7 +121,13 @@ func ConvertEntityFromDMM(result Result) ([]model.Video, *model.SearchMetadata)
 }
 
 func parsePrice(item Item) int {
-	s := strings.TrimSpace(item.Prices.Price)
+	return parseYen(item.Prices.Price)
+}
+
+// parseYen は "1980円" や "980~" のような価格の文字列を整数に変換する。
+// 価格範囲の場合は先頭の値を使用し、変換できない場合は 0 を返す。
+func parseYen(s string) int {
+	s = strings.TrimSpace(s)
 	if s == "" {
 		return 0
 	}
@@ -129,7 +145,211 @@ func parsePrice(item Item) int {
 	return p
 }
 
+// parseVolume は収録時間を分に変換する。"120"・"120分"・"02:00:00" の形式を受け付け、
+// 変換できない場合は 0 を返す。
+func parseVolume(volume flexString) int {
+	s := strings.TrimSuffix(strings.TrimSpace(string(volume)), "分")
+	if s == "" {
+		return 0
+	}
+	if strings.Contains(s, ":") {
+		parts := strings.Split(s, ":")
+		if len(parts) != 3 {
+			return 0
+		}
+		var hms [3]int
+		for i, part := range parts {
+			v, err := strconv.Atoi(part)
+			if err != nil || v < 0 {
+				return 0
+			}
+			hms[i] = v
+		}
+		return hms[0]*60 + hms[1] + hms[2]/60
+	}
+	minutes, err := strconv.Atoi(s)
+	if err != nil || minutes < 0 {
+		return 0
+	}
+	return minutes
+}
+
+// convertPricing は配信形式ごとの価格を model.Pricing に変換する。
+// 配信形式の価格が返らない商品は Deliveries を空にする。
+func convertPricing(deliveries *Deliveries) model.Pricing {
+	pricing := model.Pricing{Currency: model.CurrencyJPY, Deliveries: []model.DeliveryPrice{}}
+	if deliveries == nil {
+		return pricing
+	}
+	for _, d := range deliveries.Delivery {
+		pricing.Deliveries = append(pricing.Deliveries, model.NewDeliveryPrice(
+			model.ParseDeliveryType(d.Type),
+			parseYen(d.Price),
+			parseYen(d.ListPrice),
+		))
+	}
+	return pricing
+}
+
+// convertSampleImages は小さい画像と大きい画像を同じ順序で組にする。
+// どちらかが欠けている位置は空文字列にする。
+func convertSampleImages(urls *SampleImageURL) []model.SampleImage {
+	if urls == nil {
+		return []model.SampleImage{}
+	}
+	var small, large []string
+	if urls.SampleS != nil {
+		small = urls.SampleS.Image
+	}
+	if urls.SampleL != nil {
+		large = urls.SampleL.Image
+	}
+	images := make([]model.SampleImage, max(len(small), len(large)))
+	for i := range images {
+		if i < len(small) {
+			images[i].Small = small[i]
+		}
+		if i < len(large) {
+			images[i].Large = large[i]
+		}
+	}
+	return images
+}
+
+func convertCampaigns(campaigns []Campaign) []model.Campaign {
+	converted := make([]model.Campaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		converted = append(converted, model.Campaign{
+			Title:   c.Title,
+			BeginAt: parseDate(c.DateBegin),
+			EndAt:   parseDate(c.DateEnd),
+		})
+	}
+	return converted
+}
+
+// largestSampleMovie は最も大きいサイズのサンプル動画の URL を返す。
+func largestSampleMovie(m model.SampleMovies) string {
+	for _, url := range []string{m.Size720x480, m.Size644x414, m.Size560x360, m.Size476x306} {
+		if url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
 func parseDate(dateStr string) time.Time {
 	t, _ := time.Parse("2006-01-02 15:04:05", dateStr)
 	return t
 }
+
+// ConvertActressesFromDMM は ActressSearch API の結果を model.ActressProfile と model.SearchMetadata に変換する。
+// 数値に変換できない項目（未登録など）は 0 とする。
+func ConvertActressesFromDMM(result ActressResult) ([]model.ActressProfile, *model.SearchMetadata) {
+	metadata := &model.SearchMetadata{
+		ResultCount:   int(result.ResultCount),
+		TotalCount:    int(result.TotalCount),
+		FirstPosition: int(result.FirstPosition),
+	}
+
+	actresses := make([]model.ActressProfile, 0, len(result.Actresses))
+	for _, item := range result.Actresses {
+		actress := model.ActressProfile{
+			ID:          string(item.ID),
+			Name:        item.Name,
+			Ruby:        item.Ruby,
+			Bust:        parseMeasurement(item.Bust),
+			Cup:         string(item.Cup),
+			Waist:       parseMeasurement(item.Waist),
+			Hip:         parseMeasurement(item.Hip),
+			Height:      parseMeasurement(item.Height),
+			Birthday:    string(item.Birthday),
+			BloodType:   string(item.BloodType),
+			Hobby:       string(item.Hobby),
+			Prefectures: string(item.Prefectures),
+		}
+		if item.ImageURL != nil {
+			actress.ImageURL = model.ActressImage{Small: item.ImageURL.Small, Large: item.ImageURL.Large}
+		}
+		if item.ListURL != nil {
+			actress.ListURL = model.ActressListURL{
+				Digital: item.ListURL.Digital,
+				Monthly: item.ListURL.Monthly,
+				Mono:    item.ListURL.Mono,
+			}
+		}
+		actresses = append(actresses, actress)
+	}
+
+	return actresses, metadata
+}
+
+func parseMeasurement(s flexString) int {
+	v, err := strconv.Atoi(strings.TrimSpace(string(s)))
+	if err != nil || v < 0 {
+		return 0
+	}
+	return v
+}
+
+// ConvertTaxonomyFromDMM は GenreSearch・MakerSearch・SeriesSearch API の結果を
+// model.TaxonomyEntry と model.SearchMetadata に変換する。
+func ConvertTaxonomyFromDMM(kind model.TaxonomyKind, result TaxonomyResult) ([]model.TaxonomyEntry, *model.SearchMetadata) {
+	metadata := &model.SearchMetadata{
+		ResultCount:   int(result.ResultCount),
+		TotalCount:    int(result.TotalCount),
+		FirstPosition: int(result.FirstPosition),
+	}
+
+	var items []TaxonomyItem
+	switch kind {
+	case model.TaxonomyGenre:
+		items = result.Genres
+	case model.TaxonomyMaker:
+		items = result.Makers
+	case model.TaxonomySeries:
+		items = result.Series
+	}
+
+	entries := make([]model.TaxonomyEntry, 0, len(items))
+	for _, item := range items {
+		var id flexString
+		switch kind {
+		case model.TaxonomyGenre:
+			id = item.GenreID
+		case model.TaxonomyMaker:
+			id = item.MakerID
+		case model.TaxonomySeries:
+			id = item.SeriesID
+		}
+		entries = append(entries, model.TaxonomyEntry{
+			ID:      string(id),
+			Name:    item.Name,
+			Ruby:    item.Ruby,
+			ListURL: item.ListURL,
+		})
+	}
+
+	return entries, metadata
+}
+
+// ConvertFloorsFromDMM は FloorList API の結果をサイト・サービス・フロアの順に平坦化した model.Floor に変換する。
+func ConvertFloorsFromDMM(result FloorListResult) []model.Floor {
+	var floors []model.Floor
+	for _, site := range result.Sites {
+		for _, service := range site.Services {
+			for _, floor := range service.Floors {
+				floors = append(floors, model.Floor{
+					SiteName:    site.Name,
+					SiteCode:    site.Code,
+					ServiceName: service.Name,
+					ServiceCode: service.Code,
+					FloorID:     string(floor.ID),
+					FloorName:   floor.Name,
+					FloorCode:   floor.Code,
+				})
+			}
+		}
+	}
+	return floors
+}
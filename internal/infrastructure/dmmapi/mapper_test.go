@@ -1,6 +1,9 @@
 package dmmapi
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -11,23 +14,16 @@ import (
 )
 
 func TestConvertEntityFromDMM(t *testing.T) {
-	originalResolver := resolveDirectURL
-	defer func() { resolveDirectURL = originalResolver }()
-
 	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name             string
-		resolver         func(string) (string, error)
 		input            Result
 		expectedVideos   []model.Video
 		expectedMetadata *model.SearchMetadata
 	}{
 		{
 			name: "正常系: 全てのフィールドが存在する場合",
-			resolver: func(id string) (string, error) {
-				return "direct-" + id, nil
-			},
 			input: Result{
 				Status:        200,
 				ResultCount:   1,
@@ -39,42 +35,20 @@ func TestConvertEntityFromDMM(t *testing.T) {
 						Title:     "テスト動画",
 						Date:      "2024-01-01 00:00:00",
 						URL:       "https://example.com/video",
-						ImageURL: struct {
-							Large string `json:"large"`
-						}{
+						ImageURL: ItemImageURL{
 							Large: "https://example.com/image.jpg",
 						},
-						SampleMovieURL: &struct {
-							Size720480 string `json:"size_720_480"`
-						}{
+						SampleMovieURL: &SampleMovieURL{
 							Size720480: "https://example.com/sample.mp4",
 						},
-						Prices: struct {
-							Price      string `json:"price,omitempty"`
-							Deliveries *struct {
-								Delivery []struct {
-									Type      string `json:"type"`
-									Price     string `json:"price"`
-									ListPrice string `json:"list_price"`
-								}
-							} `json:"deliveries,omitempty"`
-						}{
+						Prices: ItemPrices{
 							Price: "1980円",
 						},
-						Review: &struct {
-							Count   int    `json:"count"`
-							Average string `json:"average"`
-						}{
+						Review: &ItemReview{
 							Count:   10,
 							Average: "4.5",
 						},
-						ItemInfo: struct {
-							Actress  []Actress  `json:"actress,omitempty"`
-							Genre    []Genre    `json:"genre,omitempty"`
-							Maker    []Maker    `json:"maker,omitempty"`
-							Series   []Series   `json:"series,omitempty"`
-							Director []Director `json:"director,omitempty"`
-						}{
+						ItemInfo: ItemInfo{
 							Actress: []Actress{
 								{ID: 1, Name: "テスト女優1"},
 								{ID: 2, Name: "テスト女優2"},
@@ -100,7 +74,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 				{
 					DmmID:        "test001",
 					Title:        "テスト動画",
-					DirectURL:    "direct-test001",
 					URL:          "https://example.com/video",
 					SampleURL:    "https://example.com/sample.mp4",
 					ThumbnailURL: "https://example.com/image.jpg",
@@ -124,6 +97,11 @@ func TestConvertEntityFromDMM(t *testing.T) {
 					Directors: []model.Director{
 						{ID: "1", Name: "テスト監督1"},
 					},
+					Labels:       []model.Label{},
+					Pricing:      model.Pricing{Currency: model.CurrencyJPY, Deliveries: []model.DeliveryPrice{}},
+					SampleImages: []model.SampleImage{},
+					SampleMovies: model.SampleMovies{Size720x480: "https://example.com/sample.mp4"},
+					Campaigns:    []model.Campaign{},
 					Review: model.Review{
 						Count:   10,
 						Average: 4.5,
@@ -138,9 +116,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 		},
 		{
 			name: "正常系: オプショナルフィールドが存在しない場合",
-			resolver: func(id string) (string, error) {
-				return "direct-" + id, nil
-			},
 			input: Result{
 				Status:        200,
 				ResultCount:   1,
@@ -152,30 +127,13 @@ func TestConvertEntityFromDMM(t *testing.T) {
 						Title:     "テスト動画2",
 						Date:      "2024-01-01 00:00:00",
 						URL:       "https://example.com/video2",
-						ImageURL: struct {
-							Large string `json:"large"`
-						}{
+						ImageURL: ItemImageURL{
 							Large: "https://example.com/image2.jpg",
 						},
-						Prices: struct {
-							Price      string `json:"price,omitempty"`
-							Deliveries *struct {
-								Delivery []struct {
-									Type      string `json:"type"`
-									Price     string `json:"price"`
-									ListPrice string `json:"list_price"`
-								}
-							} `json:"deliveries,omitempty"`
-						}{
+						Prices: ItemPrices{
 							Price: "2980円",
 						},
-						ItemInfo: struct {
-							Actress  []Actress  `json:"actress,omitempty"`
-							Genre    []Genre    `json:"genre,omitempty"`
-							Maker    []Maker    `json:"maker,omitempty"`
-							Series   []Series   `json:"series,omitempty"`
-							Director []Director `json:"director,omitempty"`
-						}{},
+						ItemInfo: ItemInfo{},
 					},
 				},
 			},
@@ -183,7 +141,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 				{
 					DmmID:        "test002",
 					Title:        "テスト動画2",
-					DirectURL:    "direct-test002",
 					URL:          "https://example.com/video2",
 					ThumbnailURL: "https://example.com/image2.jpg",
 					CreatedAt:    createdAt,
@@ -194,6 +151,10 @@ func TestConvertEntityFromDMM(t *testing.T) {
 					Makers:       []model.Maker{},
 					Series:       []model.Series{},
 					Directors:    []model.Director{},
+					Labels:       []model.Label{},
+					Pricing:      model.Pricing{Currency: model.CurrencyJPY, Deliveries: []model.DeliveryPrice{}},
+					SampleImages: []model.SampleImage{},
+					Campaigns:    []model.Campaign{},
 					Review: model.Review{
 						Count:   0,
 						Average: 0,
@@ -208,9 +169,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 		},
 		{
 			name: "正常系: 空の結果を返す場合",
-			resolver: func(id string) (string, error) {
-				return "direct-" + id, nil
-			},
 			input: Result{
 				Status:        200,
 				ResultCount:   0,
@@ -226,42 +184,20 @@ func TestConvertEntityFromDMM(t *testing.T) {
 			},
 		},
 		{
-			name: "DirectURL 解決に失敗したらサンプルURLを利用",
-			resolver: func(id string) (string, error) {
-				return "", assert.AnError
-			},
+			name: "DirectURL は変換時に解決しない",
 			input: Result{
 				ResultCount: 1,
 				TotalCount:  1,
 				Items: []Item{
 					{
-						ContentID: "testFallback",
-						Title:     "fallback",
-						Date:      "2024-01-01 00:00:00",
-						URL:       "https://example.com/fallback",
-						ImageURL: struct {
-							Large string `json:"large"`
-						}{Large: "https://example.com/thumb.jpg"},
-						SampleMovieURL: &struct {
-							Size720480 string `json:"size_720_480"`
-						}{Size720480: "https://example.com/sample.mp4"},
-						Prices: struct {
-							Price      string `json:"price,omitempty"`
-							Deliveries *struct {
-								Delivery []struct {
-									Type      string `json:"type"`
-									Price     string `json:"price"`
-									ListPrice string `json:"list_price"`
-								}
-							} `json:"deliveries,omitempty"`
-						}{Price: "100円"},
-						ItemInfo: struct {
-							Actress  []Actress  `json:"actress,omitempty"`
-							Genre    []Genre    `json:"genre,omitempty"`
-							Maker    []Maker    `json:"maker,omitempty"`
-							Series   []Series   `json:"series,omitempty"`
-							Director []Director `json:"director,omitempty"`
-						}{},
+						ContentID:      "testFallback",
+						Title:          "fallback",
+						Date:           "2024-01-01 00:00:00",
+						URL:            "https://example.com/fallback",
+						ImageURL:       ItemImageURL{Large: "https://example.com/thumb.jpg"},
+						SampleMovieURL: &SampleMovieURL{Size720480: "https://example.com/sample.mp4"},
+						Prices:         ItemPrices{Price: "100円"},
+						ItemInfo:       ItemInfo{},
 					},
 				},
 			},
@@ -269,7 +205,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 				{
 					DmmID:        "testFallback",
 					Title:        "fallback",
-					DirectURL:    "https://example.com/sample.mp4",
 					URL:          "https://example.com/fallback",
 					SampleURL:    "https://example.com/sample.mp4",
 					ThumbnailURL: "https://example.com/thumb.jpg",
@@ -281,6 +216,11 @@ func TestConvertEntityFromDMM(t *testing.T) {
 					Makers:       []model.Maker{},
 					Series:       []model.Series{},
 					Directors:    []model.Director{},
+					Labels:       []model.Label{},
+					Pricing:      model.Pricing{Currency: model.CurrencyJPY, Deliveries: []model.DeliveryPrice{}},
+					SampleImages: []model.SampleImage{},
+					SampleMovies: model.SampleMovies{Size720x480: "https://example.com/sample.mp4"},
+					Campaigns:    []model.Campaign{},
 					Review:       model.Review{},
 				},
 			},
@@ -290,9 +230,6 @@ func TestConvertEntityFromDMM(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.resolver != nil {
-				resolveDirectURL = tt.resolver
-			}
 			videos, metadata := ConvertEntityFromDMM(tt.input)
 
 			require.Equal(t, tt.expectedVideos, videos)
@@ -308,6 +245,119 @@ func TestConvertEntityFromDMM(t *testing.T) {
 	}
 }
 
+// loadItemListFixture は testdata の ItemList API のレスポンスを読み込む。
+func loadItemListFixture(t *testing.T, name string) Result {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	var resp Response
+	require.NoError(t, json.Unmarshal(data, &resp))
+	return resp.Result
+}
+
+func TestConvertEntityFromDMM_Fixture(t *testing.T) {
+	videos, metadata := ConvertEntityFromDMM(loadItemListFixture(t, "itemlist_videoa.json"))
+	require.Equal(t, &model.SearchMetadata{ResultCount: 3, TotalCount: 51234, FirstPosition: 1}, metadata)
+	require.Len(t, videos, 3)
+
+	t.Run("全ての項目がある商品", func(t *testing.T) {
+		v := videos[0]
+		assert.Equal(t, "abc00123", v.DmmID)
+		assert.Equal(t, "abc00123", v.ProductID)
+		assert.Equal(t, "ABC-123", v.MakerProduct)
+		assert.Equal(t, 120, v.RuntimeMinutes)
+		assert.Equal(t, 300, v.Price)
+		assert.Equal(t, 500, v.ListPrice)
+		assert.Equal(t, model.Pricing{
+			Currency: model.CurrencyJPY,
+			Deliveries: []model.DeliveryPrice{
+				{Type: model.DeliveryStream, Price: 300, ListPrice: 500, DiscountPercent: 40},
+				{Type: model.DeliveryDownload, Price: 980, ListPrice: 1480, DiscountPercent: 33},
+				{Type: model.DeliveryHD, Price: 1280, ListPrice: 1980, DiscountPercent: 35},
+			},
+		}, v.Pricing)
+		assert.True(t, v.Pricing.OnSale())
+		assert.Equal(t, 40, v.Pricing.MaxDiscountPercent())
+		assert.Equal(t, []model.SampleImage{
+			{
+				Small: "https://pics.dmm.co.jp/digital/video/abc00123/abc00123-1.jpg",
+				Large: "https://pics.dmm.co.jp/digital/video/abc00123/abc00123jp-1.jpg",
+			},
+			{
+				Small: "https://pics.dmm.co.jp/digital/video/abc00123/abc00123-2.jpg",
+				Large: "https://pics.dmm.co.jp/digital/video/abc00123/abc00123jp-2.jpg",
+			},
+		}, v.SampleImages)
+		assert.Equal(t, model.SampleMovies{
+			Size476x306: "https://www.dmm.co.jp/litevideo/-/part/=/cid=abc00123/size=476_306/",
+			Size560x360: "https://www.dmm.co.jp/litevideo/-/part/=/cid=abc00123/size=560_360/",
+			Size644x414: "https://www.dmm.co.jp/litevideo/-/part/=/cid=abc00123/size=644_414/",
+			Size720x480: "https://www.dmm.co.jp/litevideo/-/part/=/cid=abc00123/size=720_480/",
+		}, v.SampleMovies)
+		assert.Equal(t, "https://www.dmm.co.jp/litevideo/-/part/=/cid=abc00123/size=720_480/", v.SampleURL)
+		assert.Equal(t, []model.Campaign{{
+			Title:   "新作40%OFF",
+			BeginAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			EndAt:   time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC),
+		}}, v.Campaigns)
+		assert.Equal(t, []model.Label{{ID: "4712", Name: "サンプルレーベル"}}, v.Labels)
+		assert.Equal(t, model.Review{Count: 12, Average: 4.25}, v.Review)
+		assert.Equal(t, []model.Actress{{ID: "1044099", Name: "サンプル女優"}}, v.Actresses)
+	})
+
+	t.Run("収録時間が時刻形式で大きいサンプル画像・動画がない商品", func(t *testing.T) {
+		v := videos[1]
+		assert.Equal(t, 125, v.RuntimeMinutes)
+		assert.Equal(t, 1980, v.Price)
+		assert.Equal(t, 0, v.ListPrice)
+		assert.Equal(t, []model.DeliveryPrice{
+			{Type: model.DeliveryDownload, Price: 1980, ListPrice: 1980},
+		}, v.Pricing.Deliveries)
+		assert.False(t, v.Pricing.OnSale())
+		assert.Equal(t, []model.SampleImage{
+			{Small: "https://pics.dmm.co.jp/digital/video/xyz00456/xyz00456-1.jpg"},
+		}, v.SampleImages)
+		assert.Equal(t, "https://www.dmm.co.jp/litevideo/-/part/=/cid=xyz00456/size=560_360/", v.SampleURL)
+		assert.Empty(t, v.Campaigns)
+		assert.Empty(t, v.Labels)
+	})
+
+	t.Run("項目が欠けている商品", func(t *testing.T) {
+		v := videos[2]
+		assert.Equal(t, "min00001", v.DmmID)
+		assert.Zero(t, v.RuntimeMinutes)
+		assert.Zero(t, v.Price)
+		assert.Empty(t, v.MakerProduct)
+		assert.Empty(t, v.Pricing.Deliveries)
+		assert.Empty(t, v.SampleImages)
+		assert.Equal(t, model.SampleMovies{}, v.SampleMovies)
+		assert.Empty(t, v.SampleURL)
+		assert.Equal(t, model.Review{}, v.Review)
+	})
+}
+
+func TestParseVolume(t *testing.T) {
+	tests := []struct {
+		input    flexString
+		expected int
+	}{
+		{input: "120", expected: 120},
+		{input: "120分", expected: 120},
+		{input: "01:59:59", expected: 119},
+		{input: "02:00:00", expected: 120},
+		{input: "", expected: 0},
+		{input: "不明", expected: 0},
+		{input: "1:30", expected: 0},
+		{input: "-5", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.input), func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseVolume(tt.input))
+		})
+	}
+}
+
 func TestParsePrice(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -317,16 +367,7 @@ func TestParsePrice(t *testing.T) {
 		{
 			name: "通常の価格",
 			input: Item{
-				Prices: struct {
-					Price      string `json:"price,omitempty"`
-					Deliveries *struct {
-						Delivery []struct {
-							Type      string `json:"type"`
-							Price     string `json:"price"`
-							ListPrice string `json:"list_price"`
-						}
-					} `json:"deliveries,omitempty"`
-				}{
+				Prices: ItemPrices{
 					Price: "1980円",
 				},
 			},
@@ -335,16 +376,7 @@ func TestParsePrice(t *testing.T) {
 		{
 			name: "価格範囲の場合",
 			input: Item{
-				Prices: struct {
-					Price      string `json:"price,omitempty"`
-					Deliveries *struct {
-						Delivery []struct {
-							Type      string `json:"type"`
-							Price     string `json:"price"`
-							ListPrice string `json:"list_price"`
-						}
-					} `json:"deliveries,omitempty"`
-				}{
+				Prices: ItemPrices{
 					Price: "1980円~2980円",
 				},
 			},
@@ -353,16 +385,7 @@ func TestParsePrice(t *testing.T) {
 		{
 			name: "価格が空の場合",
 			input: Item{
-				Prices: struct {
-					Price      string `json:"price,omitempty"`
-					Deliveries *struct {
-						Delivery []struct {
-							Type      string `json:"type"`
-							Price     string `json:"price"`
-							ListPrice string `json:"list_price"`
-						}
-					} `json:"deliveries,omitempty"`
-				}{
+				Prices: ItemPrices{
 					Price: "",
 				},
 			},
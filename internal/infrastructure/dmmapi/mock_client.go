@@ -10,6 +10,8 @@
 package dmmapi
 
 import (
+	context "context"
+	url "net/url"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,15 +42,15 @@ func (m *MockClientInterface) EXPECT() *MockClientInterfaceMockRecorder {
 }
 
 // Call mocks base method.
-func (m *MockClientInterface) Call(path string, v any) error {
+func (m *MockClientInterface) Call(ctx context.Context, path string, query url.Values, v any) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Call", path, v)
+	ret := m.ctrl.Call(m, "Call", ctx, path, query, v)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Call indicates an expected call of Call.
-func (mr *MockClientInterfaceMockRecorder) Call(path, v any) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) Call(ctx, path, query, v any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockClientInterface)(nil).Call), path, v)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockClientInterface)(nil).Call), ctx, path, query, v)
 }
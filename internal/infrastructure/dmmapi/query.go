@@ -0,0 +1,229 @@
+package dmmapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// itemListPath は DMM 商品検索 API のパス。
+const itemListPath = "/v3/ItemList"
+
+// dmmDateLayout は gte_date / lte_date に指定する日時の書式。
+const dmmDateLayout = "2006-01-02T15:04:05"
+
+// maxItemListHits は 1 リクエストで取得できる最大件数。
+const maxItemListHits = 100
+
+// 検索条件の既定値
+const (
+	defaultSite    = "FANZA"
+	defaultService = "digital"
+	defaultFloor   = "videoa"
+)
+
+// ArticleType は article パラメータで絞り込む対象の種類。
+type ArticleType string
+
+const (
+	ArticleActress  ArticleType = "actress"
+	ArticleGenre    ArticleType = "genre"
+	ArticleMaker    ArticleType = "maker"
+	ArticleSeries   ArticleType = "series"
+	ArticleDirector ArticleType = "director"
+)
+
+// Article は article / article_id の組による絞り込み条件。
+type Article struct {
+	Type ArticleType
+	ID   string
+}
+
+// validSorts は sort パラメータに指定できる値。
+var validSorts = map[string]struct{}{
+	"rank":   {},
+	"price":  {},
+	"-price": {},
+	"date":   {},
+	"review": {},
+	"match":  {},
+}
+
+// ItemListQuery は ItemList API の検索条件。
+// ゼロ値のフィールドはクエリに含めず、Site・Service・Floor は未指定の場合に既定値を使う。
+type ItemListQuery struct {
+	Site     string
+	Service  string
+	Floor    string
+	CID      string
+	Keyword  string
+	Articles []Article
+	Sort     string
+	GteDate  string
+	LteDate  string
+	Hits     int32
+	Offset   int32
+}
+
+// AddArticles は指定種別の ID を絞り込み条件に追加する。空の ID は無視する。
+func (q *ItemListQuery) AddArticles(articleType ArticleType, ids ...string) {
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		q.Articles = append(q.Articles, Article{Type: articleType, ID: id})
+	}
+}
+
+// 以下は VideoCatalog の各メソッドが ItemList API に送る検索条件を組み立てる。
+// キャッシュや呼び出しの集約はこの条件をエンコードしたクエリをキーにするため、
+// キーが同じ呼び出しは上流に同じクエリを送る。
+
+// VideosByDateQuery は targetDate から当日 23:59 までの新着順の検索条件を返す。
+func VideosByDateQuery(floor model.FloorSelector, targetDate time.Time, hits, offset int32) ItemListQuery {
+	endOfDay := time.Date(targetDate.Year(), targetDate.Month(), targetDate.Day(), 23, 59, 0, 0, targetDate.Location())
+	return ItemListQuery{
+		Site:    floor.Site,
+		Service: floor.Service,
+		Floor:   floor.Floor,
+		Sort:    "date",
+		Hits:    hits,
+		Offset:  offset,
+		GteDate: targetDate.Format(dmmDateLayout),
+		LteDate: endOfDay.Format(dmmDateLayout),
+	}
+}
+
+// VideoByIdQuery は商品 ID を指定した検索条件を返す。
+func VideoByIdQuery(floor model.FloorSelector, dmmID string) ItemListQuery {
+	return ItemListQuery{
+		Site:    floor.Site,
+		Service: floor.Service,
+		Floor:   floor.Floor,
+		CID:     dmmID,
+	}
+}
+
+// SearchVideosQuery はキーワードと各 ID 1 件ずつで絞り込む検索条件を返す。
+func SearchVideosQuery(floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) ItemListQuery {
+	query := ItemListQuery{
+		Site:    floor.Site,
+		Service: floor.Service,
+		Floor:   floor.Floor,
+		Keyword: keyword,
+	}
+	query.AddArticles(ArticleActress, actressID)
+	query.AddArticles(ArticleGenre, genreID)
+	query.AddArticles(ArticleMaker, makerID)
+	query.AddArticles(ArticleSeries, seriesID)
+	query.AddArticles(ArticleDirector, directorID)
+	return query
+}
+
+// VideosByIDQuery は複数の ID で絞り込む検索条件を返す。
+func VideosByIDQuery(
+	floor model.FloorSelector,
+	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ItemListQuery {
+	query := ItemListQuery{
+		Site:    floor.Site,
+		Service: floor.Service,
+		Floor:   floor.Floor,
+		Sort:    sort,
+		GteDate: gteDate,
+		LteDate: lteDate,
+		Hits:    hits,
+		Offset:  offset,
+	}
+	query.AddArticles(ArticleActress, actressIDs...)
+	query.AddArticles(ArticleGenre, genreIDs...)
+	query.AddArticles(ArticleMaker, makerIDs...)
+	query.AddArticles(ArticleSeries, seriesIDs...)
+	query.AddArticles(ArticleDirector, directorIDs...)
+	return query
+}
+
+// VideosByKeywordQuery はキーワード検索の条件を返す。
+func VideosByKeywordQuery(floor model.FloorSelector, keyword string, hits, offset int32, sort, gteDate, lteDate string) ItemListQuery {
+	return ItemListQuery{
+		Site:    floor.Site,
+		Service: floor.Service,
+		Floor:   floor.Floor,
+		Keyword: keyword,
+		Sort:    sort,
+		GteDate: gteDate,
+		LteDate: lteDate,
+		Hits:    hits,
+		Offset:  offset,
+	}
+}
+
+// Validate は列挙値や範囲を検証する。
+func (q ItemListQuery) Validate() error {
+	if q.Sort != "" {
+		if _, ok := validSorts[q.Sort]; !ok {
+			return fmt.Errorf("不正なソート順です: %q", q.Sort)
+		}
+	}
+	if q.Hits < 0 || q.Hits > maxItemListHits {
+		return fmt.Errorf("hits は 0 以上 %d 以下で指定してください: %d", maxItemListHits, q.Hits)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("offset は 0 以上で指定してください: %d", q.Offset)
+	}
+	for _, date := range []string{q.GteDate, q.LteDate} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(dmmDateLayout, date); err != nil {
+			return fmt.Errorf("不正な日時形式です（%s 形式で指定してください）: %q", dmmDateLayout, date)
+		}
+	}
+	for _, article := range q.Articles {
+		switch article.Type {
+		case ArticleActress, ArticleGenre, ArticleMaker, ArticleSeries, ArticleDirector:
+		default:
+			return fmt.Errorf("不正な絞り込み種別です: %q", article.Type)
+		}
+	}
+	return nil
+}
+
+// Values は検証済みの検索条件を url.Values に変換する。
+func (q ItemListQuery) Values() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("site", defaultIfEmpty(q.Site, defaultSite))
+	v.Set("service", defaultIfEmpty(q.Service, defaultService))
+	v.Set("floor", defaultIfEmpty(q.Floor, defaultFloor))
+	setIfNotEmpty(v, "cid", q.CID)
+	setIfNotEmpty(v, "keyword", q.Keyword)
+	setIfNotEmpty(v, "sort", q.Sort)
+	setIfNotEmpty(v, "gte_date", q.GteDate)
+	setIfNotEmpty(v, "lte_date", q.LteDate)
+	if q.Hits > 0 {
+		v.Set("hits", strconv.Itoa(int(q.Hits)))
+	}
+	if q.Offset > 0 {
+		v.Set("offset", strconv.Itoa(int(q.Offset)))
+	}
+	for i, article := range q.Articles {
+		v.Set(fmt.Sprintf("article[%d]", i), string(article.Type))
+		v.Set(fmt.Sprintf("article_id[%d]", i), article.ID)
+	}
+	return v, nil
+}
+
+func setIfNotEmpty(v url.Values, key, value string) {
+	if value != "" {
+		v.Set(key, value)
+	}
+}
@@ -0,0 +1,114 @@
+package dmmapi
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemListQueryValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    ItemListQuery
+		expected url.Values
+	}{
+		{
+			name:  "未指定の場合は既定のフロアを使う",
+			query: ItemListQuery{},
+			expected: url.Values{
+				"site":    {"FANZA"},
+				"service": {"digital"},
+				"floor":   {"videoa"},
+			},
+		},
+		{
+			name: "指定した条件をすべて含める",
+			query: ItemListQuery{
+				Site:    "DMM.com",
+				Service: "mono",
+				Floor:   "dvd",
+				CID:     "abc123",
+				Keyword: "女優 名前&x=1",
+				Sort:    "-price",
+				GteDate: "2024-01-01T00:00:00",
+				LteDate: "2024-01-31T23:59:59",
+				Hits:    20,
+				Offset:  41,
+			},
+			expected: url.Values{
+				"site":     {"DMM.com"},
+				"service":  {"mono"},
+				"floor":    {"dvd"},
+				"cid":      {"abc123"},
+				"keyword":  {"女優 名前&x=1"},
+				"sort":     {"-price"},
+				"gte_date": {"2024-01-01T00:00:00"},
+				"lte_date": {"2024-01-31T23:59:59"},
+				"hits":     {"20"},
+				"offset":   {"41"},
+			},
+		},
+		{
+			name: "絞り込み条件は追加順に連番を振り、空の ID は無視する",
+			query: func() ItemListQuery {
+				var q ItemListQuery
+				q.AddArticles(ArticleActress, "1", "", "2")
+				q.AddArticles(ArticleGenre, "3")
+				q.AddArticles(ArticleDirector)
+				return q
+			}(),
+			expected: url.Values{
+				"site":          {"FANZA"},
+				"service":       {"digital"},
+				"floor":         {"videoa"},
+				"article[0]":    {"actress"},
+				"article_id[0]": {"1"},
+				"article[1]":    {"actress"},
+				"article_id[1]": {"2"},
+				"article[2]":    {"genre"},
+				"article_id[2]": {"3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := tt.query.Values()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestItemListQueryValuesEncoding(t *testing.T) {
+	values, err := ItemListQuery{Keyword: "巨乳 & 美少女"}.Values()
+	require.NoError(t, err)
+
+	encoded := values.Encode()
+	require.Contains(t, encoded, "keyword=%E5%B7%A8%E4%B9%B3+%26+%E7%BE%8E%E5%B0%91%E5%A5%B3")
+	decoded, err := url.ParseQuery(encoded)
+	require.NoError(t, err)
+	require.Equal(t, "巨乳 & 美少女", decoded.Get("keyword"))
+}
+
+func TestItemListQueryValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		query ItemListQuery
+	}{
+		{name: "不正なソート順", query: ItemListQuery{Sort: "newest"}},
+		{name: "hits が上限を超える", query: ItemListQuery{Hits: 101}},
+		{name: "hits が負数", query: ItemListQuery{Hits: -1}},
+		{name: "offset が負数", query: ItemListQuery{Offset: -1}},
+		{name: "日付の書式が不正", query: ItemListQuery{GteDate: "2024-01-01"}},
+		{name: "絞り込み種別が不正", query: ItemListQuery{Articles: []Article{{Type: "label", ID: "1"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.query.Values()
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package dmmapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// 分類ごとの DMM 検索 API のパス。
+var taxonomyPaths = map[model.TaxonomyKind]string{
+	model.TaxonomyGenre:  "/v3/GenreSearch",
+	model.TaxonomyMaker:  "/v3/MakerSearch",
+	model.TaxonomySeries: "/v3/SeriesSearch",
+}
+
+// defaultFloorID は floor_id 未指定時に使うフロア（FANZA 動画 videoa）の ID。
+const defaultFloorID = "43"
+
+// maxTaxonomyHits は 1 リクエストで取得できる最大件数。
+const maxTaxonomyHits = 500
+
+// TaxonomySearchQuery は GenreSearch・MakerSearch・SeriesSearch API の検索条件。
+// ゼロ値のフィールドはクエリに含めず、FloorID は未指定の場合に既定値を使う。
+type TaxonomySearchQuery struct {
+	Kind    model.TaxonomyKind
+	FloorID string
+	// Initial は名前の頭文字（ひらがな 1 文字）。
+	Initial string
+	Hits    int32
+	Offset  int32
+}
+
+// ListTaxonomyQuery は TaxonomyCatalog.ListTaxonomy が分類の検索 API に送る検索条件を返す。
+// キャッシュはこの条件をエンコードしたクエリをキーにするため、キーが同じ呼び出しは上流に同じクエリを送る。
+func ListTaxonomyQuery(kind model.TaxonomyKind, query model.TaxonomyQuery) TaxonomySearchQuery {
+	return TaxonomySearchQuery{
+		Kind:    kind,
+		FloorID: query.FloorID,
+		Initial: query.Initial,
+		Hits:    query.Hits,
+		Offset:  query.Offset,
+	}
+}
+
+// path は分類に対応する API のパスを返す。
+func (q TaxonomySearchQuery) path() string {
+	return taxonomyPaths[q.Kind]
+}
+
+// Validate は分類の種類や範囲を検証する。
+func (q TaxonomySearchQuery) Validate() error {
+	if _, ok := taxonomyPaths[q.Kind]; !ok {
+		return fmt.Errorf("不正な分類の種類です: %q", q.Kind)
+	}
+	if q.FloorID != "" {
+		if _, err := strconv.Atoi(q.FloorID); err != nil {
+			return fmt.Errorf("floor_id は数値で指定してください: %q", q.FloorID)
+		}
+	}
+	if q.Initial != "" && utf8.RuneCountInString(q.Initial) != 1 {
+		return fmt.Errorf("頭文字は 1 文字で指定してください: %q", q.Initial)
+	}
+	if q.Hits < 0 || q.Hits > maxTaxonomyHits {
+		return fmt.Errorf("hits は 0 以上 %d 以下で指定してください: %d", maxTaxonomyHits, q.Hits)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("offset は 0 以上で指定してください: %d", q.Offset)
+	}
+	return nil
+}
+
+// Values は検証済みの検索条件を url.Values に変換する。
+func (q TaxonomySearchQuery) Values() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("floor_id", defaultIfEmpty(q.FloorID, defaultFloorID))
+	setIfNotEmpty(v, "initial", q.Initial)
+	if q.Hits > 0 {
+		v.Set("hits", strconv.Itoa(int(q.Hits)))
+	}
+	if q.Offset > 0 {
+		v.Set("offset", strconv.Itoa(int(q.Offset)))
+	}
+	return v, nil
+}
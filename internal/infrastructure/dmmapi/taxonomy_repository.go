@@ -0,0 +1,52 @@
+package dmmapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// TaxonomyRepository は GenreSearch・MakerSearch・SeriesSearch API を使った port.TaxonomyCatalog の実装。
+type TaxonomyRepository struct {
+	client ClientInterface
+}
+
+// NewTaxonomyRepository は環境変数の設定で DMM API を呼び出す TaxonomyCatalog を返す。
+// クライアントは動画用の Repository と共有し、レート制限を API ID 単位で守る。
+func NewTaxonomyRepository() (port.TaxonomyCatalog, error) {
+	c, err := sharedClient()
+	if err != nil {
+		return nil, err
+	}
+	return &TaxonomyRepository{client: c}, nil
+}
+
+// NewTaxonomyRepositoryWithClient テストやモック注入用のコンストラクタ
+func NewTaxonomyRepositoryWithClient(client ClientInterface) port.TaxonomyCatalog {
+	return &TaxonomyRepository{client: client}
+}
+
+// ListTaxonomy は指定フロアの分類の一覧を取得する。該当なしの場合も件数情報は返す。
+func (r *TaxonomyRepository) ListTaxonomy(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error) {
+	search := ListTaxonomyQuery(kind, query)
+	values, err := search.Values()
+	if err != nil {
+		return nil, nil, invalidParameterError(err)
+	}
+
+	logger := logger.LoggerWithCtx(ctx)
+	logger.Debug("calling API", "path", search.path(), "query", values.Encode())
+	var resp TaxonomyResponse
+	if err := r.client.Call(ctx, search.path(), values, &resp); err != nil {
+		return nil, nil, classifyCallError(err)
+	}
+	if int(resp.Result.Status) != http.StatusOK {
+		return nil, nil, resultStatusError(int(resp.Result.Status), resp.Result.Message)
+	}
+
+	entries, metadata := ConvertTaxonomyFromDMM(kind, resp.Result)
+	return entries, metadata, nil
+}
@@ -0,0 +1,123 @@
+package dmmapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+)
+
+// taxonomyJSON は分類ごとの検索 API のレスポンス例。ID の項目名と一覧の項目名が API ごとに異なる。
+var taxonomyJSON = map[model.TaxonomyKind]string{
+	model.TaxonomyGenre: `{"result":{"status":"200","result_count":1,"total_count":"250","first_position":1,
+		"floor_id":"43","floor_name":"ビデオ",
+		"genre":[{"genre_id":"6003","name":"ドラマ","ruby":"どらま","list_url":"https://example.com/genre/6003"}]}}`,
+	model.TaxonomyMaker: `{"result":{"status":"200","result_count":1,"total_count":"1200","first_position":1,
+		"floor_id":"43","floor_name":"ビデオ",
+		"maker":[{"maker_id":"1509","name":"メーカーA","ruby":"めーかーえー","list_url":"https://example.com/maker/1509"}]}}`,
+	model.TaxonomySeries: `{"result":{"status":"200","result_count":1,"total_count":"30000","first_position":1,
+		"floor_id":"43","floor_name":"ビデオ",
+		"series":[{"series_id":77,"name":"シリーズA","ruby":"しりーずえー","list_url":"https://example.com/series/77"}]}}`,
+}
+
+func TestListTaxonomy(t *testing.T) {
+	tests := []struct {
+		kind     model.TaxonomyKind
+		path     string
+		expected model.TaxonomyEntry
+		total    int
+	}{
+		{
+			kind:     model.TaxonomyGenre,
+			path:     "/v3/GenreSearch",
+			expected: model.TaxonomyEntry{ID: "6003", Name: "ドラマ", Ruby: "どらま", ListURL: "https://example.com/genre/6003"},
+			total:    250,
+		},
+		{
+			kind:     model.TaxonomyMaker,
+			path:     "/v3/MakerSearch",
+			expected: model.TaxonomyEntry{ID: "1509", Name: "メーカーA", Ruby: "めーかーえー", ListURL: "https://example.com/maker/1509"},
+			total:    1200,
+		},
+		{
+			kind:     model.TaxonomySeries,
+			path:     "/v3/SeriesSearch",
+			expected: model.TaxonomyEntry{ID: "77", Name: "シリーズA", Ruby: "しりーずえー", ListURL: "https://example.com/series/77"},
+			total:    30000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockClientInterface(ctrl)
+			expectedQuery := url.Values{"floor_id": {"43"}, "initial": {"あ"}, "hits": {"100"}, "offset": {"101"}}
+			mockClient.EXPECT().
+				Call(gomock.Any(), tt.path, expectedQuery, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+					return json.Unmarshal([]byte(taxonomyJSON[tt.kind]), v)
+				})
+
+			repo := NewTaxonomyRepositoryWithClient(mockClient)
+			entries, metadata, err := repo.ListTaxonomy(context.Background(), tt.kind, model.TaxonomyQuery{
+				Initial: "あ",
+				Hits:    100,
+				Offset:  101,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []model.TaxonomyEntry{tt.expected}, entries)
+			require.Equal(t, tt.total, metadata.TotalCount)
+		})
+	}
+}
+
+func TestListTaxonomyInvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  model.TaxonomyKind
+		query model.TaxonomyQuery
+	}{
+		{name: "分類の種類が不正", kind: "label"},
+		{name: "floor_id が数値でない", kind: model.TaxonomyGenre, query: model.TaxonomyQuery{FloorID: "videoa"}},
+		{name: "頭文字が 2 文字以上", kind: model.TaxonomyGenre, query: model.TaxonomyQuery{Initial: "あい"}},
+		{name: "hits が上限を超える", kind: model.TaxonomyMaker, query: model.TaxonomyQuery{Hits: 501}},
+		{name: "offset が負数", kind: model.TaxonomySeries, query: model.TaxonomyQuery{Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := NewMockClientInterface(ctrl)
+			mockClient.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, _, err := NewTaxonomyRepositoryWithClient(mockClient).ListTaxonomy(context.Background(), tt.kind, tt.query)
+			require.ErrorIs(t, err, port.ErrCatalogInvalidParameter)
+		})
+	}
+}
+
+func TestListTaxonomyResultStatusError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := NewMockClientInterface(ctrl)
+	mockClient.EXPECT().
+		Call(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ url.Values, v interface{}) error {
+			return json.Unmarshal([]byte(`{"result":{"status":"400","message":"floor_id is invalid"}}`), v)
+		})
+
+	_, _, err := NewTaxonomyRepositoryWithClient(mockClient).ListTaxonomy(context.Background(), model.TaxonomyGenre, model.TaxonomyQuery{FloorID: "9999"})
+	require.ErrorIs(t, err, port.ErrCatalogUpstreamStatus)
+	require.ErrorIs(t, err, ErrAPIError)
+}
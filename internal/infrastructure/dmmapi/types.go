@@ -1,5 +1,11 @@
 package dmmapi
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
 // JSON レスポンス構造体
 
 type Genre struct {
@@ -29,42 +35,98 @@ type Series struct {
 
 // ... Maker, Series, Director も同様の構造体を定義
 
-type Item struct {
-	ContentID string `json:"content_id"`
+type Label struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ItemImageURL は商品画像の URL。list < small < large の順に大きい。
+type ItemImageURL struct {
+	List  string `json:"list"`
+	Small string `json:"small"`
+	Large string `json:"large"`
+}
+
+// SampleImages はサンプル画像の URL の一覧。
+type SampleImages struct {
+	Image []string `json:"image"`
+}
+
+// SampleImageURL はサンプル画像。sample_l（大きい画像）は返らない商品もある。
+type SampleImageURL struct {
+	SampleS *SampleImages `json:"sample_s,omitempty"`
+	SampleL *SampleImages `json:"sample_l,omitempty"`
+}
+
+// SampleMovieURL はサイズ別のサンプル動画の URL。
+type SampleMovieURL struct {
+	Size476306 string `json:"size_476_306"`
+	Size560360 string `json:"size_560_360"`
+	Size644414 string `json:"size_644_414"`
+	Size720480 string `json:"size_720_480"`
+}
+
+// Delivery は配信形式（stream・download・hd など）ごとの価格。価格は "980" のような文字列で返る。
+type Delivery struct {
+	Type      string `json:"type"`
+	Price     string `json:"price"`
+	ListPrice string `json:"list_price"`
+}
+
+type Deliveries struct {
+	Delivery []Delivery `json:"delivery"`
+}
+
+// ItemPrices は商品の価格。price・list_price は "300~" のように最安値の範囲で返ることがある。
+type ItemPrices struct {
+	Price      string      `json:"price,omitempty"`
+	ListPrice  string      `json:"list_price,omitempty"`
+	Deliveries *Deliveries `json:"deliveries,omitempty"`
+}
+
+type ItemReview struct {
+	Count   int    `json:"count"`
+	Average string `json:"average"` // JSONではstringとして返ってくる
+}
+
+// Campaign は商品に適用されているキャンペーン。日時は "2006-01-02 15:04:05" 形式。
+type Campaign struct {
+	DateBegin string `json:"date_begin"`
+	DateEnd   string `json:"date_end"`
 	Title     string `json:"title"`
-	Date      string `json:"date"`
-	URL       string `json:"URL"`
-	ImageURL  struct {
-		Large string `json:"large"`
-	} `json:"imageURL"`
-	SampleMovieURL *struct {
-		Size720480 string `json:"size_720_480"`
-	} `json:"sampleMovieURL,omitempty"`
-	Prices struct {
-		Price      string `json:"price,omitempty"`
-		Deliveries *struct {
-			Delivery []struct {
-				Type      string `json:"type"`
-				Price     string `json:"price"`
-				ListPrice string `json:"list_price"`
-			}
-		} `json:"deliveries,omitempty"`
-	} `json:"prices"`
-	Review *struct {
-		Count   int    `json:"count"`
-		Average string `json:"average"` // JSONではstringとして返ってくる
-	} `json:"review,omitempty"`
-	ItemInfo struct {
-		Actress  []Actress  `json:"actress,omitempty"`
-		Genre    []Genre    `json:"genre,omitempty"`
-		Maker    []Maker    `json:"maker,omitempty"`
-		Series   []Series   `json:"series,omitempty"`
-		Director []Director `json:"director,omitempty"`
-	} `json:"iteminfo"`
+}
+
+type ItemInfo struct {
+	Actress  []Actress  `json:"actress,omitempty"`
+	Genre    []Genre    `json:"genre,omitempty"`
+	Maker    []Maker    `json:"maker,omitempty"`
+	Series   []Series   `json:"series,omitempty"`
+	Director []Director `json:"director,omitempty"`
+	Label    []Label    `json:"label,omitempty"`
+}
+
+// Item は ItemList API の商品。
+// volume は収録時間で、フロアによって "120"（分）や "02:00:00" の形式で返る。
+type Item struct {
+	ContentID      string          `json:"content_id"`
+	ProductID      string          `json:"product_id"`
+	MakerProduct   string          `json:"maker_product"`
+	Title          string          `json:"title"`
+	Volume         flexString      `json:"volume"`
+	Date           string          `json:"date"`
+	URL            string          `json:"URL"`
+	ImageURL       ItemImageURL    `json:"imageURL"`
+	SampleImageURL *SampleImageURL `json:"sampleImageURL,omitempty"`
+	SampleMovieURL *SampleMovieURL `json:"sampleMovieURL,omitempty"`
+	Prices         ItemPrices      `json:"prices"`
+	Review         *ItemReview     `json:"review,omitempty"`
+	ItemInfo       ItemInfo        `json:"iteminfo"`
+	Campaign       []Campaign      `json:"campaign,omitempty"`
 }
 
 type Result struct {
 	Status        int    `json:"status"`
+	Message       string `json:"message,omitempty"`
 	ResultCount   int    `json:"result_count"`
 	TotalCount    int    `json:"total_count"`
 	FirstPosition int    `json:"first_position"`
@@ -74,3 +136,145 @@ type Result struct {
 type Response struct {
 	Result Result `json:"result"`
 }
+
+// ActressItem は ActressSearch API の女優情報。
+// 数値の項目も文字列で返り、未登録の項目は null になる。
+type ActressItem struct {
+	ID          flexString `json:"id"`
+	Name        string     `json:"name"`
+	Ruby        string     `json:"ruby"`
+	Bust        flexString `json:"bust"`
+	Cup         flexString `json:"cup"`
+	Waist       flexString `json:"waist"`
+	Hip         flexString `json:"hip"`
+	Height      flexString `json:"height"`
+	Birthday    flexString `json:"birthday"`
+	BloodType   flexString `json:"blood_type"`
+	Hobby       flexString `json:"hobby"`
+	Prefectures flexString `json:"prefectures"`
+	ImageURL    *struct {
+		Small string `json:"small"`
+		Large string `json:"large"`
+	} `json:"imageURL,omitempty"`
+	ListURL *struct {
+		Digital string `json:"digital"`
+		Monthly string `json:"monthly"`
+		Mono    string `json:"mono"`
+	} `json:"listURL,omitempty"`
+}
+
+// ActressResult は ActressSearch API の結果。ItemList と異なり status や件数が文字列で返ることがある。
+type ActressResult struct {
+	Status        flexInt       `json:"status"`
+	Message       string        `json:"message,omitempty"`
+	ResultCount   flexInt       `json:"result_count"`
+	TotalCount    flexInt       `json:"total_count"`
+	FirstPosition flexInt       `json:"first_position"`
+	Actresses     []ActressItem `json:"actress"`
+}
+
+type ActressResponse struct {
+	Result ActressResult `json:"result"`
+}
+
+// TaxonomyItem は GenreSearch・MakerSearch・SeriesSearch API の 1 件。
+// ID の項目名は API ごとに異なるため、それぞれのフィールドで受け取る。
+type TaxonomyItem struct {
+	GenreID  flexString `json:"genre_id"`
+	MakerID  flexString `json:"maker_id"`
+	SeriesID flexString `json:"series_id"`
+	Name     string     `json:"name"`
+	Ruby     string     `json:"ruby"`
+	ListURL  string     `json:"list_url"`
+}
+
+// TaxonomyResult は GenreSearch・MakerSearch・SeriesSearch API の結果。一覧の項目名も API ごとに異なる。
+type TaxonomyResult struct {
+	Status        flexInt        `json:"status"`
+	Message       string         `json:"message,omitempty"`
+	ResultCount   flexInt        `json:"result_count"`
+	TotalCount    flexInt        `json:"total_count"`
+	FirstPosition flexInt        `json:"first_position"`
+	FloorID       flexString     `json:"floor_id"`
+	FloorName     string         `json:"floor_name"`
+	Genres        []TaxonomyItem `json:"genre"`
+	Makers        []TaxonomyItem `json:"maker"`
+	Series        []TaxonomyItem `json:"series"`
+}
+
+type TaxonomyResponse struct {
+	Result TaxonomyResult `json:"result"`
+}
+
+// FloorListFloor は FloorList API のフロア。
+type FloorListFloor struct {
+	ID   flexString `json:"id"`
+	Name string     `json:"name"`
+	Code string     `json:"code"`
+}
+
+// FloorListService は FloorList API のサービスと配下のフロア。
+type FloorListService struct {
+	Name   string           `json:"name"`
+	Code   string           `json:"code"`
+	Floors []FloorListFloor `json:"floor"`
+}
+
+// FloorListSite は FloorList API のサイトと配下のサービス。
+type FloorListSite struct {
+	Name     string             `json:"name"`
+	Code     string             `json:"code"`
+	Services []FloorListService `json:"service"`
+}
+
+// FloorListResult は FloorList API の結果。status は返らないことがあり、その場合は 0 になる。
+type FloorListResult struct {
+	Status  flexInt         `json:"status"`
+	Message string          `json:"message"`
+	Sites   []FloorListSite `json:"site"`
+}
+
+type FloorListResponse struct {
+	Result FloorListResult `json:"result"`
+}
+
+// flexString は文字列・数値・null のいずれかで返る項目を文字列として受け取る。null は空文字列にする。
+type flexString string
+
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = ""
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = flexString(str)
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	*s = flexString(num.String())
+	return nil
+}
+
+// flexInt は数値または数値の文字列で返る項目を受け取る。null と空文字列は 0 にする。
+type flexInt int
+
+func (n *flexInt) UnmarshalJSON(data []byte) error {
+	var s flexString
+	if err := s.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if s == "" {
+		*n = 0
+		return nil
+	}
+	v, err := strconv.Atoi(string(s))
+	if err != nil {
+		return fmt.Errorf("invalid integer %q: %w", s, err)
+	}
+	*n = flexInt(v)
+	return nil
+}
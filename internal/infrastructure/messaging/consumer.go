@@ -0,0 +1,180 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+const (
+	defaultMaxAttempts     = 5
+	defaultRetryBaseDelay  = 500 * time.Millisecond
+	defaultRetryMaxDelay   = 30 * time.Second
+	defaultShutdownTimeout = 10 * time.Second
+)
+
+// Reader はコンシューマーグループとしてメッセージを受信する。*kafka.Reader と MemoryReader が実装する。
+type Reader interface {
+	// FetchMessage は次のメッセージを返す。オフセットはコミットしない。
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	// CommitMessages は msgs までのオフセットをコミットする。
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// ConsumerConfig は Consumer の再試行と停止の設定。0 の項目は既定値を使う。
+type ConsumerConfig struct {
+	// MaxAttempts は 1 メッセージあたりの最大処理回数。超えたメッセージはログに残してスキップする。
+	MaxAttempts int
+	// RetryBaseDelay と RetryMaxDelay は再試行間隔（上限付き指数バックオフ + ジッター）。
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// ShutdownTimeout は停止要求後に処理中のメッセージの完了を待つ時間。
+	ShutdownTimeout time.Duration
+}
+
+// Consumer は Reader から受信したイベントを Registry の Handler に振り分ける。
+// オフセットはすべての Handler が成功した後にコミットするため、処理中に停止したメッセージは再配信される。
+type Consumer struct {
+	reader   Reader
+	registry *Registry
+	config   ConsumerConfig
+	logger   *slog.Logger
+
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewConsumer は新しい Consumer を返す。
+func NewConsumer(reader Reader, registry *Registry, config ConsumerConfig) *Consumer {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryBaseDelay <= 0 {
+		config.RetryBaseDelay = defaultRetryBaseDelay
+	}
+	if config.RetryMaxDelay <= 0 {
+		config.RetryMaxDelay = defaultRetryMaxDelay
+	}
+	if config.ShutdownTimeout <= 0 {
+		config.ShutdownTimeout = defaultShutdownTimeout
+	}
+	return &Consumer{
+		reader:   reader,
+		registry: registry,
+		config:   config,
+		logger:   slog.Default().With(slog.String("component", "event_consumer")),
+		sleep:    sleepCtx,
+	}
+}
+
+// Run は ctx がキャンセルされるまでメッセージを処理し、Reader を閉じて戻る。
+// キャンセル後も処理中のメッセージは ShutdownTimeout まで処理を続け、完了すればコミットする。
+func (c *Consumer) Run(ctx context.Context) error {
+	// 処理とコミットは停止要求から ShutdownTimeout 後にキャンセルする
+	handleCtx, cancelHandle := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandle()
+	stop := context.AfterFunc(ctx, func() {
+		time.AfterFunc(c.config.ShutdownTimeout, cancelHandle)
+	})
+	defer stop()
+
+	err := c.consume(ctx, handleCtx)
+	if closeErr := c.reader.Close(); closeErr != nil {
+		c.logger.Warn("failed to close reader", "error", closeErr)
+	}
+	if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (c *Consumer) consume(ctx, handleCtx context.Context) error {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+		if err := c.process(handleCtx, msg); err != nil {
+			// 停止によって処理を打ち切った。コミットしないので次回起動時に再配信される
+			return err
+		}
+		if err := c.reader.CommitMessages(handleCtx, msg); err != nil {
+			return fmt.Errorf("commit offset %d of partition %d: %w", msg.Offset, msg.Partition, err)
+		}
+	}
+}
+
+// process はメッセージを Handler に渡す。失敗時は再試行し、MaxAttempts 回失敗したメッセージや
+// 解釈できないメッセージはスキップする。ctx がキャンセルされた場合のみエラーを返す。
+func (c *Consumer) process(ctx context.Context, msg kafka.Message) error {
+	log := c.logger.With("partition", msg.Partition, "offset", msg.Offset)
+
+	var e entity.EventLog
+	if err := json.Unmarshal(msg.Value, &e); err != nil {
+		log.Error("skipping malformed event", "error", err)
+		return nil
+	}
+	handlers := c.registry.handlersFor(e.EventType)
+	if len(handlers) == 0 {
+		return nil
+	}
+	log = log.With("event_log_id", e.EventLogID, "event_type", e.EventType)
+
+	for attempt := 1; ; attempt++ {
+		err := handle(ctx, handlers, &e)
+		if err == nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if attempt >= c.config.MaxAttempts {
+			log.Error("dropping event after repeated failures", "attempts", attempt, "error", err)
+			return nil
+		}
+		log.Warn("event handler failed, retrying", "attempt", attempt, "error", err)
+		if err := c.sleep(ctx, c.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// handle はすべての Handler を呼び出す。失敗した場合はメッセージ全体を再試行するため、成功済みの Handler も再度呼ばれる。
+func handle(ctx context.Context, handlers []Handler, e *entity.EventLog) error {
+	var errs []error
+	for _, h := range handlers {
+		if err := h.HandleEvent(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff は attempt 回目の失敗後に待機する時間を返す（上限付き指数バックオフ + ジッター）。
+func (c *Consumer) backoff(attempt int) time.Duration {
+	d := c.config.RetryBaseDelay << (attempt - 1)
+	if d > c.config.RetryMaxDelay || d <= 0 {
+		d = c.config.RetryMaxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
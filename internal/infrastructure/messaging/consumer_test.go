@@ -0,0 +1,166 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+const testGroup = "test-worker"
+
+func publish(t *testing.T, broker *MemoryBroker, events ...*entity.EventLog) {
+	t.Helper()
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		require.NoError(t, err)
+		require.NoError(t, broker.WriteMessages(context.Background(), kafka.Message{Key: []byte(e.EventLogID), Value: payload}))
+	}
+}
+
+// recorder は受け取ったイベント ID を記録する Handler。
+type recorder struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *recorder) HandleEvent(ctx context.Context, e *entity.EventLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, e.EventLogID)
+	return nil
+}
+
+func (r *recorder) seen() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.ids...)
+}
+
+// runConsumer は Consumer をバックグラウンドで起動し、停止して Run の戻り値を返す関数を返す。
+func runConsumer(c *Consumer) (stop func() error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- c.Run(ctx) }()
+	return func() error {
+		cancel()
+		return <-done
+	}
+}
+
+func newTestConsumer(broker *MemoryBroker, registry *Registry, config ConsumerConfig) *Consumer {
+	c := NewConsumer(broker.Reader(testGroup), registry, config)
+	c.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return c
+}
+
+func TestConsumer_DispatchesByEventTypeAndCommits(t *testing.T) {
+	broker := NewMemoryBroker()
+	playback, all := &recorder{}, &recorder{}
+	registry := NewRegistry()
+	registry.Register(playback, "start", "pause")
+	registry.Register(all)
+
+	stop := runConsumer(newTestConsumer(broker, registry, ConsumerConfig{}))
+	publish(t, broker,
+		&entity.EventLog{EventLogID: "e1", EventType: "start"},
+		&entity.EventLog{EventLogID: "e2", EventType: "like"},
+		&entity.EventLog{EventLogID: "e3", EventType: "pause"},
+	)
+	require.NoError(t, broker.WriteMessages(context.Background(), kafka.Message{Value: []byte("not json")}))
+
+	require.Eventually(t, func() bool { return broker.Committed(testGroup) == 4 }, time.Second, time.Millisecond)
+	require.NoError(t, stop())
+	require.Equal(t, []string{"e1", "e3"}, playback.seen())
+	require.Equal(t, []string{"e1", "e2", "e3"}, all.seen())
+}
+
+func TestConsumer_RetriesAndDropsAfterMaxAttempts(t *testing.T) {
+	broker := NewMemoryBroker()
+	attempts := map[string]int{}
+	registry := NewRegistry()
+	registry.Register(HandlerFunc(func(ctx context.Context, e *entity.EventLog) error {
+		attempts[e.EventLogID]++
+		if e.EventLogID == "flaky" && attempts[e.EventLogID] < 2 {
+			return errors.New("temporary failure")
+		}
+		if e.EventLogID == "poison" {
+			return errors.New("permanent failure")
+		}
+		return nil
+	}))
+
+	publish(t, broker,
+		&entity.EventLog{EventLogID: "flaky", EventType: "start"},
+		&entity.EventLog{EventLogID: "poison", EventType: "start"},
+		&entity.EventLog{EventLogID: "ok", EventType: "start"},
+	)
+	stop := runConsumer(newTestConsumer(broker, registry, ConsumerConfig{MaxAttempts: 3}))
+	require.Eventually(t, func() bool { return broker.Committed(testGroup) == 3 }, time.Second, time.Millisecond)
+	require.NoError(t, stop())
+	require.Equal(t, map[string]int{"flaky": 2, "poison": 3, "ok": 1}, attempts)
+}
+
+func TestConsumer_GracefulShutdown(t *testing.T) {
+	broker := NewMemoryBroker()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	registry := NewRegistry()
+	registry.Register(HandlerFunc(func(ctx context.Context, e *entity.EventLog) error {
+		if e.EventLogID != "slow" {
+			return nil
+		}
+		close(started)
+		select {
+		case <-release:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}))
+
+	publish(t, broker, &entity.EventLog{EventLogID: "slow", EventType: "start"})
+	stop := runConsumer(newTestConsumer(broker, registry, ConsumerConfig{ShutdownTimeout: time.Minute}))
+	<-started
+
+	// 停止要求後も処理中のメッセージは完了まで待ち、コミットされる
+	stopped := make(chan error, 1)
+	go func() { stopped <- stop() }()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	require.NoError(t, <-stopped)
+	require.EqualValues(t, 1, broker.Committed(testGroup))
+}
+
+func TestConsumer_ShutdownTimeoutLeavesMessageUncommitted(t *testing.T) {
+	broker := NewMemoryBroker()
+	started := make(chan struct{})
+	registry := NewRegistry()
+	registry.Register(HandlerFunc(func(ctx context.Context, e *entity.EventLog) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	publish(t, broker, &entity.EventLog{EventLogID: "stuck", EventType: "start"})
+	stop := runConsumer(newTestConsumer(broker, registry, ConsumerConfig{ShutdownTimeout: 10 * time.Millisecond}))
+	<-started
+	require.NoError(t, stop())
+	require.Zero(t, broker.Committed(testGroup))
+
+	// 再起動したコンシューマーには未コミットのメッセージが再配信される
+	redelivered := &recorder{}
+	registry = NewRegistry()
+	registry.Register(redelivered)
+	stop = runConsumer(newTestConsumer(broker, registry, ConsumerConfig{}))
+	require.Eventually(t, func() bool { return broker.Committed(testGroup) == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, stop())
+	require.Equal(t, []string{"stuck"}, redelivered.seen())
+}
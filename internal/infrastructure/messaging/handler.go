@@ -0,0 +1,50 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// Handler は Kafka から受信したイベントを処理する。
+// 同じイベントが再配信されることがある（at-least-once）ため、冪等に実装する。
+type Handler interface {
+	HandleEvent(ctx context.Context, e *entity.EventLog) error
+}
+
+// HandlerFunc は関数を Handler として扱うためのアダプタ。
+type HandlerFunc func(ctx context.Context, e *entity.EventLog) error
+
+// HandleEvent は f(ctx, e) を呼び出す。
+func (f HandlerFunc) HandleEvent(ctx context.Context, e *entity.EventLog) error {
+	return f(ctx, e)
+}
+
+// Registry は event_type ごとに Handler を登録する。
+type Registry struct {
+	byType map[string][]Handler
+	all    []Handler
+}
+
+// NewRegistry は空の Registry を返す。
+func NewRegistry() *Registry {
+	return &Registry{byType: make(map[string][]Handler)}
+}
+
+// Register は eventTypes のイベントを処理する Handler を登録する。eventTypes を省略するとすべてのイベントを受け取る。
+func (r *Registry) Register(h Handler, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		r.all = append(r.all, h)
+		return
+	}
+	for _, t := range eventTypes {
+		r.byType[t] = append(r.byType[t], h)
+	}
+}
+
+// handlersFor は eventType のイベントを処理する Handler を登録順に返す。
+func (r *Registry) handlersFor(eventType string) []Handler {
+	handlers := make([]Handler, 0, len(r.all)+len(r.byType[eventType]))
+	handlers = append(handlers, r.all...)
+	return append(handlers, r.byType[eventType]...)
+}
@@ -0,0 +1,136 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// ErrReaderClosed は閉じた MemoryReader から受信しようとした場合に返す。
+var ErrReaderClosed = errors.New("reader closed")
+
+// MemoryBroker はテスト用のインメモリなブローカー。1 トピック・1 パーティションを模し、
+// コンシューマーグループごとにコミット済みオフセットを保持する。
+type MemoryBroker struct {
+	mu        sync.Mutex
+	messages  []kafka.Message
+	committed map[string]int64
+	// notify は新しいメッセージが書き込まれるたびに閉じて作り直す
+	notify chan struct{}
+}
+
+// NewMemoryBroker は空の MemoryBroker を返す。
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		committed: make(map[string]int64),
+		notify:    make(chan struct{}),
+	}
+}
+
+// WriteMessages はメッセージを末尾に追加する。*kafka.Writer と同じシグネチャを持つ。
+func (b *MemoryBroker) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, msg := range msgs {
+		msg.Offset = int64(len(b.messages))
+		if msg.Time.IsZero() {
+			msg.Time = time.Now()
+		}
+		b.messages = append(b.messages, msg)
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+	return nil
+}
+
+// Messages は書き込まれたメッセージを書き込み順に返す。
+func (b *MemoryBroker) Messages() []kafka.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]kafka.Message(nil), b.messages...)
+}
+
+// Committed は groupID のコミット済みオフセット（次に受信するオフセット）を返す。
+func (b *MemoryBroker) Committed(groupID string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.committed[groupID]
+}
+
+// Reader は groupID のコミット済みオフセットから受信を始める MemoryReader を返す。
+func (b *MemoryBroker) Reader(groupID string) *MemoryReader {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &MemoryReader{broker: b, groupID: groupID, next: b.committed[groupID], closed: make(chan struct{})}
+}
+
+// MemoryReader は MemoryBroker のコンシューマー。Reader を実装する。
+type MemoryReader struct {
+	broker    *MemoryBroker
+	groupID   string
+	next      int64
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// FetchMessage は次のメッセージを返す。メッセージがなければ書き込まれるまで待つ。
+func (r *MemoryReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	for {
+		select {
+		case <-r.closed:
+			return kafka.Message{}, ErrReaderClosed
+		default:
+		}
+		r.broker.mu.Lock()
+		if r.next < int64(len(r.broker.messages)) {
+			msg := r.broker.messages[r.next]
+			r.next++
+			r.broker.mu.Unlock()
+			return msg, nil
+		}
+		notify := r.broker.notify
+		r.broker.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		case <-r.closed:
+			return kafka.Message{}, ErrReaderClosed
+		case <-notify:
+		}
+	}
+}
+
+// CommitMessages は msgs のうち最大のオフセットの次をグループのコミット済みオフセットにする。
+func (r *MemoryReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.broker.mu.Lock()
+	defer r.broker.mu.Unlock()
+
+	for _, msg := range msgs {
+		if msg.Offset+1 > r.broker.committed[r.groupID] {
+			r.broker.committed[r.groupID] = msg.Offset + 1
+		}
+	}
+	return nil
+}
+
+// Close は受信待ちを解除し、以降の FetchMessage を失敗させる。
+func (r *MemoryReader) Close() error {
+	r.closeOnce.Do(func() { close(r.closed) })
+	return nil
+}
+
+var (
+	_ Reader = (*kafka.Reader)(nil)
+	_ Reader = (*MemoryReader)(nil)
+)
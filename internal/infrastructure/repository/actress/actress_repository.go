@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/infrastructure/dmmapi"
+)
+
+// NewActressRepository は ActressCatalog ポートの実装を返す。
+func NewActressRepository() (port.ActressCatalog, error) {
+	return dmmapi.NewActressRepository()
+}
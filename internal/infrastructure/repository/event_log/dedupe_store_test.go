@@ -0,0 +1,69 @@
+package event_log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+func TestMemoryDedupeStore_Claim(t *testing.T) {
+	store := NewMemoryDedupeStore(time.Hour, 0)
+	ctx := context.Background()
+
+	seen, err := store.Claim(ctx, []string{"a", "b", "a"})
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, true}, seen)
+
+	seen, err = store.Claim(ctx, []string{"a", "c"})
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false}, seen)
+
+	// released keys can be claimed again
+	require.NoError(t, store.Release(ctx, []string{"c"}))
+	seen, err = store.Claim(ctx, []string{"c"})
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, seen)
+}
+
+func TestMemoryDedupeStore_ForgetsExpiredAndOldestKeys(t *testing.T) {
+	ctx := context.Background()
+
+	// keys are forgotten once the window has passed
+	store := NewMemoryDedupeStore(10*time.Millisecond, 0)
+	_, err := store.Claim(ctx, []string{"a"})
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	seen, err := store.Claim(ctx, []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, seen)
+
+	// at most maxKeys are remembered, whatever clients send
+	store = NewMemoryDedupeStore(time.Hour, 2)
+	_, err = store.Claim(ctx, []string{"a", "b", "c"})
+	require.NoError(t, err)
+	seen, err = store.Claim(ctx, []string{"c", "a"})
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false}, seen)
+}
+
+func TestEventLogMessage_KeyedByDedupeKey(t *testing.T) {
+	e := &entity.EventLog{EventLogID: "id-1", DeviceID: "device-1", EventTime: time.Unix(0, 0)}
+	msg, err := eventLogMessage(e)
+	require.NoError(t, err)
+	require.Equal(t, "id-1:"+e.EventTime.String(), string(msg.Key))
+
+	// copies of a resent event share the key, whatever ID the backend assigned
+	e.IdempotencyKey = "k1"
+	msg, err = eventLogMessage(e)
+	require.NoError(t, err)
+	require.Equal(t, "device:device-1:k1", string(msg.Key))
+
+	e.UserID = "5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31"
+	msg, err = eventLogMessage(e)
+	require.NoError(t, err)
+	require.Equal(t, "user:5f1c3a52-8d0e-4b8f-9d7a-2c6e1f0b7a31:k1", string(msg.Key))
+}
@@ -23,14 +23,10 @@ func NewKafkaEventLogRepository(writer *kafka.Writer) repo.EventLogRepository {
 
 // InsertEventLog publishes a single EventLog to the Kafka topic.
 func (k *KafkaEventLogRepository) InsertEventLog(ctx context.Context, e *entity.EventLog) error {
-	payload, err := json.Marshal(e)
+	msg, err := eventLogMessage(e)
 	if err != nil {
 		return err
 	}
-	msg := kafka.Message{
-		Key:   []byte(e.EventLogID + ":" + e.EventTime.String()),
-		Value: payload,
-	}
 	return k.writer.WriteMessages(ctx, msg)
 }
 
@@ -39,14 +35,29 @@ func (k *KafkaEventLogRepository) InsertEventLogs(ctx context.Context, events []
 	log.Println("InsertEventLogs", events)
 	msgs := make([]kafka.Message, len(events))
 	for i, e := range events {
-		payload, err := json.Marshal(e)
+		msg, err := eventLogMessage(e)
 		if err != nil {
 			return err
 		}
-		msgs[i] = kafka.Message{
-			Key:   []byte(e.EventLogID + ":" + e.EventTime.String()),
-			Value: payload,
-		}
+		msgs[i] = msg
 	}
 	return k.writer.WriteMessages(ctx, msgs...)
+}
+
+// eventLogMessage encodes an EventLog as the Kafka message consumers of the event-logs topic expect.
+// Events sent with an idempotency key are keyed by their dedupe key, so that consumers can drop
+// copies that reach the topic more than once.
+func eventLogMessage(e *entity.EventLog) (kafka.Message, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+	key := e.DedupeKey()
+	if key == "" {
+		key = e.EventLogID + ":" + e.EventTime.String()
+	}
+	return kafka.Message{
+		Key:   []byte(key),
+		Value: payload,
+	}, nil
 }
\ No newline at end of file
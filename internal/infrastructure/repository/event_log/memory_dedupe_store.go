@@ -0,0 +1,86 @@
+package event_log
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	repo "github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+)
+
+const (
+	// DefaultDedupeMaxKeys bounds the keys a MemoryDedupeStore remembers.
+	DefaultDedupeMaxKeys = 100_000
+	// dedupeSweepInterval is how often expired keys are dropped from memory.
+	dedupeSweepInterval = time.Minute
+)
+
+// MemoryDedupeStore keeps dedupe keys in an LRU until their window expires.
+// Keys are not shared between server instances and are lost on restart. Since clients choose
+// their keys, at most maxKeys are remembered: beyond that the least recently seen keys are
+// forgotten early and their retries are recorded again.
+type MemoryDedupeStore struct {
+	// mu makes the lookup and insert of a key atomic across concurrent claims.
+	mu        sync.Mutex
+	keys      *cache.LRU
+	window    time.Duration
+	nextSweep time.Time
+}
+
+// NewMemoryDedupeStore creates a MemoryDedupeStore remembering up to maxKeys keys for window.
+// A non-positive maxKeys uses DefaultDedupeMaxKeys.
+func NewMemoryDedupeStore(window time.Duration, maxKeys int) *MemoryDedupeStore {
+	if maxKeys <= 0 {
+		maxKeys = DefaultDedupeMaxKeys
+	}
+	return &MemoryDedupeStore{keys: cache.NewLRU(maxKeys), window: window}
+}
+
+// Claim marks keys as seen and reports which of them had already been seen within the window.
+func (s *MemoryDedupeStore) Claim(ctx context.Context, keys []string) ([]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	seen := make([]bool, len(keys))
+	for i, key := range keys {
+		_, found, err := s.keys.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			seen[i] = true
+			continue
+		}
+		if err := s.keys.Set(ctx, key, nil, s.window); err != nil {
+			return nil, err
+		}
+	}
+	return seen, nil
+}
+
+// Release forgets claimed keys.
+func (s *MemoryDedupeStore) Release(ctx context.Context, keys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		if err := s.keys.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sweep drops expired keys at most once per dedupeSweepInterval. The caller must hold mu.
+func (s *MemoryDedupeStore) sweep() {
+	now := time.Now()
+	if now.Before(s.nextSweep) {
+		return
+	}
+	s.keys.RemoveExpired()
+	s.nextSweep = now.Add(dedupeSweepInterval)
+}
+
+// ensure interface compliance
+var _ repo.EventDedupeStore = (*MemoryDedupeStore)(nil)
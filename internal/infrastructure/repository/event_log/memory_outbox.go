@@ -0,0 +1,148 @@
+package event_log
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	repo "github.com/tikfack/server/internal/domain/repository"
+)
+
+// memoryOutboxRow is a message in MemoryOutbox with its delivery state.
+type memoryOutboxRow struct {
+	OutboxMessage
+	nextAttemptAt time.Time
+	deliveredAt   time.Time
+	lastError     string
+}
+
+// MemoryOutbox provides in-memory storage for the event log outbox.
+type MemoryOutbox struct {
+	mu     sync.Mutex
+	rows   []*memoryOutboxRow
+	nextID int64
+	now    func() time.Time
+}
+
+// NewMemoryOutbox constructs a new outbox instance.
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{now: time.Now}
+}
+
+// InsertEventLog stores a single EventLog in the outbox.
+func (o *MemoryOutbox) InsertEventLog(ctx context.Context, e *entity.EventLog) error {
+	return o.InsertEventLogs(ctx, []*entity.EventLog{e})
+}
+
+// InsertEventLogs stores multiple EventLogs in the outbox; either all or none are stored.
+func (o *MemoryOutbox) InsertEventLogs(ctx context.Context, events []*entity.EventLog) error {
+	rows := make([]*memoryOutboxRow, len(events))
+	for i, e := range events {
+		msg, err := eventLogMessage(e)
+		if err != nil {
+			return err
+		}
+		rows[i] = &memoryOutboxRow{OutboxMessage: OutboxMessage{Message: msg}}
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, row := range rows {
+		o.nextID++
+		row.ID = o.nextID
+		row.nextAttemptAt = o.now()
+		o.rows = append(o.rows, row)
+	}
+	return nil
+}
+
+// Claim returns due messages, oldest first, and hides them for lease.
+func (o *MemoryOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxMessage, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := o.now()
+	var claimed []OutboxMessage
+	for _, row := range o.rows {
+		if len(claimed) >= limit {
+			break
+		}
+		if !row.deliveredAt.IsZero() || row.nextAttemptAt.After(now) {
+			continue
+		}
+		row.nextAttemptAt = now.Add(lease)
+		claimed = append(claimed, row.OutboxMessage)
+	}
+	return claimed, nil
+}
+
+// MarkDelivered records that the messages were published.
+func (o *MemoryOutbox) MarkDelivered(ctx context.Context, ids []int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delivered := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		delivered[id] = true
+	}
+	for _, row := range o.rows {
+		if delivered[row.ID] {
+			row.deliveredAt = o.now()
+		}
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt and schedules the next one.
+func (o *MemoryOutbox) MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, cause error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, row := range o.rows {
+		if row.ID == id {
+			row.Attempts++
+			row.nextAttemptAt = nextAttemptAt
+			row.lastError = cause.Error()
+		}
+	}
+	return nil
+}
+
+// DeleteDelivered removes delivered messages older than before.
+func (o *MemoryOutbox) DeleteDelivered(ctx context.Context, before time.Time, limit int) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	kept := o.rows[:0]
+	removed := 0
+	for _, row := range o.rows {
+		if removed < limit && !row.deliveredAt.IsZero() && row.deliveredAt.Before(before) {
+			removed++
+			continue
+		}
+		kept = append(kept, row)
+	}
+	o.rows = kept
+	return removed, nil
+}
+
+// Pending returns the number of messages not yet delivered.
+func (o *MemoryOutbox) Pending() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	pending := 0
+	for _, row := range o.rows {
+		if row.deliveredAt.IsZero() {
+			pending++
+		}
+	}
+	return pending
+}
+
+// ensure interface compliance
+var (
+	_ repo.EventLogRepository = (*MemoryOutbox)(nil)
+	_ OutboxStore             = (*MemoryOutbox)(nil)
+)
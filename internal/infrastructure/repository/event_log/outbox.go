@@ -0,0 +1,33 @@
+package event_log
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// OutboxMessage is a Kafka message waiting in the outbox.
+type OutboxMessage struct {
+	ID      int64
+	Message kafka.Message
+	// Attempts is the number of failed delivery attempts so far.
+	Attempts int
+}
+
+// OutboxStore holds event log messages until the relay has delivered them to Kafka.
+type OutboxStore interface {
+	// Claim returns up to limit undelivered messages that are due, oldest first, and hides them from
+	// other relays for lease so that a crashed relay's messages are retried once the lease expires.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxMessage, error)
+	MarkDelivered(ctx context.Context, ids []int64) error
+	// MarkFailed records a failed attempt and schedules the next one.
+	MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, cause error) error
+	// DeleteDelivered removes up to limit messages delivered before the given time and returns how many were removed.
+	DeleteDelivered(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// MessageWriter publishes messages to Kafka. *kafka.Writer implements it.
+type MessageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
@@ -0,0 +1,183 @@
+package event_log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	defaultRelayBatchSize       = 100
+	defaultRelayPollInterval    = time.Second
+	defaultRelayLease           = 30 * time.Second
+	defaultRelayRetryBaseDelay  = time.Second
+	defaultRelayRetryMaxDelay   = 5 * time.Minute
+	defaultRelayRetention       = 24 * time.Hour
+	defaultRelayCleanupInterval = 10 * time.Minute
+)
+
+// OutboxRelayConfig configures an OutboxRelay. Zero values select the defaults.
+type OutboxRelayConfig struct {
+	// BatchSize is the number of messages claimed and published at once.
+	BatchSize int
+	// PollInterval is how long the relay waits when the outbox has nothing due.
+	PollInterval time.Duration
+	// Lease is how long claimed messages stay hidden from other relays.
+	Lease time.Duration
+	// RetryBaseDelay and RetryMaxDelay bound the exponential backoff between attempts of a message.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// Retention is how long delivered messages are kept before cleanup.
+	Retention time.Duration
+	// CleanupInterval is how often delivered messages past the retention are deleted.
+	CleanupInterval time.Duration
+}
+
+// OutboxRelay publishes outbox messages to Kafka with at-least-once delivery: a message is marked
+// delivered only after Kafka acknowledged it, so a crash in between publishes it again.
+type OutboxRelay struct {
+	store  OutboxStore
+	writer MessageWriter
+	config OutboxRelayConfig
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewOutboxRelay constructs an OutboxRelay.
+func NewOutboxRelay(store OutboxStore, writer MessageWriter, config OutboxRelayConfig) *OutboxRelay {
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultRelayBatchSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultRelayPollInterval
+	}
+	if config.Lease <= 0 {
+		config.Lease = defaultRelayLease
+	}
+	if config.RetryBaseDelay <= 0 {
+		config.RetryBaseDelay = defaultRelayRetryBaseDelay
+	}
+	if config.RetryMaxDelay <= 0 {
+		config.RetryMaxDelay = defaultRelayRetryMaxDelay
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaultRelayRetention
+	}
+	if config.CleanupInterval <= 0 {
+		config.CleanupInterval = defaultRelayCleanupInterval
+	}
+	return &OutboxRelay{
+		store:  store,
+		writer: writer,
+		config: config,
+		logger: slog.Default().With(slog.String("component", "outbox_relay")),
+		now:    time.Now,
+	}
+}
+
+// Run relays messages until ctx is canceled. Full batches are followed immediately by the next one;
+// otherwise the relay waits PollInterval. Delivered messages are cleaned up every CleanupInterval.
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+	cleanup := time.NewTicker(r.config.CleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-cleanup.C:
+			if _, err := r.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				r.logger.Warn("failed to clean up outbox", "error", err)
+			}
+		case <-poll.C:
+			delivered, err := r.RelayOnce(ctx)
+			if err != nil && ctx.Err() == nil {
+				r.logger.Warn("failed to relay outbox messages", "delivered", delivered, "error", err)
+			}
+			if err == nil && delivered == r.config.BatchSize {
+				poll.Reset(0)
+			} else {
+				poll.Reset(r.config.PollInterval)
+			}
+		}
+	}
+}
+
+// RelayOnce publishes one batch of due messages and returns how many were delivered.
+// Messages Kafka rejected are rescheduled with backoff and reported in the returned error.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	batch, err := r.store.Claim(ctx, r.config.BatchSize, r.config.Lease)
+	if err != nil {
+		return 0, fmt.Errorf("claim outbox messages: %w", err)
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	msgs := make([]kafka.Message, len(batch))
+	for i, m := range batch {
+		msgs[i] = m.Message
+	}
+	writeErr := r.writer.WriteMessages(ctx, msgs...)
+
+	// kafka.Writer reports per-message results for batches it partly delivered
+	var perMessage kafka.WriteErrors
+	if !errors.As(writeErr, &perMessage) || len(perMessage) != len(batch) {
+		perMessage = nil
+	}
+	var delivered []int64
+	var errs []error
+	for i, m := range batch {
+		msgErr := writeErr
+		if perMessage != nil {
+			msgErr = perMessage[i]
+		}
+		if msgErr == nil {
+			delivered = append(delivered, m.ID)
+			continue
+		}
+		next := r.now().Add(r.backoff(m.Attempts + 1))
+		if err := r.store.MarkFailed(ctx, m.ID, next, msgErr); err != nil {
+			// the lease expires and the message is retried anyway
+			errs = append(errs, fmt.Errorf("mark outbox message %d failed: %w", m.ID, err))
+		}
+	}
+	if writeErr != nil {
+		errs = append(errs, fmt.Errorf("publish %d of %d outbox messages failed: %w", len(batch)-len(delivered), len(batch), writeErr))
+	}
+	if err := r.store.MarkDelivered(ctx, delivered); err != nil {
+		// these messages are published again after the lease expires
+		errs = append(errs, fmt.Errorf("mark outbox messages delivered: %w", err))
+	}
+	return len(delivered), errors.Join(errs...)
+}
+
+// Cleanup deletes messages delivered more than Retention ago and returns how many were deleted.
+func (r *OutboxRelay) Cleanup(ctx context.Context) (int, error) {
+	before := r.now().Add(-r.config.Retention)
+	total := 0
+	for {
+		n, err := r.store.DeleteDelivered(ctx, before, r.config.BatchSize)
+		total += n
+		if err != nil || n < r.config.BatchSize {
+			return total, err
+		}
+	}
+}
+
+// backoff returns the delay before the given attempt: exponential with jitter, capped at RetryMaxDelay.
+func (r *OutboxRelay) backoff(attempt int) time.Duration {
+	d := r.config.RetryBaseDelay << (attempt - 1)
+	if d > r.config.RetryMaxDelay || d <= 0 {
+		d = r.config.RetryMaxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
@@ -0,0 +1,150 @@
+package event_log
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/infrastructure/messaging"
+)
+
+// flakyWriter fails the messages whose index is listed in failAt, once per call.
+type flakyWriter struct {
+	failAt map[int]bool
+	down   bool
+	calls  int
+}
+
+func (w *flakyWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.calls++
+	if w.down {
+		return errors.New("kafka unavailable")
+	}
+	if len(w.failAt) == 0 {
+		return nil
+	}
+	errs := make(kafka.WriteErrors, len(msgs))
+	for i := range msgs {
+		if w.failAt[i] {
+			errs[i] = kafka.LeaderNotAvailable
+		}
+	}
+	return errs
+}
+
+func newTestOutbox(t *testing.T, clock *time.Time, ids ...string) *MemoryOutbox {
+	t.Helper()
+	outbox := NewMemoryOutbox()
+	outbox.now = func() time.Time { return *clock }
+	events := make([]*entity.EventLog, len(ids))
+	for i, id := range ids {
+		events[i] = &entity.EventLog{EventLogID: id, EventType: "start", EventTime: *clock}
+	}
+	require.NoError(t, outbox.InsertEventLogs(context.Background(), events))
+	return outbox
+}
+
+func newTestRelay(outbox *MemoryOutbox, writer MessageWriter, clock *time.Time) *OutboxRelay {
+	relay := NewOutboxRelay(outbox, writer, OutboxRelayConfig{
+		BatchSize:      2,
+		RetryBaseDelay: time.Second,
+		RetryMaxDelay:  time.Minute,
+		Retention:      time.Hour,
+	})
+	relay.now = func() time.Time { return *clock }
+	return relay
+}
+
+func TestOutboxRelay_PublishesInOrder(t *testing.T) {
+	ctx := context.Background()
+	clock := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(t, &clock, "e1", "e2", "e3")
+	broker := messaging.NewMemoryBroker()
+	relay := newTestRelay(outbox, broker, &clock)
+
+	delivered, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered)
+	delivered, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Zero(t, outbox.Pending())
+
+	var ids []string
+	for _, msg := range broker.Messages() {
+		var e entity.EventLog
+		require.NoError(t, json.Unmarshal(msg.Value, &e))
+		ids = append(ids, e.EventLogID)
+	}
+	require.Equal(t, []string{"e1", "e2", "e3"}, ids)
+
+	// delivered rows are kept for the retention period
+	removed, err := relay.Cleanup(ctx)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+	clock = clock.Add(2 * time.Hour)
+	removed, err = relay.Cleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, removed)
+}
+
+func TestOutboxRelay_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	clock := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(t, &clock, "e1", "e2")
+	writer := &flakyWriter{down: true}
+	relay := newTestRelay(outbox, writer, &clock)
+
+	delivered, err := relay.RelayOnce(ctx)
+	require.Error(t, err)
+	require.Zero(t, delivered)
+	require.Equal(t, 2, outbox.Pending())
+
+	// nothing is due until the backoff has elapsed
+	delivered, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+	require.Equal(t, 1, writer.calls)
+
+	// Kafka recovers but rejects the second message of the batch
+	writer.down = false
+	writer.failAt = map[int]bool{1: true}
+	clock = clock.Add(time.Second)
+	delivered, err = relay.RelayOnce(ctx)
+	var writeErrs kafka.WriteErrors
+	require.ErrorAs(t, err, &writeErrs)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, 1, outbox.Pending())
+
+	writer.failAt = nil
+	clock = clock.Add(2 * time.Second)
+	delivered, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Zero(t, outbox.Pending())
+}
+
+func TestOutboxRelay_ClaimedMessagesAreRetriedAfterLease(t *testing.T) {
+	ctx := context.Background()
+	clock := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(t, &clock, "e1")
+
+	// a relay that crashed after claiming leaves the message hidden until the lease expires
+	claimed, err := outbox.Claim(ctx, 10, 30*time.Second)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	claimed, err = outbox.Claim(ctx, 10, 30*time.Second)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+
+	clock = clock.Add(30 * time.Second)
+	claimed, err = outbox.Claim(ctx, 10, 30*time.Second)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+}
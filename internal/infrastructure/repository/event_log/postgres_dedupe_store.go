@@ -0,0 +1,116 @@
+package event_log
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+
+	repo "github.com/tikfack/server/internal/domain/repository"
+)
+
+const (
+	// dedupePurgeInterval is how often Claim deletes expired keys.
+	dedupePurgeInterval = 10 * time.Minute
+	// dedupePurgeLimit bounds the rows deleted by one purge, keeping it short.
+	dedupePurgeLimit = 1000
+)
+
+// PostgresDedupeStore keeps dedupe keys in the event_dedupe_keys table, shared by all server instances.
+type PostgresDedupeStore struct {
+	db     *sql.DB
+	window time.Duration
+	// lastPurge is the UnixNano time of the last purge of expired keys.
+	lastPurge atomic.Int64
+}
+
+// NewPostgresDedupeStore creates a PostgresDedupeStore remembering keys for window.
+func NewPostgresDedupeStore(db *sql.DB, window time.Duration) *PostgresDedupeStore {
+	return &PostgresDedupeStore{db: db, window: window}
+}
+
+// Claim inserts the keys that are not remembered, or whose window has expired, and reports the others as seen.
+// The insert is atomic per key, so concurrent claims of the same key see it as new only once.
+func (s *PostgresDedupeStore) Claim(ctx context.Context, keys []string) ([]bool, error) {
+	seen := make([]bool, len(keys))
+	// ON CONFLICT DO UPDATE cannot touch a row twice in one statement, so repeated keys are settled here.
+	first := make(map[string]int, len(keys))
+	unique := make([]string, 0, len(keys))
+	for i, key := range keys {
+		if _, ok := first[key]; ok {
+			seen[i] = true
+			continue
+		}
+		first[key] = i
+		unique = append(unique, key)
+	}
+	if len(unique) == 0 {
+		return seen, nil
+	}
+	s.purgeExpired(ctx)
+
+	query := `
+INSERT INTO event_dedupe_keys (dedupe_key, expires_at)
+SELECT key, NOW() + $2 * INTERVAL '1 millisecond' FROM unnest($1::text[]) AS key
+ON CONFLICT (dedupe_key) DO UPDATE SET expires_at = EXCLUDED.expires_at
+WHERE event_dedupe_keys.expires_at <= NOW()
+RETURNING dedupe_key
+`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(unique), s.window.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	claimed := make(map[string]bool, len(unique))
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		claimed[key] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, key := range unique {
+		seen[first[key]] = !claimed[key]
+	}
+	return seen, nil
+}
+
+// Release deletes claimed keys.
+func (s *PostgresDedupeStore) Release(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := s.db.ExecContext(ctx, `DELETE FROM event_dedupe_keys WHERE dedupe_key = ANY($1)`, pq.Array(keys))
+	return err
+}
+
+// purgeExpired deletes expired keys at most once per dedupePurgeInterval across concurrent claims.
+// Failures are only logged: expired keys are ignored by Claim and purged next time.
+func (s *PostgresDedupeStore) purgeExpired(ctx context.Context) {
+	now := time.Now().UnixNano()
+	last := s.lastPurge.Load()
+	if now-last < int64(dedupePurgeInterval) || !s.lastPurge.CompareAndSwap(last, now) {
+		return
+	}
+	query := `
+DELETE FROM event_dedupe_keys
+WHERE dedupe_key IN (
+	SELECT dedupe_key FROM event_dedupe_keys
+	WHERE expires_at <= NOW()
+	LIMIT $1
+)
+`
+	if _, err := s.db.ExecContext(ctx, query, dedupePurgeLimit); err != nil {
+		slog.Warn("failed to purge expired dedupe keys", "error", err)
+	}
+}
+
+// ensure interface compliance
+var _ repo.EventDedupeStore = (*PostgresDedupeStore)(nil)
@@ -0,0 +1,146 @@
+package event_log
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	repo "github.com/tikfack/server/internal/domain/repository"
+)
+
+// PostgresOutbox stores event logs in the event_log_outbox table. As an EventLogRepository it makes
+// recording durable while Kafka is unavailable; OutboxRelay later publishes the rows to Kafka.
+type PostgresOutbox struct {
+	db *sql.DB
+}
+
+// NewPostgresOutbox creates a new PostgresOutbox.
+func NewPostgresOutbox(db *sql.DB) *PostgresOutbox {
+	return &PostgresOutbox{db: db}
+}
+
+// InsertEventLog stores a single EventLog in the outbox.
+func (o *PostgresOutbox) InsertEventLog(ctx context.Context, e *entity.EventLog) error {
+	return o.InsertEventLogs(ctx, []*entity.EventLog{e})
+}
+
+// InsertEventLogs stores multiple EventLogs in the outbox in one transaction.
+func (o *PostgresOutbox) InsertEventLogs(ctx context.Context, events []*entity.EventLog) error {
+	msgs := make([]kafka.Message, len(events))
+	for i, e := range events {
+		msg, err := eventLogMessage(e)
+		if err != nil {
+			return err
+		}
+		msgs[i] = msg
+	}
+
+	tx, err := o.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	stmt, err := tx.PrepareContext(ctx, `
+INSERT INTO event_log_outbox (event_log_id, message_key, payload, attempts, next_attempt_at, created_at)
+VALUES ($1, $2, $3, 0, NOW(), NOW())
+`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for i, e := range events {
+		if _, err := stmt.ExecContext(ctx, e.EventLogID, msgs[i].Key, msgs[i].Value); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Claim returns due messages and pushes their next_attempt_at past the lease.
+// SKIP LOCKED lets several relays claim disjoint batches concurrently.
+func (o *PostgresOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxMessage, error) {
+	query := `
+UPDATE event_log_outbox SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+WHERE outbox_id IN (
+	SELECT outbox_id FROM event_log_outbox
+	WHERE delivered_at IS NULL AND next_attempt_at <= NOW()
+	ORDER BY outbox_id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING outbox_id, message_key, payload, attempts
+`
+	rows, err := o.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claimed []OutboxMessage
+	for rows.Next() {
+		var m OutboxMessage
+		if err := rows.Scan(&m.ID, &m.Message.Key, &m.Message.Value, &m.Attempts); err != nil {
+			return nil, err
+		}
+		claimed = append(claimed, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// RETURNING does not preserve the subquery's order
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i].ID < claimed[j].ID })
+	return claimed, nil
+}
+
+// MarkDelivered records that the messages were published.
+func (o *PostgresOutbox) MarkDelivered(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := o.db.ExecContext(ctx, `UPDATE event_log_outbox SET delivered_at = NOW() WHERE outbox_id = ANY($1)`, pq.Array(ids))
+	return err
+}
+
+// MarkFailed records a failed attempt and schedules the next one.
+func (o *PostgresOutbox) MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, cause error) error {
+	query := `
+UPDATE event_log_outbox SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE outbox_id = $1
+`
+	_, err := o.db.ExecContext(ctx, query, id, nextAttemptAt, cause.Error())
+	return err
+}
+
+// DeleteDelivered removes delivered messages older than before.
+func (o *PostgresOutbox) DeleteDelivered(ctx context.Context, before time.Time, limit int) (int, error) {
+	query := `
+DELETE FROM event_log_outbox
+WHERE outbox_id IN (
+	SELECT outbox_id FROM event_log_outbox
+	WHERE delivered_at < $1
+	LIMIT $2
+)
+`
+	result, err := o.db.ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// ensure interface compliance
+var (
+	_ repo.EventLogRepository = (*PostgresOutbox)(nil)
+	_ OutboxStore             = (*PostgresOutbox)(nil)
+)
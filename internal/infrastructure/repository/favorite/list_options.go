@@ -0,0 +1,59 @@
+package favorite
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// favoriteKeyset builds the cursor condition, ORDER BY and LIMIT that follow the WHERE conditions
+// of a favorites listing query. args are the arguments already bound by those conditions ($1..$n);
+// the returned slice appends the arguments of the clause.
+func favoriteKeyset(uuidColumn string, opts repository.FavoriteListOptions, args ...any) (string, []any) {
+	direction, comparison := "ASC", ">"
+	if opts.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	clause := ""
+	if opts.After != nil {
+		args = append(args, opts.After.CreatedAt, opts.After.UUID)
+		clause += fmt.Sprintf("\nAND (created_at, %s) %s ($%d, $%d)", uuidColumn, comparison, len(args)-1, len(args))
+	}
+	clause += fmt.Sprintf("\nORDER BY created_at %s, %s %s", direction, uuidColumn, direction)
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		clause += fmt.Sprintf("\nLIMIT $%d", len(args))
+	}
+	return clause, args
+}
+
+// pageFavorites sorts favorites the same way as favoriteKeyset and applies the cursor and limit.
+func pageFavorites[T any](favorites []T, cursorOf func(T) repository.FavoriteCursor, opts repository.FavoriteListOptions) []T {
+	compare := func(a, b repository.FavoriteCursor) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		switch {
+		case a.UUID < b.UUID:
+			return -1
+		case a.UUID > b.UUID:
+			return 1
+		}
+		return 0
+	}
+	if opts.Descending {
+		ascending := compare
+		compare = func(a, b repository.FavoriteCursor) int { return ascending(b, a) }
+	}
+
+	slices.SortFunc(favorites, func(a, b T) int { return compare(cursorOf(a), cursorOf(b)) })
+	if opts.After != nil {
+		favorites = slices.DeleteFunc(favorites, func(f T) bool { return compare(cursorOf(f), *opts.After) <= 0 })
+	}
+	if opts.Limit > 0 && len(favorites) > opts.Limit {
+		favorites = favorites[:opts.Limit]
+	}
+	return favorites
+}
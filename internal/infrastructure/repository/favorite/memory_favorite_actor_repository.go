@@ -65,18 +65,25 @@ func (r *MemoryFavoriteActorRepository) FindByUserAndActorID(ctx context.Context
 	return nil, repository.ErrFavoriteActorNotFound
 }
 
-// ListByUserID lists favorite actors for a user.
-func (r *MemoryFavoriteActorRepository) ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteActor, error) {
+// ListByUserID lists favorite actors for a user in the order and window given by opts.
+func (r *MemoryFavoriteActorRepository) ListByUserID(ctx context.Context, userID string, opts repository.FavoriteListOptions) ([]entity.FavoriteActor, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	userActors, ok := r.actorsByUser[userID]
-	if !ok {
-		return []entity.FavoriteActor{}, nil
-	}
+	userActors := r.actorsByUser[userID]
 	result := make([]entity.FavoriteActor, 0, len(userActors))
 	for _, favorite := range userActors {
 		result = append(result, *favorite)
 	}
-	return result, nil
+	return pageFavorites(result, func(f entity.FavoriteActor) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteActorUUID}
+	}, opts), nil
+}
+
+// CountByUserID returns the number of favorite actors for a user.
+func (r *MemoryFavoriteActorRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.actorsByUser[userID]), nil
 }
@@ -0,0 +1,110 @@
+package favorite
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// articleKey identifies a favorite article within a user's favorites.
+type articleKey struct {
+	articleType entity.ArticleType
+	articleID   string
+}
+
+// MemoryFavoriteArticleRepository provides in-memory storage for favorite articles.
+type MemoryFavoriteArticleRepository struct {
+	mu             sync.RWMutex
+	articlesByUser map[string]map[articleKey]*entity.FavoriteArticle
+}
+
+// NewMemoryFavoriteArticleRepository constructs a new article repository instance.
+func NewMemoryFavoriteArticleRepository() *MemoryFavoriteArticleRepository {
+	return &MemoryFavoriteArticleRepository{
+		articlesByUser: make(map[string]map[articleKey]*entity.FavoriteArticle),
+	}
+}
+
+// Add adds a favorite article, keeping the existing record if the user already has it.
+func (r *MemoryFavoriteArticleRepository) Add(ctx context.Context, favorite *entity.FavoriteArticle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userArticles, ok := r.articlesByUser[favorite.UserID]
+	if !ok {
+		userArticles = make(map[articleKey]*entity.FavoriteArticle)
+		r.articlesByUser[favorite.UserID] = userArticles
+	}
+	key := articleKey{articleType: favorite.ArticleType, articleID: favorite.ArticleID}
+	if existing, ok := userArticles[key]; ok {
+		*favorite = *existing
+		return nil
+	}
+	stored := *favorite
+	userArticles[key] = &stored
+	return nil
+}
+
+// RemoveByArticleID removes a favorite article entry and returns it.
+func (r *MemoryFavoriteArticleRepository) RemoveByArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := articleKey{articleType: articleType, articleID: articleID}
+	favorite, ok := r.articlesByUser[userID][key]
+	if !ok {
+		return nil, repository.ErrFavoriteArticleNotFound
+	}
+	delete(r.articlesByUser[userID], key)
+	return favorite, nil
+}
+
+// FindByUserAndArticleID finds a favorite article entry.
+func (r *MemoryFavoriteArticleRepository) FindByUserAndArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if favorite, ok := r.articlesByUser[userID][articleKey{articleType: articleType, articleID: articleID}]; ok {
+		copied := *favorite
+		return &copied, nil
+	}
+	return nil, repository.ErrFavoriteArticleNotFound
+}
+
+// ListByUserID lists favorite articles for a user in the order and window given by opts.
+func (r *MemoryFavoriteArticleRepository) ListByUserID(ctx context.Context, userID string, articleType entity.ArticleType, opts repository.FavoriteListOptions) ([]entity.FavoriteArticle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]entity.FavoriteArticle, 0, len(r.articlesByUser[userID]))
+	for _, favorite := range r.articlesByUser[userID] {
+		if articleType == "" || favorite.ArticleType == articleType {
+			result = append(result, *favorite)
+		}
+	}
+	return pageFavorites(result, func(f entity.FavoriteArticle) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteArticleUUID}
+	}, opts), nil
+}
+
+// CountByUserID returns the number of favorite articles for a user.
+func (r *MemoryFavoriteArticleRepository) CountByUserID(ctx context.Context, userID string, articleType entity.ArticleType) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if articleType == "" {
+		return len(r.articlesByUser[userID]), nil
+	}
+	count := 0
+	for key := range r.articlesByUser[userID] {
+		if key.articleType == articleType {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// ensure interface compliance
+var _ repository.FavoriteArticleRepository = (*MemoryFavoriteArticleRepository)(nil)
@@ -65,18 +65,25 @@ func (r *MemoryFavoriteVideoRepository) FindByUserAndVideoID(ctx context.Context
 	return nil, repository.ErrFavoriteVideoNotFound
 }
 
-// ListByUserID lists favorite videos for a user.
-func (r *MemoryFavoriteVideoRepository) ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteVideo, error) {
+// ListByUserID lists favorite videos for a user in the order and window given by opts.
+func (r *MemoryFavoriteVideoRepository) ListByUserID(ctx context.Context, userID string, opts repository.FavoriteListOptions) ([]entity.FavoriteVideo, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	userVideos, ok := r.videosByUser[userID]
-	if !ok {
-		return []entity.FavoriteVideo{}, nil
-	}
+	userVideos := r.videosByUser[userID]
 	result := make([]entity.FavoriteVideo, 0, len(userVideos))
 	for _, favorite := range userVideos {
 		result = append(result, *favorite)
 	}
-	return result, nil
+	return pageFavorites(result, func(f entity.FavoriteVideo) repository.FavoriteCursor {
+		return repository.FavoriteCursor{CreatedAt: f.CreatedAt, UUID: f.FavoriteVideoUUID}
+	}, opts), nil
+}
+
+// CountByUserID returns the number of favorite videos for a user.
+func (r *MemoryFavoriteVideoRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.videosByUser[userID]), nil
 }
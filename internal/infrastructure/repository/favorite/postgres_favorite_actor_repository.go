@@ -66,15 +66,14 @@ WHERE user_id = $1 AND actor_id = $2
 	return favorite, nil
 }
 
-// ListByUserID returns all favorite actors for a user.
-func (r *PostgresFavoriteActorRepository) ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteActor, error) {
+// ListByUserID returns a user's favorite actors using keyset pagination on (created_at, favorite_actor_uuid).
+func (r *PostgresFavoriteActorRepository) ListByUserID(ctx context.Context, userID string, opts repository.FavoriteListOptions) ([]entity.FavoriteActor, error) {
+	clause, args := favoriteKeyset("favorite_actor_uuid", opts, userID)
 	query := `
 SELECT favorite_actor_uuid, user_id, actor_id, created_at, updated_at
 FROM favorite_actors
-WHERE user_id = $1
-ORDER BY created_at ASC
-`
-	rows, err := r.db.QueryContext(ctx, query, userID)
+WHERE user_id = $1` + clause
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +94,15 @@ ORDER BY created_at ASC
 	return favorites, nil
 }
 
+// CountByUserID returns the number of favorite actors for a user.
+func (r *PostgresFavoriteActorRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM favorite_actors WHERE user_id = $1`, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *PostgresFavoriteActorRepository) scanFavorite(row *sql.Row, favorite *entity.FavoriteActor) error {
 	return row.Scan(&favorite.FavoriteActorUUID, &favorite.UserID, &favorite.ActorID, &favorite.CreatedAt, &favorite.UpdatedAt)
 }
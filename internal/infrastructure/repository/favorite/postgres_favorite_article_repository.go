@@ -0,0 +1,112 @@
+package favorite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// PostgresFavoriteArticleRepository stores favorite genres, makers, series and directors in Postgres.
+type PostgresFavoriteArticleRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresFavoriteArticleRepository creates a new PostgresFavoriteArticleRepository.
+func NewPostgresFavoriteArticleRepository(db *sql.DB) *PostgresFavoriteArticleRepository {
+	return &PostgresFavoriteArticleRepository{db: db}
+}
+
+// Add inserts a favorite article, returning the existing record if already present.
+func (r *PostgresFavoriteArticleRepository) Add(ctx context.Context, favorite *entity.FavoriteArticle) error {
+	query := `
+INSERT INTO favorite_articles (favorite_article_uuid, user_id, article_type, article_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW())
+ON CONFLICT (user_id, article_type, article_id) DO UPDATE SET updated_at = EXCLUDED.updated_at
+RETURNING favorite_article_uuid, user_id, article_type, article_id, created_at, updated_at
+`
+
+	return r.scanFavorite(r.db.QueryRowContext(ctx, query, favorite.FavoriteArticleUUID, favorite.UserID, favorite.ArticleType, favorite.ArticleID), favorite)
+}
+
+// RemoveByArticleID deletes a favorite article for a user.
+func (r *PostgresFavoriteArticleRepository) RemoveByArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error) {
+	query := `
+DELETE FROM favorite_articles
+WHERE user_id = $1 AND article_type = $2 AND article_id = $3
+RETURNING favorite_article_uuid, user_id, article_type, article_id, created_at, updated_at
+`
+	row := r.db.QueryRowContext(ctx, query, userID, articleType, articleID)
+	favorite := &entity.FavoriteArticle{}
+	if err := r.scanFavorite(row, favorite); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrFavoriteArticleNotFound
+		}
+		return nil, err
+	}
+	return favorite, nil
+}
+
+// FindByUserAndArticleID returns a favorite article when it exists.
+func (r *PostgresFavoriteArticleRepository) FindByUserAndArticleID(ctx context.Context, userID string, articleType entity.ArticleType, articleID string) (*entity.FavoriteArticle, error) {
+	query := `
+SELECT favorite_article_uuid, user_id, article_type, article_id, created_at, updated_at
+FROM favorite_articles
+WHERE user_id = $1 AND article_type = $2 AND article_id = $3
+`
+	row := r.db.QueryRowContext(ctx, query, userID, articleType, articleID)
+	favorite := &entity.FavoriteArticle{}
+	if err := r.scanFavorite(row, favorite); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrFavoriteArticleNotFound
+		}
+		return nil, err
+	}
+	return favorite, nil
+}
+
+// ListByUserID returns a user's favorite articles using keyset pagination on (created_at, favorite_article_uuid).
+func (r *PostgresFavoriteArticleRepository) ListByUserID(ctx context.Context, userID string, articleType entity.ArticleType, opts repository.FavoriteListOptions) ([]entity.FavoriteArticle, error) {
+	clause, args := favoriteKeyset("favorite_article_uuid", opts, userID, articleType)
+	query := `
+SELECT favorite_article_uuid, user_id, article_type, article_id, created_at, updated_at
+FROM favorite_articles
+WHERE user_id = $1 AND ($2::text = '' OR article_type = $2)` + clause
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var favorites []entity.FavoriteArticle
+	for rows.Next() {
+		var favorite entity.FavoriteArticle
+		if err := rows.Scan(&favorite.FavoriteArticleUUID, &favorite.UserID, &favorite.ArticleType, &favorite.ArticleID, &favorite.CreatedAt, &favorite.UpdatedAt); err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, favorite)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return favorites, nil
+}
+
+// CountByUserID returns the number of favorite articles for a user.
+func (r *PostgresFavoriteArticleRepository) CountByUserID(ctx context.Context, userID string, articleType entity.ArticleType) (int, error) {
+	query := `SELECT COUNT(*) FROM favorite_articles WHERE user_id = $1 AND ($2::text = '' OR article_type = $2)`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID, articleType).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PostgresFavoriteArticleRepository) scanFavorite(row *sql.Row, favorite *entity.FavoriteArticle) error {
+	return row.Scan(&favorite.FavoriteArticleUUID, &favorite.UserID, &favorite.ArticleType, &favorite.ArticleID, &favorite.CreatedAt, &favorite.UpdatedAt)
+}
+
+// ensure interface compliance
+var _ repository.FavoriteArticleRepository = (*PostgresFavoriteArticleRepository)(nil)
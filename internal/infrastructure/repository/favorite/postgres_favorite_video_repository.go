@@ -66,15 +66,14 @@ WHERE user_id = $1 AND video_id = $2
 	return favorite, nil
 }
 
-// ListByUserID returns all favorite videos for a user.
-func (r *PostgresFavoriteVideoRepository) ListByUserID(ctx context.Context, userID string) ([]entity.FavoriteVideo, error) {
+// ListByUserID returns a user's favorite videos using keyset pagination on (created_at, favorite_video_uuid).
+func (r *PostgresFavoriteVideoRepository) ListByUserID(ctx context.Context, userID string, opts repository.FavoriteListOptions) ([]entity.FavoriteVideo, error) {
+	clause, args := favoriteKeyset("favorite_video_uuid", opts, userID)
 	query := `
 SELECT favorite_video_uuid, user_id, video_id, created_at, updated_at
 FROM favorite_videos
-WHERE user_id = $1
-ORDER BY created_at ASC
-`
-	rows, err := r.db.QueryContext(ctx, query, userID)
+WHERE user_id = $1` + clause
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +94,15 @@ ORDER BY created_at ASC
 	return favorites, nil
 }
 
+// CountByUserID returns the number of favorite videos for a user.
+func (r *PostgresFavoriteVideoRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM favorite_videos WHERE user_id = $1`, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *PostgresFavoriteVideoRepository) scanFavorite(row *sql.Row, favorite *entity.FavoriteVideo) error {
 	return row.Scan(&favorite.FavoriteVideoUUID, &favorite.UserID, &favorite.VideoID, &favorite.CreatedAt, &favorite.UpdatedAt)
 }
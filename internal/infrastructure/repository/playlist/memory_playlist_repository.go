@@ -0,0 +1,199 @@
+package playlist
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// MemoryPlaylistRepository provides in-memory storage for playlists.
+type MemoryPlaylistRepository struct {
+	mu        sync.RWMutex
+	playlists map[string]*entity.Playlist
+	// items holds each playlist's items in position order.
+	items map[string][]*entity.PlaylistItem
+}
+
+// NewMemoryPlaylistRepository constructs a new playlist repository instance.
+func NewMemoryPlaylistRepository() *MemoryPlaylistRepository {
+	return &MemoryPlaylistRepository{
+		playlists: make(map[string]*entity.Playlist),
+		items:     make(map[string][]*entity.PlaylistItem),
+	}
+}
+
+// Create adds a playlist.
+func (r *MemoryPlaylistRepository) Create(ctx context.Context, playlist *entity.Playlist) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *playlist
+	r.playlists[playlist.PlaylistUUID] = &stored
+	return nil
+}
+
+// Get returns a copy of a playlist.
+func (r *MemoryPlaylistRepository) Get(ctx context.Context, playlistUUID string) (*entity.Playlist, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	playlist, ok := r.playlists[playlistUUID]
+	if !ok {
+		return nil, repository.ErrPlaylistNotFound
+	}
+	copied := r.snapshot(playlist)
+	return &copied, nil
+}
+
+// Update stores the playlist's name, description and visibility.
+func (r *MemoryPlaylistRepository) Update(ctx context.Context, playlist *entity.Playlist) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.playlists[playlist.PlaylistUUID]
+	if !ok {
+		return repository.ErrPlaylistNotFound
+	}
+	stored.Name = playlist.Name
+	stored.Description = playlist.Description
+	stored.Visibility = playlist.Visibility
+	stored.UpdatedAt = time.Now().UTC()
+	playlist.UpdatedAt = stored.UpdatedAt
+	return nil
+}
+
+// Delete removes a playlist and its items.
+func (r *MemoryPlaylistRepository) Delete(ctx context.Context, playlistUUID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.playlists[playlistUUID]; !ok {
+		return repository.ErrPlaylistNotFound
+	}
+	delete(r.playlists, playlistUUID)
+	delete(r.items, playlistUUID)
+	return nil
+}
+
+// ListByUserID lists a user's playlists, most recently updated first.
+func (r *MemoryPlaylistRepository) ListByUserID(ctx context.Context, userID string, visibility entity.PlaylistVisibility) ([]entity.Playlist, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []entity.Playlist
+	for _, playlist := range r.playlists {
+		if playlist.UserID == userID && (visibility == "" || playlist.Visibility == visibility) {
+			result = append(result, r.snapshot(playlist))
+		}
+	}
+	slices.SortFunc(result, func(a, b entity.Playlist) int {
+		if c := b.UpdatedAt.Compare(a.UpdatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PlaylistUUID, b.PlaylistUUID)
+	})
+	return result, nil
+}
+
+// ListItems returns a playlist's items ordered by position.
+func (r *MemoryPlaylistRepository) ListItems(ctx context.Context, playlistUUID string) ([]entity.PlaylistItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]entity.PlaylistItem, 0, len(r.items[playlistUUID]))
+	for i, item := range r.items[playlistUUID] {
+		copied := *item
+		copied.Position = i
+		items = append(items, copied)
+	}
+	return items, nil
+}
+
+// AddItem appends a video to the end of the playlist, or loads the existing item when already present.
+func (r *MemoryPlaylistRepository) AddItem(ctx context.Context, item *entity.PlaylistItem, maxItems int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.items[item.PlaylistUUID]
+	if i := indexOfVideo(items, item.VideoID); i >= 0 {
+		*item = *items[i]
+		item.Position = i
+		return r.touch(item.PlaylistUUID)
+	}
+	if _, ok := r.playlists[item.PlaylistUUID]; ok && len(items) >= maxItems {
+		// like a rolled-back transaction, a rejected add leaves UpdatedAt unchanged
+		return repository.ErrPlaylistFull
+	}
+	if err := r.touch(item.PlaylistUUID); err != nil {
+		return err
+	}
+	item.Position = len(items)
+	stored := *item
+	r.items[item.PlaylistUUID] = append(items, &stored)
+	return nil
+}
+
+// RemoveItem removes a video from the playlist.
+func (r *MemoryPlaylistRepository) RemoveItem(ctx context.Context, playlistUUID, videoID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.touch(playlistUUID); err != nil {
+		return err
+	}
+	items := r.items[playlistUUID]
+	i := indexOfVideo(items, videoID)
+	if i < 0 {
+		return repository.ErrPlaylistItemNotFound
+	}
+	r.items[playlistUUID] = slices.Delete(items, i, i+1)
+	return nil
+}
+
+// MoveItem moves a video to a new position.
+func (r *MemoryPlaylistRepository) MoveItem(ctx context.Context, playlistUUID, videoID string, position int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.touch(playlistUUID); err != nil {
+		return err
+	}
+	items := r.items[playlistUUID]
+	i := indexOfVideo(items, videoID)
+	if i < 0 {
+		return repository.ErrPlaylistItemNotFound
+	}
+	moved := items[i]
+	items = slices.Delete(items, i, i+1)
+	position = min(max(position, 0), len(items))
+	r.items[playlistUUID] = slices.Insert(items, position, moved)
+	return nil
+}
+
+// touch bumps a playlist's UpdatedAt, as the Postgres repository does on item changes.
+func (r *MemoryPlaylistRepository) touch(playlistUUID string) error {
+	playlist, ok := r.playlists[playlistUUID]
+	if !ok {
+		return repository.ErrPlaylistNotFound
+	}
+	playlist.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *MemoryPlaylistRepository) snapshot(playlist *entity.Playlist) entity.Playlist {
+	copied := *playlist
+	copied.ItemCount = len(r.items[playlist.PlaylistUUID])
+	return copied
+}
+
+func indexOfVideo(items []*entity.PlaylistItem, videoID string) int {
+	return slices.IndexFunc(items, func(item *entity.PlaylistItem) bool { return item.VideoID == videoID })
+}
+
+// ensure interface compliance
+var _ repository.PlaylistRepository = (*MemoryPlaylistRepository)(nil)
@@ -0,0 +1,244 @@
+package playlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// PostgresPlaylistRepository stores playlists in the playlists and playlist_items tables.
+// Item mutations lock the playlist row so that positions stay contiguous under concurrent edits.
+type PostgresPlaylistRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPlaylistRepository creates a new PostgresPlaylistRepository.
+func NewPostgresPlaylistRepository(db *sql.DB) *PostgresPlaylistRepository {
+	return &PostgresPlaylistRepository{db: db}
+}
+
+const playlistColumns = `p.playlist_uuid, p.user_id, p.name, p.description, p.visibility, p.created_at, p.updated_at,
+	(SELECT COUNT(*) FROM playlist_items i WHERE i.playlist_uuid = p.playlist_uuid)`
+
+// Create inserts a new playlist.
+func (r *PostgresPlaylistRepository) Create(ctx context.Context, playlist *entity.Playlist) error {
+	query := `
+INSERT INTO playlists (playlist_uuid, user_id, name, description, visibility, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+RETURNING created_at, updated_at
+`
+	return r.db.QueryRowContext(ctx, query, playlist.PlaylistUUID, playlist.UserID, playlist.Name, playlist.Description, playlist.Visibility).
+		Scan(&playlist.CreatedAt, &playlist.UpdatedAt)
+}
+
+// Get returns a playlist by UUID.
+func (r *PostgresPlaylistRepository) Get(ctx context.Context, playlistUUID string) (*entity.Playlist, error) {
+	query := `SELECT ` + playlistColumns + ` FROM playlists p WHERE p.playlist_uuid = $1`
+	playlist := &entity.Playlist{}
+	if err := scanPlaylist(r.db.QueryRowContext(ctx, query, playlistUUID), playlist); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrPlaylistNotFound
+		}
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// Update stores the playlist's name, description and visibility.
+func (r *PostgresPlaylistRepository) Update(ctx context.Context, playlist *entity.Playlist) error {
+	query := `
+UPDATE playlists SET name = $2, description = $3, visibility = $4, updated_at = NOW()
+WHERE playlist_uuid = $1
+RETURNING updated_at
+`
+	err := r.db.QueryRowContext(ctx, query, playlist.PlaylistUUID, playlist.Name, playlist.Description, playlist.Visibility).
+		Scan(&playlist.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrPlaylistNotFound
+	}
+	return err
+}
+
+// Delete removes a playlist; its items are removed by the ON DELETE CASCADE foreign key.
+func (r *PostgresPlaylistRepository) Delete(ctx context.Context, playlistUUID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM playlists WHERE playlist_uuid = $1`, playlistUUID)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrPlaylistNotFound
+	}
+	return nil
+}
+
+// ListByUserID lists a user's playlists, most recently updated first.
+func (r *PostgresPlaylistRepository) ListByUserID(ctx context.Context, userID string, visibility entity.PlaylistVisibility) ([]entity.Playlist, error) {
+	query := `SELECT ` + playlistColumns + `
+FROM playlists p
+WHERE p.user_id = $1 AND ($2::text = '' OR p.visibility = $2)
+ORDER BY p.updated_at DESC, p.playlist_uuid
+`
+	rows, err := r.db.QueryContext(ctx, query, userID, visibility)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var playlists []entity.Playlist
+	for rows.Next() {
+		var playlist entity.Playlist
+		if err := scanPlaylist(rows, &playlist); err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, playlist)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return playlists, nil
+}
+
+// ListItems returns a playlist's items ordered by position.
+func (r *PostgresPlaylistRepository) ListItems(ctx context.Context, playlistUUID string) ([]entity.PlaylistItem, error) {
+	query := `
+SELECT playlist_item_uuid, playlist_uuid, video_id, position, created_at
+FROM playlist_items
+WHERE playlist_uuid = $1
+ORDER BY position
+`
+	rows, err := r.db.QueryContext(ctx, query, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []entity.PlaylistItem
+	for rows.Next() {
+		var item entity.PlaylistItem
+		if err := rows.Scan(&item.PlaylistItemUUID, &item.PlaylistUUID, &item.VideoID, &item.Position, &item.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// AddItem appends a video to the end of the playlist, or loads the existing item when already present.
+// The item count is checked under the playlist lock, so concurrent adds cannot exceed maxItems.
+func (r *PostgresPlaylistRepository) AddItem(ctx context.Context, item *entity.PlaylistItem, maxItems int) error {
+	return r.withLockedPlaylist(ctx, item.PlaylistUUID, func(tx *sql.Tx) error {
+		existing := `
+SELECT playlist_item_uuid, position, created_at FROM playlist_items
+WHERE playlist_uuid = $1 AND video_id = $2
+`
+		err := tx.QueryRowContext(ctx, existing, item.PlaylistUUID, item.VideoID).Scan(&item.PlaylistItemUUID, &item.Position, &item.CreatedAt)
+		if err == nil || !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		var count int
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM playlist_items WHERE playlist_uuid = $1`, item.PlaylistUUID).Scan(&count); err != nil {
+			return err
+		}
+		if count >= maxItems {
+			return repository.ErrPlaylistFull
+		}
+
+		insert := `
+INSERT INTO playlist_items (playlist_item_uuid, playlist_uuid, video_id, position, created_at)
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING position, created_at
+`
+		return tx.QueryRowContext(ctx, insert, item.PlaylistItemUUID, item.PlaylistUUID, item.VideoID, count).Scan(&item.Position, &item.CreatedAt)
+	})
+}
+
+// RemoveItem removes a video from the playlist and shifts the following items up.
+func (r *PostgresPlaylistRepository) RemoveItem(ctx context.Context, playlistUUID, videoID string) error {
+	return r.withLockedPlaylist(ctx, playlistUUID, func(tx *sql.Tx) error {
+		var position int
+		err := tx.QueryRowContext(ctx, `DELETE FROM playlist_items WHERE playlist_uuid = $1 AND video_id = $2 RETURNING position`, playlistUUID, videoID).
+			Scan(&position)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrPlaylistItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE playlist_items SET position = position - 1 WHERE playlist_uuid = $1 AND position > $2`, playlistUUID, position)
+		return err
+	})
+}
+
+// MoveItem moves a video to a new position, shifting the items in between by one.
+func (r *PostgresPlaylistRepository) MoveItem(ctx context.Context, playlistUUID, videoID string, position int) error {
+	return r.withLockedPlaylist(ctx, playlistUUID, func(tx *sql.Tx) error {
+		var current, count int
+		query := `
+SELECT position, (SELECT COUNT(*) FROM playlist_items WHERE playlist_uuid = $1)
+FROM playlist_items WHERE playlist_uuid = $1 AND video_id = $2
+`
+		err := tx.QueryRowContext(ctx, query, playlistUUID, videoID).Scan(&current, &count)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrPlaylistItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		position = min(max(position, 0), count-1)
+		switch {
+		case position == current:
+			return nil
+		case position > current:
+			_, err = tx.ExecContext(ctx, `UPDATE playlist_items SET position = position - 1 WHERE playlist_uuid = $1 AND position > $2 AND position <= $3`, playlistUUID, current, position)
+		default:
+			_, err = tx.ExecContext(ctx, `UPDATE playlist_items SET position = position + 1 WHERE playlist_uuid = $1 AND position >= $2 AND position < $3`, playlistUUID, position, current)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE playlist_items SET position = $3 WHERE playlist_uuid = $1 AND video_id = $2`, playlistUUID, videoID, position)
+		return err
+	})
+}
+
+// withLockedPlaylist runs fn in a transaction holding the playlist row lock and bumps the playlist's updated_at.
+func (r *PostgresPlaylistRepository) withLockedPlaylist(ctx context.Context, playlistUUID string, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	result, err := tx.ExecContext(ctx, `UPDATE playlists SET updated_at = NOW() WHERE playlist_uuid = $1`, playlistUUID)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return repository.ErrPlaylistNotFound
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPlaylist(row rowScanner, playlist *entity.Playlist) error {
+	return row.Scan(&playlist.PlaylistUUID, &playlist.UserID, &playlist.Name, &playlist.Description, &playlist.Visibility,
+		&playlist.CreatedAt, &playlist.UpdatedAt, &playlist.ItemCount)
+}
+
+// ensure interface compliance
+var _ repository.PlaylistRepository = (*PostgresPlaylistRepository)(nil)
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+	"github.com/tikfack/server/internal/infrastructure/dmmapi"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// DefaultCacheTTL は分類の一覧を保持する既定の期間。ジャンル・メーカー・シリーズはほとんど変わらないため長めにとる。
+const DefaultCacheTTL = 24 * time.Hour
+
+// CachedTaxonomyRepository は TaxonomyCatalog をキャッシュで包むデコレーター。
+// DMM API に送るクエリが同一の呼び出しの結果を Backend に保持し、上流 API への呼び出しを減らす。
+type CachedTaxonomyRepository struct {
+	next    port.TaxonomyCatalog
+	backend cache.Backend
+	ttl     time.Duration
+	logger  *slog.Logger
+}
+
+// NewCachedTaxonomyRepository は next をキャッシュで包んだ TaxonomyCatalog を返す。ttl が 0 以下の場合はキャッシュしない。
+func NewCachedTaxonomyRepository(next port.TaxonomyCatalog, backend cache.Backend, ttl time.Duration) *CachedTaxonomyRepository {
+	if next == nil {
+		panic("taxonomy catalog must be provided")
+	}
+	if backend == nil {
+		panic("cache backend must be provided")
+	}
+	return &CachedTaxonomyRepository{
+		next:    next,
+		backend: backend,
+		ttl:     ttl,
+		logger:  slog.Default().With(slog.String("component", "taxonomy_cache")),
+	}
+}
+
+func (r *CachedTaxonomyRepository) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return r.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// taxonomyListEntry はキャッシュ値。
+type taxonomyListEntry struct {
+	Entries  []model.TaxonomyEntry `json:"entries"`
+	Metadata *model.SearchMetadata `json:"metadata"`
+}
+
+// ListTaxonomy は分類の一覧をキャッシュ経由で取得する
+func (r *CachedTaxonomyRepository) ListTaxonomy(ctx context.Context, kind model.TaxonomyKind, query model.TaxonomyQuery) ([]model.TaxonomyEntry, *model.SearchMetadata, error) {
+	key := taxonomyKey(kind, query)
+	if r.ttl <= 0 || key == "" {
+		return r.next.ListTaxonomy(ctx, kind, query)
+	}
+
+	log := r.loggerWithCtx(ctx)
+	var cached taxonomyListEntry
+	if cache.LoadJSON(ctx, r.backend, log, key, &cached) {
+		return cached.Entries, cached.Metadata, nil
+	}
+
+	entries, metadata, err := r.next.ListTaxonomy(ctx, kind, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	cache.StoreJSON(ctx, r.backend, log, key, taxonomyListEntry{Entries: entries, Metadata: metadata}, r.ttl)
+	return entries, metadata, nil
+}
+
+// taxonomyKey は分類の種類と、DMM API に送るクエリをエンコードした文字列からキーを生成する。
+// 検証に失敗するクエリは上流を呼ばずに失敗するため、キーは空になりキャッシュしない。
+func taxonomyKey(kind model.TaxonomyKind, query model.TaxonomyQuery) string {
+	values, err := dmmapi.ListTaxonomyQuery(kind, query).Values()
+	if err != nil {
+		return ""
+	}
+	return "taxonomy:" + string(kind) + "?" + values.Encode()
+}
+
+// ensure interface compliance
+var _ port.TaxonomyCatalog = (*CachedTaxonomyRepository)(nil)
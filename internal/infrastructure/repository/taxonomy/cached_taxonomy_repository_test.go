@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+)
+
+var (
+	testEntries  = []model.TaxonomyEntry{{ID: "6003", Name: "ドラマ", Ruby: "どらま"}}
+	testMetadata = &model.SearchMetadata{ResultCount: 1, TotalCount: 1, FirstPosition: 1}
+	testQuery    = model.TaxonomyQuery{FloorID: "43", Initial: "ど", Hits: 100}
+)
+
+func TestCachedTaxonomyRepository_ListTaxonomy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+	repo := NewCachedTaxonomyRepository(next, cache.NewLRU(10), time.Hour)
+
+	next.EXPECT().
+		ListTaxonomy(gomock.Any(), model.TaxonomyGenre, testQuery).
+		Return(testEntries, testMetadata, nil).
+		Times(1)
+
+	for i := 0; i < 3; i++ {
+		entries, md, err := repo.ListTaxonomy(ctx, model.TaxonomyGenre, testQuery)
+		require.NoError(t, err)
+		require.Equal(t, testEntries, entries)
+		require.Equal(t, testMetadata, md)
+	}
+}
+
+func TestCachedTaxonomyRepository_KindsAreCachedSeparately(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+	repo := NewCachedTaxonomyRepository(next, cache.NewLRU(10), time.Hour)
+
+	makers := []model.TaxonomyEntry{{ID: "1509", Name: "メーカーA"}}
+	next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomyGenre, testQuery).Return(testEntries, testMetadata, nil)
+	next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomyMaker, testQuery).Return(makers, testMetadata, nil)
+
+	genres, _, err := repo.ListTaxonomy(ctx, model.TaxonomyGenre, testQuery)
+	require.NoError(t, err)
+	require.Equal(t, testEntries, genres)
+
+	got, _, err := repo.ListTaxonomy(ctx, model.TaxonomyMaker, testQuery)
+	require.NoError(t, err)
+	require.Equal(t, makers, got)
+}
+
+func TestCachedTaxonomyRepository_ErrorsAreNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+	repo := NewCachedTaxonomyRepository(next, cache.NewLRU(10), time.Hour)
+
+	upstreamErr := errors.New("upstream error")
+	gomock.InOrder(
+		next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomySeries, testQuery).Return(nil, nil, upstreamErr),
+		next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomySeries, testQuery).Return(testEntries, testMetadata, nil),
+	)
+
+	_, _, err := repo.ListTaxonomy(ctx, model.TaxonomySeries, testQuery)
+	require.ErrorIs(t, err, upstreamErr)
+
+	entries, _, err := repo.ListTaxonomy(ctx, model.TaxonomySeries, testQuery)
+	require.NoError(t, err)
+	require.Equal(t, testEntries, entries)
+}
+
+func TestCachedTaxonomyRepository_ZeroTTLDisablesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+	repo := NewCachedTaxonomyRepository(next, cache.NewLRU(10), 0)
+
+	next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomyGenre, testQuery).Return(testEntries, testMetadata, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := repo.ListTaxonomy(ctx, model.TaxonomyGenre, testQuery)
+		require.NoError(t, err)
+	}
+}
+
+func TestCachedTaxonomyRepository_InvalidQueriesAreNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockTaxonomyCatalog(ctrl)
+	backend := cache.NewLRU(10)
+	repo := NewCachedTaxonomyRepository(next, backend, time.Hour)
+
+	// 上流に送れないクエリはキャッシュせず、毎回 next に任せる
+	invalid := model.TaxonomyQuery{FloorID: "videoa", Hits: 100}
+	next.EXPECT().ListTaxonomy(gomock.Any(), model.TaxonomyGenre, invalid).Return(testEntries, testMetadata, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := repo.ListTaxonomy(ctx, model.TaxonomyGenre, invalid)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 0, backend.Len())
+}
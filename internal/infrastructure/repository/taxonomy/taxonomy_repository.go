@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/infrastructure/dmmapi"
+)
+
+// NewTaxonomyRepository は TaxonomyCatalog ポートの実装を返す。
+func NewTaxonomyRepository() (port.TaxonomyCatalog, error) {
+	return dmmapi.NewTaxonomyRepository()
+}
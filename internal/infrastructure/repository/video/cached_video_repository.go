@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// VideoCatalog のメソッド名。キーの接頭辞と統計の集計単位に使う。
+const (
+	MethodGetVideosByDate    = "GetVideosByDate"
+	MethodGetVideoById       = "GetVideoById"
+	MethodSearchVideos       = "SearchVideos"
+	MethodGetVideosByID      = "GetVideosByID"
+	MethodGetVideosByKeyword = "GetVideosByKeyword"
+)
+
+var catalogMethods = []string{
+	MethodGetVideosByDate,
+	MethodGetVideoById,
+	MethodSearchVideos,
+	MethodGetVideosByID,
+	MethodGetVideosByKeyword,
+}
+
+// CacheConfig はキャッシュの TTL 設定。
+// MethodTTLs に指定のないメソッドは DefaultTTL を使い、TTL が 0 以下のメソッドはキャッシュしない。
+type CacheConfig struct {
+	DefaultTTL time.Duration
+	MethodTTLs map[string]time.Duration
+}
+
+// Validate は MethodTTLs に VideoCatalog にないメソッド名が含まれていないかを検証する。
+func (c CacheConfig) Validate() error {
+	for method := range c.MethodTTLs {
+		if !slices.Contains(catalogMethods, method) {
+			return fmt.Errorf("不明なメソッド名です: %q（指定できるのは %s）", method, strings.Join(catalogMethods, ", "))
+		}
+	}
+	return nil
+}
+
+func (c CacheConfig) ttl(method string) time.Duration {
+	if ttl, ok := c.MethodTTLs[method]; ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+// CacheStats はメソッド単位のヒット・ミス数。
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+type cacheCounter struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// CachedVideoRepository は VideoCatalog をキャッシュで包むデコレーター。
+// DMM API に送るクエリが同一の呼び出しの結果を Backend に保持し、上流 API への呼び出しを減らす。
+type CachedVideoRepository struct {
+	next     port.VideoCatalog
+	backend  cache.Backend
+	config   CacheConfig
+	counters map[string]*cacheCounter
+	logger   *slog.Logger
+}
+
+// NewCachedVideoRepository は next をキャッシュで包んだ VideoCatalog を返す。
+func NewCachedVideoRepository(next port.VideoCatalog, backend cache.Backend, config CacheConfig) *CachedVideoRepository {
+	if next == nil {
+		panic("video catalog must be provided")
+	}
+	if backend == nil {
+		panic("cache backend must be provided")
+	}
+	counters := make(map[string]*cacheCounter, len(catalogMethods))
+	for _, m := range catalogMethods {
+		counters[m] = &cacheCounter{}
+	}
+	return &CachedVideoRepository{
+		next:     next,
+		backend:  backend,
+		config:   config,
+		counters: counters,
+		logger:   slog.Default().With(slog.String("component", "video_cache")),
+	}
+}
+
+// Stats はメソッドごとのヒット・ミス数を返す。起動からの累計で、定期的に stats ログへ記録する。
+func (r *CachedVideoRepository) Stats() map[string]CacheStats {
+	stats := make(map[string]CacheStats, len(r.counters))
+	for method, c := range r.counters {
+		stats[method] = CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+	}
+	return stats
+}
+
+func (r *CachedVideoRepository) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return r.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// videoListEntry は一覧系メソッドのキャッシュ値。
+type videoListEntry struct {
+	Videos   []model.Video         `json:"videos"`
+	Metadata *model.SearchMetadata `json:"metadata"`
+}
+
+// GetVideosByDate は指定日付の動画一覧をキャッシュ経由で取得する
+func (r *CachedVideoRepository) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByDateKey(floor, targetDate, hits, offset)
+	return r.list(ctx, MethodGetVideosByDate, key, func() ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByDate(ctx, floor, targetDate, hits, offset)
+	})
+}
+
+// GetVideoById は指定 ID の動画情報をキャッシュ経由で取得する
+func (r *CachedVideoRepository) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error) {
+	method := MethodGetVideoById
+	ttl := r.config.ttl(method)
+	key := videoByIdKey(floor, dmmId)
+	if ttl <= 0 || key == "" {
+		return r.next.GetVideoById(ctx, floor, dmmId)
+	}
+
+	var cached model.Video
+	if r.load(ctx, method, key, &cached) {
+		return &cached, nil
+	}
+
+	video, err := r.next.GetVideoById(ctx, floor, dmmId)
+	if err != nil {
+		return nil, err
+	}
+	if video != nil {
+		r.store(ctx, key, video, ttl)
+	}
+	return video, nil
+}
+
+// SearchVideos はキーワードやIDによる検索結果をキャッシュ経由で取得する
+func (r *CachedVideoRepository) SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
+	key := searchVideosKey(floor, keyword, actressID, genreID, makerID, seriesID, directorID)
+	return r.list(ctx, MethodSearchVideos, key, func() ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.SearchVideos(ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID)
+	})
+}
+
+// GetVideosByID は複数ID条件の検索結果をキャッシュ経由で取得する
+func (r *CachedVideoRepository) GetVideosByID(
+	ctx context.Context,
+	floor model.FloorSelector,
+	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByIDKey(floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
+	return r.list(ctx, MethodGetVideosByID, key, func() ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByID(ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
+	})
+}
+
+// GetVideosByKeyword はキーワード検索の結果をキャッシュ経由で取得する
+func (r *CachedVideoRepository) GetVideosByKeyword(
+	ctx context.Context,
+	floor model.FloorSelector,
+	keyword string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByKeywordKey(floor, keyword, hits, offset, sort, gteDate, lteDate)
+	return r.list(ctx, MethodGetVideosByKeyword, key, func() ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByKeyword(ctx, floor, keyword, hits, offset, sort, gteDate, lteDate)
+	})
+}
+
+// list は一覧系メソッドに共通するキャッシュ参照・保存処理。
+func (r *CachedVideoRepository) list(
+	ctx context.Context,
+	method, key string,
+	fetch func() ([]model.Video, *model.SearchMetadata, error),
+) ([]model.Video, *model.SearchMetadata, error) {
+	ttl := r.config.ttl(method)
+	if ttl <= 0 || key == "" {
+		return fetch()
+	}
+
+	var cached videoListEntry
+	if r.load(ctx, method, key, &cached) {
+		return cached.Videos, cached.Metadata, nil
+	}
+
+	videos, metadata, err := fetch()
+	if err != nil {
+		return nil, nil, err
+	}
+	r.store(ctx, key, videoListEntry{Videos: videos, Metadata: metadata}, ttl)
+	return videos, metadata, nil
+}
+
+// load はキャッシュから値を読み出して v にデコードし、メソッドごとのヒット・ミス数を数える。
+func (r *CachedVideoRepository) load(ctx context.Context, method, key string, v any) bool {
+	counter := r.counters[method]
+	if !cache.LoadJSON(ctx, r.backend, r.loggerWithCtx(ctx), key, v) {
+		counter.misses.Add(1)
+		return false
+	}
+	counter.hits.Add(1)
+	return true
+}
+
+// store は値をエンコードしてキャッシュへ保存する。
+func (r *CachedVideoRepository) store(ctx context.Context, key string, v any, ttl time.Duration) {
+	cache.StoreJSON(ctx, r.backend, r.loggerWithCtx(ctx), key, v, ttl)
+}
+
+// ensure interface compliance
+var _ port.VideoCatalog = (*CachedVideoRepository)(nil)
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+	"github.com/tikfack/server/internal/infrastructure/cache"
+)
+
+var (
+	testDate     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testVideos   = []model.Video{{DmmID: "vid1", Title: "動画1", CreatedAt: testDate}}
+	testMetadata = &model.SearchMetadata{ResultCount: 1, TotalCount: 1, FirstPosition: 1}
+	testFloor    = model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoa"}
+)
+
+func TestCachedVideoRepository_GetVideosByDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	next.EXPECT().
+		GetVideosByDate(gomock.Any(), testFloor, testDate, int32(10), int32(0)).
+		Return(testVideos, testMetadata, nil).
+		Times(1)
+
+	for i := 0; i < 3; i++ {
+		videos, md, err := repo.GetVideosByDate(ctx, testFloor, testDate, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, testVideos, videos)
+		require.Equal(t, testMetadata, md)
+	}
+
+	stats := repo.Stats()[MethodGetVideosByDate]
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+}
+
+func TestCachedVideoRepository_DifferentParamsAreCachedSeparately(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	next.EXPECT().
+		GetVideosByDate(gomock.Any(), testFloor, testDate, int32(10), int32(0)).
+		Return(testVideos, testMetadata, nil)
+	next.EXPECT().
+		GetVideosByDate(gomock.Any(), testFloor, testDate, int32(10), int32(10)).
+		Return([]model.Video{}, testMetadata, nil)
+
+	_, _, err := repo.GetVideosByDate(ctx, testFloor, testDate, 10, 0)
+	require.NoError(t, err)
+	videos, _, err := repo.GetVideosByDate(ctx, testFloor, testDate, 10, 10)
+	require.NoError(t, err)
+	require.Empty(t, videos)
+}
+
+func TestCachedVideoRepository_DifferentUpstreamQueriesAreNotMerged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	// キーワードの空白・ID の指定順・日時の秒が異なれば、DMM API に送るクエリも異なる
+	next.EXPECT().
+		GetVideosByKeyword(gomock.Any(), testFloor, gomock.Any(), int32(20), int32(0), "date", "", "").
+		Return(testVideos, testMetadata, nil).
+		Times(2)
+	next.EXPECT().
+		GetVideosByID(gomock.Any(), testFloor, gomock.Any(), nil, nil, nil, nil, int32(20), int32(0), "", gomock.Any(), "").
+		Return(testVideos, testMetadata, nil).
+		Times(3)
+
+	for _, keyword := range []string{" 女優  名前 ", "女優 名前", "女優 名前"} {
+		_, _, err := repo.GetVideosByKeyword(ctx, testFloor, keyword, 20, 0, "date", "", "")
+		require.NoError(t, err)
+	}
+	for _, call := range []struct {
+		actressIDs []string
+		gteDate    string
+	}{
+		{[]string{"2", "1"}, "2024-01-01T10:00:00"},
+		{[]string{"1", "2"}, "2024-01-01T10:00:00"},
+		{[]string{"1", "2"}, "2024-01-01T10:00:30"},
+		{[]string{"1", "2"}, "2024-01-01T10:00:30"},
+	} {
+		_, _, err := repo.GetVideosByID(ctx, testFloor, call.actressIDs, nil, nil, nil, nil, 20, 0, "", call.gteDate, "")
+		require.NoError(t, err)
+	}
+}
+
+func TestCachedVideoRepository_InvalidQueriesAreNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	next.EXPECT().
+		GetVideosByKeyword(gomock.Any(), testFloor, "kw", int32(20), int32(0), "newest", "", "").
+		Return(testVideos, testMetadata, nil).
+		Times(2)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := repo.GetVideosByKeyword(ctx, testFloor, "kw", 20, 0, "newest", "", "")
+		require.NoError(t, err)
+	}
+}
+
+func TestCachedVideoRepository_GetVideoById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	next.EXPECT().GetVideoById(gomock.Any(), testFloor, "vid1").Return(&testVideos[0], nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		video, err := repo.GetVideoById(ctx, testFloor, "vid1")
+		require.NoError(t, err)
+		require.Equal(t, "vid1", video.DmmID)
+		require.True(t, video.CreatedAt.Equal(testDate))
+	}
+}
+
+func TestCachedVideoRepository_ErrorsAreNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	upstreamErr := errors.New("upstream failure")
+	gomock.InOrder(
+		next.EXPECT().SearchVideos(gomock.Any(), testFloor, "kw", "", "", "", "", "").Return(nil, nil, upstreamErr),
+		next.EXPECT().SearchVideos(gomock.Any(), testFloor, "kw", "", "", "", "", "").Return(testVideos, testMetadata, nil),
+	)
+
+	_, _, err := repo.SearchVideos(ctx, testFloor, "kw", "", "", "", "", "")
+	require.ErrorIs(t, err, upstreamErr)
+	videos, _, err := repo.SearchVideos(ctx, testFloor, "kw", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, testVideos, videos)
+}
+
+func TestCachedVideoRepository_FloorsAreCachedSeparately(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{DefaultTTL: time.Minute})
+
+	amateur := model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoc"}
+	amateurVideos := []model.Video{{DmmID: "vid2", Title: "動画2", CreatedAt: testDate}}
+	next.EXPECT().GetVideoById(gomock.Any(), testFloor, "vid1").Return(&testVideos[0], nil)
+	next.EXPECT().GetVideoById(gomock.Any(), amateur, "vid1").Return(&amateurVideos[0], nil)
+
+	video, err := repo.GetVideoById(ctx, testFloor, "vid1")
+	require.NoError(t, err)
+	require.Equal(t, "動画1", video.Title)
+
+	video, err = repo.GetVideoById(ctx, amateur, "vid1")
+	require.NoError(t, err)
+	require.Equal(t, "動画2", video.Title)
+}
+
+func TestCachedVideoRepository_MethodTTLDisablesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCachedVideoRepository(next, cache.NewLRU(10), CacheConfig{
+		DefaultTTL: time.Minute,
+		MethodTTLs: map[string]time.Duration{MethodGetVideosByDate: 0},
+	})
+
+	next.EXPECT().
+		GetVideosByDate(gomock.Any(), testFloor, testDate, int32(10), int32(0)).
+		Return(testVideos, testMetadata, nil).
+		Times(2)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := repo.GetVideosByDate(ctx, testFloor, testDate, 10, 0)
+		require.NoError(t, err)
+	}
+	stats := repo.Stats()[MethodGetVideosByDate]
+	require.Zero(t, stats.Hits)
+	require.Zero(t, stats.Misses)
+}
+
+func TestCacheConfig_Validate(t *testing.T) {
+	require.NoError(t, CacheConfig{MethodTTLs: map[string]time.Duration{MethodGetVideoById: time.Hour}}.Validate())
+
+	// 綴りを誤ったメソッド名は指定が効かないまま DefaultTTL が使われるため拒否する
+	err := CacheConfig{MethodTTLs: map[string]time.Duration{"GetVideoByID": time.Hour}}.Validate()
+	require.ErrorContains(t, err, "GetVideoByID")
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// CoalescingVideoRepository は同一クエリで同時に実行中の VideoCatalog 呼び出しを 1 つにまとめるデコレーター。
+// 上流への呼び出しは 1 回だけ行い、待機していたすべての呼び出し元に同じ結果を返す。
+type CoalescingVideoRepository struct {
+	next   port.VideoCatalog
+	group  flightGroup
+	logger *slog.Logger
+}
+
+// NewCoalescingVideoRepository は next の同時呼び出しを集約する VideoCatalog を返す。
+func NewCoalescingVideoRepository(next port.VideoCatalog) *CoalescingVideoRepository {
+	if next == nil {
+		panic("video catalog must be provided")
+	}
+	return &CoalescingVideoRepository{
+		next:   next,
+		logger: slog.Default().With(slog.String("component", "video_coalescer")),
+	}
+}
+
+func (r *CoalescingVideoRepository) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return r.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// GetVideosByDate は同一日付・件数の呼び出しを集約して動画一覧を取得する
+func (r *CoalescingVideoRepository) GetVideosByDate(ctx context.Context, floor model.FloorSelector, targetDate time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByDateKey(floor, targetDate, hits, offset)
+	return r.list(ctx, key, func(ctx context.Context) ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByDate(ctx, floor, targetDate, hits, offset)
+	})
+}
+
+// GetVideoById は同一 ID の呼び出しを集約して動画情報を取得する
+func (r *CoalescingVideoRepository) GetVideoById(ctx context.Context, floor model.FloorSelector, dmmId string) (*model.Video, error) {
+	v, err := r.do(ctx, videoByIdKey(floor, dmmId), func(ctx context.Context) (any, error) {
+		return r.next.GetVideoById(ctx, floor, dmmId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	video, _ := v.(*model.Video)
+	if video == nil {
+		return nil, nil
+	}
+	// 呼び出し元ごとに別の値を返し、共有した結果を書き換えられないようにする
+	copied := *video
+	return &copied, nil
+}
+
+// SearchVideos は同一条件の検索を集約する
+func (r *CoalescingVideoRepository) SearchVideos(ctx context.Context, floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) ([]model.Video, *model.SearchMetadata, error) {
+	key := searchVideosKey(floor, keyword, actressID, genreID, makerID, seriesID, directorID)
+	return r.list(ctx, key, func(ctx context.Context) ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.SearchVideos(ctx, floor, keyword, actressID, genreID, makerID, seriesID, directorID)
+	})
+}
+
+// GetVideosByID は同一条件の複数ID検索を集約する
+func (r *CoalescingVideoRepository) GetVideosByID(
+	ctx context.Context,
+	floor model.FloorSelector,
+	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByIDKey(floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
+	return r.list(ctx, key, func(ctx context.Context) ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByID(ctx, floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate)
+	})
+}
+
+// GetVideosByKeyword は同一条件のキーワード検索を集約する
+func (r *CoalescingVideoRepository) GetVideosByKeyword(
+	ctx context.Context,
+	floor model.FloorSelector,
+	keyword string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) ([]model.Video, *model.SearchMetadata, error) {
+	key := videosByKeywordKey(floor, keyword, hits, offset, sort, gteDate, lteDate)
+	return r.list(ctx, key, func(ctx context.Context) ([]model.Video, *model.SearchMetadata, error) {
+		return r.next.GetVideosByKeyword(ctx, floor, keyword, hits, offset, sort, gteDate, lteDate)
+	})
+}
+
+// list は一覧系メソッドに共通する集約処理。
+func (r *CoalescingVideoRepository) list(
+	ctx context.Context,
+	key string,
+	fetch func(ctx context.Context) ([]model.Video, *model.SearchMetadata, error),
+) ([]model.Video, *model.SearchMetadata, error) {
+	v, err := r.do(ctx, key, func(ctx context.Context) (any, error) {
+		videos, metadata, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return videoListEntry{Videos: videos, Metadata: metadata}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	entry := v.(videoListEntry)
+	var metadata *model.SearchMetadata
+	if entry.Metadata != nil {
+		copied := *entry.Metadata
+		metadata = &copied
+	}
+	return slices.Clone(entry.Videos), metadata, nil
+}
+
+// do は key が同じ実行中の呼び出しがあれば合流し、なければ fn を実行する。key が空の場合は集約しない。
+func (r *CoalescingVideoRepository) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	if key == "" {
+		return fn(ctx)
+	}
+	v, shared, err := r.group.do(ctx, key, fn)
+	if shared {
+		r.loggerWithCtx(ctx).Debug("joined in-flight catalog call", "key", key)
+	}
+	return v, err
+}
+
+// ensure interface compliance
+var _ port.VideoCatalog = (*CoalescingVideoRepository)(nil)
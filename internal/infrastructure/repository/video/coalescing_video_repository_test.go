@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/tikfack/server/internal/application/model"
+	mockcatalog "github.com/tikfack/server/internal/application/port/mock"
+)
+
+// waiters は key の実行中の呼び出しを待っている呼び出し元の数を返す。
+func waiters(repo *CoalescingVideoRepository, key string) int {
+	repo.group.mu.Lock()
+	defer repo.group.mu.Unlock()
+	if c, ok := repo.group.calls[key]; ok {
+		return c.waiters
+	}
+	return 0
+}
+
+func TestCoalescingVideoRepository_SharesInFlightCall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCoalescingVideoRepository(next)
+
+	release := make(chan struct{})
+	next.EXPECT().
+		GetVideosByDate(gomock.Any(), testFloor, testDate, int32(10), int32(0)).
+		DoAndReturn(func(ctx context.Context, _ model.FloorSelector, _ time.Time, _, _ int32) ([]model.Video, *model.SearchMetadata, error) {
+			<-release
+			return testVideos, testMetadata, nil
+		}).
+		Times(1)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([][]model.Video, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			videos, md, err := repo.GetVideosByDate(context.Background(), testFloor, testDate, 10, 0)
+			require.NoError(t, err)
+			require.Equal(t, testMetadata, md)
+			results[i] = videos
+		}(i)
+	}
+	key := videosByDateKey(testFloor, testDate, 10, 0)
+	require.Eventually(t, func() bool { return waiters(repo, key) == callers }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, videos := range results {
+		require.Equal(t, testVideos, videos)
+	}
+}
+
+func TestCoalescingVideoRepository_LeaderCancellationDoesNotAffectWaiters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCoalescingVideoRepository(next)
+
+	release := make(chan struct{})
+	upstreamCtx := make(chan context.Context, 1)
+	next.EXPECT().
+		GetVideoById(gomock.Any(), testFloor, "vid1").
+		DoAndReturn(func(ctx context.Context, _ model.FloorSelector, _ string) (*model.Video, error) {
+			upstreamCtx <- ctx
+			<-release
+			return &testVideos[0], nil
+		}).
+		Times(1)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := repo.GetVideoById(leaderCtx, testFloor, "vid1")
+		leaderErr <- err
+	}()
+	ctx := <-upstreamCtx
+
+	followerDone := make(chan *model.Video, 1)
+	go func() {
+		video, err := repo.GetVideoById(context.Background(), testFloor, "vid1")
+		require.NoError(t, err)
+		followerDone <- video
+	}()
+	key := videoByIdKey(testFloor, "vid1")
+	require.Eventually(t, func() bool { return waiters(repo, key) == 2 }, time.Second, time.Millisecond)
+
+	cancelLeader()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+	require.NoError(t, ctx.Err(), "待機者が残っている間は上流の呼び出しを継続する")
+
+	close(release)
+	video := <-followerDone
+	require.Equal(t, "vid1", video.DmmID)
+}
+
+func TestCoalescingVideoRepository_AllWaitersCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCoalescingVideoRepository(next)
+
+	upstreamCtx := make(chan context.Context, 1)
+	gomock.InOrder(
+		next.EXPECT().
+			SearchVideos(gomock.Any(), testFloor, "kw", "", "", "", "", "").
+			DoAndReturn(func(ctx context.Context, _ model.FloorSelector, _, _, _, _, _, _ string) ([]model.Video, *model.SearchMetadata, error) {
+				upstreamCtx <- ctx
+				<-ctx.Done()
+				return nil, nil, ctx.Err()
+			}),
+		next.EXPECT().
+			SearchVideos(gomock.Any(), testFloor, "kw", "", "", "", "", "").
+			Return(testVideos, testMetadata, nil),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, _, err := repo.SearchVideos(ctx, testFloor, "kw", "", "", "", "", "")
+		errCh <- err
+	}()
+	upstream := <-upstreamCtx
+	cancel()
+
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	require.Eventually(t, func() bool { return upstream.Err() != nil }, time.Second, time.Millisecond,
+		"待機者がいなくなったら上流の呼び出しをキャンセルする")
+
+	// 打ち切った呼び出しには合流せず、新しく実行する
+	videos, _, err := repo.SearchVideos(context.Background(), testFloor, "kw", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, testVideos, videos)
+}
+
+func TestCoalescingVideoRepository_ResultsAreNotRetained(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCoalescingVideoRepository(next)
+
+	upstreamErr := errors.New("upstream failure")
+	gomock.InOrder(
+		next.EXPECT().GetVideosByKeyword(gomock.Any(), testFloor, "kw", int32(20), int32(0), "", "", "").Return(nil, nil, upstreamErr),
+		next.EXPECT().GetVideosByKeyword(gomock.Any(), testFloor, "kw", int32(20), int32(0), "", "", "").Return(testVideos, testMetadata, nil),
+	)
+
+	_, _, err := repo.GetVideosByKeyword(context.Background(), testFloor, "kw", 20, 0, "", "", "")
+	require.ErrorIs(t, err, upstreamErr)
+	videos, _, err := repo.GetVideosByKeyword(context.Background(), testFloor, "kw", 20, 0, "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, testVideos, videos)
+}
+
+func TestCoalescingVideoRepository_SharedResultsAreCopied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	next := mockcatalog.NewMockVideoCatalog(ctrl)
+	repo := NewCoalescingVideoRepository(next)
+
+	upstream := []model.Video{{DmmID: "vid1", Title: "動画1"}}
+	next.EXPECT().
+		GetVideosByID(gomock.Any(), testFloor, []string{"1"}, nil, nil, nil, nil, int32(20), int32(0), "", "", "").
+		Return(upstream, testMetadata, nil)
+
+	videos, _, err := repo.GetVideosByID(context.Background(), testFloor, []string{"1"}, nil, nil, nil, nil, 20, 0, "", "", "")
+	require.NoError(t, err)
+	videos[0].Title = "changed"
+	require.Equal(t, "動画1", upstream[0].Title)
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// flightGroup は同一キーで実行中の呼び出しを 1 つにまとめる。
+// golang.org/x/sync/singleflight と異なり、呼び出しは呼び出し元のキャンセルから切り離して実行し、
+// 結果を待つ呼び出し元が全員いなくなった時点でキャンセルする。
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done    chan struct{}
+	val     any
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do は key の呼び出しが実行中であればその結果を待ち、なければ fn を実行する。
+// shared は実行中の他の呼び出しの結果を受け取ったかどうかを表す。
+// ctx が先に終了した場合は ctx.Err() を返し、実行中の呼び出しは他の待機者のために継続する。
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (v any, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if c, ok := g.calls[key]; ok {
+		c.waiters++
+		g.mu.Unlock()
+		return g.wait(ctx, key, c, true)
+	}
+
+	// 値（トレース ID など）は引き継ぎ、キャンセルと期限は待機者の有無で制御する
+	callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	c := &flightCall{done: make(chan struct{}), waiters: 1, cancel: cancel}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	go g.run(callCtx, key, c, fn)
+	return g.wait(ctx, key, c, false)
+}
+
+func (g *flightGroup) run(ctx context.Context, key string, c *flightCall, fn func(ctx context.Context) (any, error)) {
+	defer c.cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			c.val, c.err = nil, fmt.Errorf("coalesced call panicked: %v", r)
+		}
+		g.forget(key, c)
+		close(c.done)
+	}()
+	c.val, c.err = fn(ctx)
+}
+
+func (g *flightGroup) wait(ctx context.Context, key string, c *flightCall, shared bool) (any, bool, error) {
+	select {
+	case <-c.done:
+		return c.val, shared, c.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		c.waiters--
+		abandoned := c.waiters == 0
+		if abandoned && g.calls[key] == c {
+			// 以降の呼び出しは打ち切った呼び出しに合流させず、新しく実行する
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+		if abandoned {
+			// 誰も結果を待っていないので上流の呼び出しを打ち切る
+			c.cancel()
+		}
+		return nil, shared, ctx.Err()
+	}
+}
+
+// forget は key に c が登録されたままであれば取り除く。
+func (g *flightGroup) forget(key string, c *flightCall) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/infrastructure/dmmapi"
+)
+
+// リクエストキーはキャッシュと呼び出しの集約で共通に使う。
+// 同じキーになる呼び出しは同じ結果を返すものとして扱うため、キーは DMM API に送るクエリそのものから作る。
+// 検証に失敗するクエリは上流を呼ばずに失敗するため、キーは空になり、キャッシュも集約もしない。
+
+func videosByDateKey(floor model.FloorSelector, targetDate time.Time, hits, offset int32) string {
+	return requestKey(MethodGetVideosByDate, dmmapi.VideosByDateQuery(floor, targetDate, hits, offset))
+}
+
+func videoByIdKey(floor model.FloorSelector, dmmId string) string {
+	return requestKey(MethodGetVideoById, dmmapi.VideoByIdQuery(floor, dmmId))
+}
+
+func searchVideosKey(floor model.FloorSelector, keyword, actressID, genreID, makerID, seriesID, directorID string) string {
+	return requestKey(MethodSearchVideos, dmmapi.SearchVideosQuery(floor, keyword, actressID, genreID, makerID, seriesID, directorID))
+}
+
+func videosByIDKey(
+	floor model.FloorSelector,
+	actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs []string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) string {
+	return requestKey(MethodGetVideosByID, dmmapi.VideosByIDQuery(floor, actressIDs, genreIDs, makerIDs, seriesIDs, directorIDs, hits, offset, sort, gteDate, lteDate))
+}
+
+func videosByKeywordKey(
+	floor model.FloorSelector,
+	keyword string,
+	hits, offset int32,
+	sort, gteDate, lteDate string,
+) string {
+	return requestKey(MethodGetVideosByKeyword, dmmapi.VideosByKeywordQuery(floor, keyword, hits, offset, sort, gteDate, lteDate))
+}
+
+// requestKey はメソッド名と、DMM API に送るクエリをエンコードした文字列からキーを生成する。
+// 一覧と 1 件取得では結果の形が異なるため、同じクエリでもメソッドごとに別のキーにする。
+// url.Values.Encode はキー順にソートし値をエスケープするため、区切り文字の衝突が起きない。
+func requestKey(method string, query dmmapi.ItemListQuery) string {
+	values, err := query.Values()
+	if err != nil {
+		return ""
+	}
+	return "video:" + method + "?" + values.Encode()
+}
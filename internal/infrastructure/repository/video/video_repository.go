@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/tikfack/server/internal/application/port"
 	"github.com/tikfack/server/internal/infrastructure/dmmapi"
 )
@@ -10,3 +12,8 @@ import (
 func NewVideoRepository() (port.VideoCatalog, error) {
 	return dmmapi.NewRepository()
 }
+
+// NewFloorCatalog は起動時に FloorList API から読み込んだ FloorCatalog を返す。
+func NewFloorCatalog(ctx context.Context) (port.FloorCatalog, error) {
+	return dmmapi.NewFloorRegistry(ctx)
+}
@@ -0,0 +1,114 @@
+package watchhistory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// MemoryWatchHistoryRepository provides in-memory storage for watch history.
+type MemoryWatchHistoryRepository struct {
+	mu            sync.RWMutex
+	historyByUser map[string]map[string]*entity.WatchHistory
+}
+
+// NewMemoryWatchHistoryRepository constructs a new watch history repository instance.
+func NewMemoryWatchHistoryRepository() *MemoryWatchHistoryRepository {
+	return &MemoryWatchHistoryRepository{
+		historyByUser: make(map[string]map[string]*entity.WatchHistory),
+	}
+}
+
+// Get returns the history of a video for a user.
+func (r *MemoryWatchHistoryRepository) Get(ctx context.Context, userID, videoID string) (*entity.WatchHistory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if history, ok := r.historyByUser[userID][videoID]; ok {
+		copied := *history
+		return &copied, nil
+	}
+	return nil, repository.ErrWatchHistoryNotFound
+}
+
+// Upsert stores the history unless a newer event has already been stored.
+func (r *MemoryWatchHistoryRepository) Upsert(ctx context.Context, history *entity.WatchHistory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userHistory, ok := r.historyByUser[history.UserID]
+	if !ok {
+		userHistory = make(map[string]*entity.WatchHistory)
+		r.historyByUser[history.UserID] = userHistory
+	}
+	stored := *history
+	if existing, ok := userHistory[history.VideoID]; ok {
+		if existing.LastEventAt.After(history.LastEventAt) {
+			return nil
+		}
+		stored.Completed = stored.Completed || existing.Completed
+	}
+	stored.UpdatedAt = time.Now()
+	history.UpdatedAt = stored.UpdatedAt
+	userHistory[history.VideoID] = &stored
+	return nil
+}
+
+// ListByUserID lists a user's history, most recently watched first.
+func (r *MemoryWatchHistoryRepository) ListByUserID(ctx context.Context, userID string, opts repository.WatchHistoryListOptions) ([]entity.WatchHistory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]entity.WatchHistory, 0, len(r.historyByUser[userID]))
+	for _, history := range r.historyByUser[userID] {
+		if opts.InProgressOnly && history.ResumePositionSeconds() <= 0 {
+			continue
+		}
+		if opts.After != nil && !watchedBefore(*history, *opts.After) {
+			continue
+		}
+		result = append(result, *history)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return watchedBefore(result[j], repository.WatchHistoryCursor{LastEventAt: result[i].LastEventAt, VideoID: result[i].VideoID})
+	})
+	if opts.Limit > 0 && len(result) > opts.Limit {
+		result = result[:opts.Limit]
+	}
+	return result, nil
+}
+
+// Delete removes the given videos from a user's history, or the whole history when videoIDs is empty.
+func (r *MemoryWatchHistoryRepository) Delete(ctx context.Context, userID string, videoIDs []string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userHistory := r.historyByUser[userID]
+	if len(videoIDs) == 0 {
+		delete(r.historyByUser, userID)
+		return len(userHistory), nil
+	}
+	removed := 0
+	for _, videoID := range videoIDs {
+		if _, ok := userHistory[videoID]; ok {
+			delete(userHistory, videoID)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// watchedBefore reports whether h comes after the cursor in (last_event_at DESC, video_id DESC) order.
+func watchedBefore(h entity.WatchHistory, c repository.WatchHistoryCursor) bool {
+	if !h.LastEventAt.Equal(c.LastEventAt) {
+		return h.LastEventAt.Before(c.LastEventAt)
+	}
+	return h.VideoID < c.VideoID
+}
+
+// ensure interface compliance
+var _ repository.WatchHistoryRepository = (*MemoryWatchHistoryRepository)(nil)
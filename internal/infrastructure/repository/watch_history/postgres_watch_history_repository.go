@@ -0,0 +1,131 @@
+package watchhistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/tikfack/server/internal/domain/entity"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// PostgresWatchHistoryRepository stores watch history in the watch_history table, one row per user and video.
+type PostgresWatchHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresWatchHistoryRepository creates a new PostgresWatchHistoryRepository.
+func NewPostgresWatchHistoryRepository(db *sql.DB) *PostgresWatchHistoryRepository {
+	return &PostgresWatchHistoryRepository{db: db}
+}
+
+const watchHistoryColumns = `user_id, video_id, position_seconds, duration_seconds, completed, last_event_at, updated_at`
+
+// inProgressCondition matches entries that can be resumed; it mirrors entity.WatchHistory.ResumePositionSeconds.
+var inProgressCondition = fmt.Sprintf(`position_seconds > 0 AND NOT (duration_seconds > 0 AND position_seconds >= duration_seconds * %g)`,
+	entity.CompletedProgress)
+
+// Get returns the history of a video for a user.
+func (r *PostgresWatchHistoryRepository) Get(ctx context.Context, userID, videoID string) (*entity.WatchHistory, error) {
+	query := `SELECT ` + watchHistoryColumns + ` FROM watch_history WHERE user_id = $1 AND video_id = $2`
+	history := &entity.WatchHistory{}
+	if err := scanWatchHistory(r.db.QueryRowContext(ctx, query, userID, videoID), history); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrWatchHistoryNotFound
+		}
+		return nil, err
+	}
+	return history, nil
+}
+
+// Upsert inserts or updates the history. The update is skipped when the stored row already reflects a newer event,
+// so events applied out of order or by concurrent consumers never move the position backwards.
+func (r *PostgresWatchHistoryRepository) Upsert(ctx context.Context, history *entity.WatchHistory) error {
+	query := `
+INSERT INTO watch_history (` + watchHistoryColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+ON CONFLICT (user_id, video_id) DO UPDATE SET
+	position_seconds = EXCLUDED.position_seconds,
+	duration_seconds = EXCLUDED.duration_seconds,
+	completed = watch_history.completed OR EXCLUDED.completed,
+	last_event_at = EXCLUDED.last_event_at,
+	updated_at = NOW()
+WHERE watch_history.last_event_at <= EXCLUDED.last_event_at
+RETURNING updated_at
+`
+	err := r.db.QueryRowContext(ctx, query, history.UserID, history.VideoID, history.PositionSeconds, history.DurationSeconds,
+		history.Completed, history.LastEventAt).Scan(&history.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		// a newer event has already been stored
+		return nil
+	}
+	return err
+}
+
+// ListByUserID lists a user's history, most recently watched first.
+func (r *PostgresWatchHistoryRepository) ListByUserID(ctx context.Context, userID string, opts repository.WatchHistoryListOptions) ([]entity.WatchHistory, error) {
+	var sb strings.Builder
+	args := []any{userID}
+	sb.WriteString(`SELECT ` + watchHistoryColumns + ` FROM watch_history WHERE user_id = $1`)
+	if opts.InProgressOnly {
+		sb.WriteString(` AND ` + inProgressCondition)
+	}
+	if opts.After != nil {
+		args = append(args, opts.After.LastEventAt, opts.After.VideoID)
+		fmt.Fprintf(&sb, ` AND (last_event_at, video_id) < ($%d, $%d)`, len(args)-1, len(args))
+	}
+	sb.WriteString(` ORDER BY last_event_at DESC, video_id DESC`)
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		fmt.Fprintf(&sb, ` LIMIT $%d`, len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var histories []entity.WatchHistory
+	for rows.Next() {
+		var history entity.WatchHistory
+		if err := scanWatchHistory(rows, &history); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
+
+// Delete removes the given videos from a user's history, or the whole history when videoIDs is empty.
+func (r *PostgresWatchHistoryRepository) Delete(ctx context.Context, userID string, videoIDs []string) (int, error) {
+	query := `DELETE FROM watch_history WHERE user_id = $1 AND (COALESCE(cardinality($2::text[]), 0) = 0 OR video_id = ANY($2))`
+	result, err := r.db.ExecContext(ctx, query, userID, pq.Array(videoIDs))
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWatchHistory(row rowScanner, history *entity.WatchHistory) error {
+	return row.Scan(&history.UserID, &history.VideoID, &history.PositionSeconds, &history.DurationSeconds,
+		&history.Completed, &history.LastEventAt, &history.UpdatedAt)
+}
+
+// ensure interface compliance
+var _ repository.WatchHistoryRepository = (*PostgresWatchHistoryRepository)(nil)
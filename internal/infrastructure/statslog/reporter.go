@@ -0,0 +1,55 @@
+// Package statslog は起動からの累計の統計を一定間隔でログに記録する。
+package statslog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Source は記録する統計の提供元。
+type Source struct {
+	// Name はログの source 属性に設定する名前。
+	Name string
+	// Attrs は記録する時点の統計を slog の属性（キーと値の組）で返す。
+	Attrs func() []any
+}
+
+// Reporter は登録された提供元の統計を interval ごとに Info ログに記録する。
+type Reporter struct {
+	interval time.Duration
+	sources  []Source
+	logger   *slog.Logger
+}
+
+// NewReporter は sources の統計を interval ごとに記録する Reporter を返す。
+func NewReporter(interval time.Duration, sources ...Source) *Reporter {
+	if interval <= 0 {
+		panic("stats log interval must be positive")
+	}
+	return &Reporter{
+		interval: interval,
+		sources:  sources,
+		logger:   slog.Default().With(slog.String("component", "stats")),
+	}
+}
+
+// Run は ctx が終了するまで統計を記録し、終了時は ctx.Err() を返す。
+func (r *Reporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.report()
+		}
+	}
+}
+
+func (r *Reporter) report() {
+	for _, s := range r.sources {
+		r.logger.Info("stats", append([]any{"source", s.Name}, s.Attrs()...)...)
+	}
+}
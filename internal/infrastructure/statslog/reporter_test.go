@@ -0,0 +1,55 @@
+package statslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer はログの書き込みとテストからの読み出しを排他する。
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestReporter_LogsEachSourceUntilCanceled(t *testing.T) {
+	var out syncBuffer
+	var calls int
+	r := NewReporter(5*time.Millisecond, Source{Name: "limiter", Attrs: func() []any {
+		calls++
+		return []any{"waited_total", calls}
+	}})
+	r.logger = slog.New(slog.NewJSONHandler(&out, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- r.Run(ctx) }()
+	require.Eventually(t, func() bool { return bytes.Count([]byte(out.String()), []byte("\n")) >= 2 }, time.Second, time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	var first map[string]any
+	line, _, _ := bytes.Cut([]byte(out.String()), []byte("\n"))
+	require.NoError(t, json.Unmarshal(line, &first))
+	require.Equal(t, "stats", first["msg"])
+	require.Equal(t, "limiter", first["source"])
+	require.Equal(t, float64(1), first["waited_total"])
+}
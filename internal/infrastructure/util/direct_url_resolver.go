@@ -0,0 +1,201 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/tikfack/server/internal/infrastructure/cache"
+)
+
+// ErrDirectURLNotFound は候補 URL のいずれも再生可能でなかったことを表す。
+var ErrDirectURLNotFound = errors.New("有効な動画URLが見つかりませんでした")
+
+// DirectURLResolver の既定値
+const (
+	DefaultDirectURLConcurrency  = 8
+	DefaultDirectURLHeadTimeout  = 3 * time.Second
+	DefaultDirectURLPositiveTTL  = 24 * time.Hour
+	DefaultDirectURLNegativeTTL  = 10 * time.Minute
+	DefaultDirectURLCacheEntries = 10000
+)
+
+var (
+	dmmIDPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+	reAlt0       = regexp.MustCompile(`^([A-Za-z0-9_]+?)0(\d+)([A-Za-z])?$`)
+	reAlt00      = regexp.MustCompile(`^([A-Za-z0-9_]+?)00(\d+)([A-Za-z])?$`)
+)
+
+// IsValidDmmID は id が DMM の content_id に使われる文字（英小文字・数字・アンダースコア）だけで構成されているかを返す。
+// 候補 URL のパスにそのまま埋め込むため、それ以外の文字を含む id は扱わない。
+func IsValidDmmID(id string) bool {
+	return dmmIDPattern.MatchString(id)
+}
+
+// DirectURLResolverConfig は DirectURLResolver の設定。
+type DirectURLResolverConfig struct {
+	// Concurrency は同時に実行する解決処理の上限（プロセス全体で共有）。0 以下の場合は制限しない。
+	Concurrency int
+	// HeadTimeout は HEAD リクエスト 1 件あたりのタイムアウト。0 の場合は呼び出し元のコンテキストに従う。
+	HeadTimeout time.Duration
+	// PositiveTTL・NegativeTTL は解決できた結果・見つからなかった結果を保持する期間。0 以下の場合は保持しない。
+	PositiveTTL time.Duration
+	NegativeTTL time.Duration
+	// MaxEntries はキャッシュの最大エントリ数。0 以下の場合はキャッシュしない。
+	MaxEntries int
+}
+
+// DefaultDirectURLResolverConfig は既定の設定を返す。
+func DefaultDirectURLResolverConfig() DirectURLResolverConfig {
+	return DirectURLResolverConfig{
+		Concurrency: DefaultDirectURLConcurrency,
+		HeadTimeout: DefaultDirectURLHeadTimeout,
+		PositiveTTL: DefaultDirectURLPositiveTTL,
+		NegativeTTL: DefaultDirectURLNegativeTTL,
+		MaxEntries:  DefaultDirectURLCacheEntries,
+	}
+}
+
+// DirectURLResolver は DMM ID から動画の直接再生 URL を解決する。
+// 候補 URL を HEAD リクエストで検証し、結果（見つからなかった場合を含む）を TTL 付きでキャッシュする。
+// 複数のゴルーチンから共有して使う。
+type DirectURLResolver struct {
+	httpClient *http.Client
+	config     DirectURLResolverConfig
+	sem        chan struct{}
+	cache      cache.Backend
+}
+
+// NewDirectURLResolver は新しい DirectURLResolver を返す。
+func NewDirectURLResolver(config DirectURLResolverConfig) *DirectURLResolver {
+	r := &DirectURLResolver{config: config}
+	if config.Concurrency > 0 {
+		r.sem = make(chan struct{}, config.Concurrency)
+	}
+	if config.MaxEntries > 0 {
+		r.cache = cache.NewLRU(config.MaxEntries)
+	}
+	return r
+}
+
+// Resolve は dmmID の直接再生 URL を返す。
+// 再生可能な URL がない場合は ErrDirectURLNotFound を、通信エラーなどで判定できなかった場合はそのエラーを返す。
+func (r *DirectURLResolver) Resolve(ctx context.Context, dmmID string) (string, error) {
+	key := "direct_url:" + dmmID
+	if url, found := r.load(ctx, key); found {
+		if url == "" {
+			return "", fmt.Errorf("%w: %s", ErrDirectURLNotFound, dmmID)
+		}
+		return url, nil
+	}
+
+	if r.sem != nil {
+		select {
+		case r.sem <- struct{}{}:
+			defer func() { <-r.sem }()
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	url, err := r.probe(ctx, dmmID)
+	switch {
+	case err == nil:
+		r.store(ctx, key, url, r.config.PositiveTTL)
+		return url, nil
+	case errors.Is(err, ErrDirectURLNotFound):
+		r.store(ctx, key, "", r.config.NegativeTTL)
+		return "", err
+	default:
+		// 一時的な失敗は結果をキャッシュしない
+		return "", err
+	}
+}
+
+// probe は候補 URL を優先順に検証し、最初に 2xx を返した URL を返す。
+func (r *DirectURLResolver) probe(ctx context.Context, dmmID string) (string, error) {
+	var lastErr error
+	for _, url := range candidateURLs(dmmID) {
+		ok, err := r.head(ctx, url)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			lastErr = err
+			continue
+		}
+		if ok {
+			return url, nil
+		}
+	}
+	if lastErr != nil {
+		return "", fmt.Errorf("動画URLの検証に失敗しました: %s: %w", dmmID, lastErr)
+	}
+	return "", fmt.Errorf("%w: %s", ErrDirectURLNotFound, dmmID)
+}
+
+func (r *DirectURLResolver) head(ctx context.Context, url string) (bool, error) {
+	if r.config.HeadTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.config.HeadTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, err
+	}
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode >= 200 && resp.StatusCode < 300, nil
+}
+
+func (r *DirectURLResolver) load(ctx context.Context, key string) (string, bool) {
+	if r.cache == nil {
+		return "", false
+	}
+	raw, found, err := r.cache.Get(ctx, key)
+	if err != nil || !found {
+		return "", false
+	}
+	return string(raw), true
+}
+
+func (r *DirectURLResolver) store(ctx context.Context, key, url string, ttl time.Duration) {
+	if r.cache == nil || ttl <= 0 {
+		return
+	}
+	_ = r.cache.Set(ctx, key, []byte(url), ttl)
+}
+
+// candidateURLs は DMM ID から推定される配信 URL の候補を優先順に返す。
+// 品番の数字部分のゼロ埋めが異なる場合に備え、先頭の 0・00 を除いた ID も候補にする。
+func candidateURLs(dmmID string) []string {
+	ids := []string{
+		dmmID,
+		reAlt0.ReplaceAllString(dmmID, "$1$2$3"),
+		reAlt00.ReplaceAllString(dmmID, "$1$2$3"),
+	}
+	urls := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if len(id) < 3 || !IsValidDmmID(id) {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		urls = append(urls, fmt.Sprintf("https://cc3001.dmm.co.jp/litevideo/freepv/%s/%s/%s/%smhb.mp4", id[0:1], id[0:3], id, id))
+	}
+	return urls
+}
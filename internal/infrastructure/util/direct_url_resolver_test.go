@@ -0,0 +1,193 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func statusResponse(code int) *http.Response {
+	return &http.Response{StatusCode: code, Header: make(http.Header), Body: http.NoBody}
+}
+
+func newTestResolver(config DirectURLResolverConfig, rt roundTripFunc) *DirectURLResolver {
+	r := NewDirectURLResolver(config)
+	r.httpClient = &http.Client{Transport: rt}
+	return r
+}
+
+func TestCandidateURLs(t *testing.T) {
+	tests := []struct {
+		name  string
+		dmmID string
+		want  []string
+	}{
+		{
+			name:  "ゼロ埋めの異なる ID も候補にする",
+			dmmID: "abc00123",
+			want: []string{
+				"https://cc3001.dmm.co.jp/litevideo/freepv/a/abc/abc00123/abc00123mhb.mp4",
+				"https://cc3001.dmm.co.jp/litevideo/freepv/a/abc/abc0123/abc0123mhb.mp4",
+				"https://cc3001.dmm.co.jp/litevideo/freepv/a/abc/abc123/abc123mhb.mp4",
+			},
+		},
+		{
+			name:  "同じ候補は重複させない",
+			dmmID: "abc123",
+			want:  []string{"https://cc3001.dmm.co.jp/litevideo/freepv/a/abc/abc123/abc123mhb.mp4"},
+		},
+		{
+			name:  "短すぎる ID は候補なし",
+			dmmID: "ab",
+			want:  []string{},
+		},
+		{
+			name:  "content_id の文字種以外を含む ID は候補なし",
+			dmmID: "../abc123",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, candidateURLs(tt.dmmID))
+		})
+	}
+}
+
+func TestDirectURLResolver_FallsBackToAlternativeCandidate(t *testing.T) {
+	resolver := newTestResolver(DefaultDirectURLResolverConfig(), func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodHead, req.Method)
+		if req.URL.Path == "/litevideo/freepv/a/abc/abc123/abc123mhb.mp4" {
+			return statusResponse(http.StatusOK), nil
+		}
+		return statusResponse(http.StatusNotFound), nil
+	})
+
+	url, err := resolver.Resolve(context.Background(), "abc00123")
+	require.NoError(t, err)
+	assert.Equal(t, "https://cc3001.dmm.co.jp/litevideo/freepv/a/abc/abc123/abc123mhb.mp4", url)
+}
+
+func TestDirectURLResolver_CachesResults(t *testing.T) {
+	var calls atomic.Int32
+	resolver := newTestResolver(DefaultDirectURLResolverConfig(), func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		if req.URL.Path == "/litevideo/freepv/a/abc/abc123/abc123mhb.mp4" {
+			return statusResponse(http.StatusOK), nil
+		}
+		return statusResponse(http.StatusNotFound), nil
+	})
+	ctx := context.Background()
+
+	// 解決できた結果
+	for i := 0; i < 3; i++ {
+		url, err := resolver.Resolve(ctx, "abc123")
+		require.NoError(t, err)
+		assert.NotEmpty(t, url)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	// 見つからなかった結果もキャッシュする
+	calls.Store(0)
+	for i := 0; i < 3; i++ {
+		_, err := resolver.Resolve(ctx, "xyz999")
+		require.ErrorIs(t, err, ErrDirectURLNotFound)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDirectURLResolver_DoesNotCacheTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	resolver := newTestResolver(DefaultDirectURLResolverConfig(), func(req *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return statusResponse(http.StatusOK), nil
+	})
+	ctx := context.Background()
+
+	_, err := resolver.Resolve(ctx, "abc123")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrDirectURLNotFound)
+
+	url, err := resolver.Resolve(ctx, "abc123")
+	require.NoError(t, err)
+	assert.NotEmpty(t, url)
+}
+
+func TestDirectURLResolver_HeadTimeout(t *testing.T) {
+	config := DefaultDirectURLResolverConfig()
+	config.HeadTimeout = 20 * time.Millisecond
+	resolver := newTestResolver(config, func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	start := time.Now()
+	_, err := resolver.Resolve(context.Background(), "abc123")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDirectURLResolver_ContextCanceled(t *testing.T) {
+	var calls atomic.Int32
+	resolver := newTestResolver(DefaultDirectURLResolverConfig(), func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := resolver.Resolve(ctx, "abc00123")
+	require.ErrorIs(t, err, context.Canceled)
+	// 呼び出し元がキャンセルしたら残りの候補は検証しない
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDirectURLResolver_BoundsConcurrency(t *testing.T) {
+	const limit = 3
+	config := DefaultDirectURLResolverConfig()
+	config.Concurrency = limit
+	var inFlight, peak atomic.Int32
+	resolver := newTestResolver(config, func(req *http.Request) (*http.Response, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return statusResponse(http.StatusOK), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := resolver.Resolve(context.Background(), fmt.Sprintf("abc%03d", i))
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, peak.Load(), int32(limit))
+	assert.Greater(t, peak.Load(), int32(1))
+}
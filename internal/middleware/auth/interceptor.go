@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/http"
 	"strings"
 
 	"github.com/bufbuild/connect-go"
@@ -26,8 +27,12 @@ var (
 
 // extractBearerToken extracts Bearer token from Authorization header
 func extractBearerToken(req connect.AnyRequest) (string, error) {
-	header := req.Header().Get("Authorization")
-	parts := strings.SplitN(header, " ", 2)
+	return bearerToken(req.Header())
+}
+
+// bearerToken extracts Bearer token from the Authorization header of a request or stream
+func bearerToken(header http.Header) (string, error) {
+	parts := strings.SplitN(header.Get("Authorization"), " ", 2)
 	if len(parts) != 2 || parts[0] != "Bearer" {
 		return "", ErrInvalidAuthHeader
 	}
@@ -175,50 +180,13 @@ func IntrospectionInterceptorWithInterfaces(
 	clientID,
 	clientSecret string,
 ) connect.Interceptor {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// 認証ヘッダが無ければスキップ（非ログインアクセスを許可）
-			if strings.TrimSpace(req.Header().Get("Authorization")) == "" {
-				return next(ctx, req)
-			}
-
-			// 1) Authorization ヘッダ取得
-			token, err := extractBearerToken(req)
-			if err != nil {
-				slog.Error("failed to extract bearer token", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-
-			// 2) go-oidc で署名検証 & sub 取得
-			idt, err := verifier.Verify(ctx, token)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-			var claims struct {
-				Sub string `json:"sub"`
-			}
-			if err := idt.Claims(&claims); err != nil {
-				slog.Error("failed to extract claims", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-
-			// 3) IntrospectToken（＝Token Introspection エンドポイント呼び出し）
-			result, err := client.RetrospectToken(ctx, token, clientID, clientSecret, realm)
-			if err != nil {
-				slog.Error("failed to introspect token", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-			if result.Active == nil || !*result.Active {
-				slog.Warn("token is not active")
-				return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenNotActive)
-			}
-
-			ctx = context.WithValue(ctx, ctxkeys.TokenKey, token)
-			ctx = context.WithValue(ctx, ctxkeys.SubKey, claims.Sub)
-
-			return next(ctx, req)
-		}
-	})
+	return &introspectionInterceptor{
+		verifier:     verifier,
+		client:       client,
+		realm:        realm,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+	}
 }
 
 func IntrospectionInterceptor(
@@ -228,50 +196,90 @@ func IntrospectionInterceptor(
 	clientID,
 	clientSecret string,
 ) connect.Interceptor {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// 認証ヘッダが無ければスキップ（非ログインアクセスを許可）
-			if strings.TrimSpace(req.Header().Get("Authorization")) == "" {
-				return next(ctx, req)
-			}
-
-			// 1) Authorization ヘッダ取得
-			token, err := extractBearerToken(req)
-			if err != nil {
-				slog.Error("failed to extract bearer token", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
+	return IntrospectionInterceptorWithInterfaces(
+		mock.NewIDTokenVerifierWrapper(verifier),
+		mock.NewGocloakClientWrapper(client),
+		realm,
+		clientID,
+		clientSecret,
+	)
+}
 
-			// 2) go-oidc で署名検証 & sub 取得
-			idt, err := verifier.Verify(ctx, token)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-			var claims struct {
-				Sub string `json:"sub"`
-			}
-			if err := idt.Claims(&claims); err != nil {
-				slog.Error("failed to extract claims", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
+// introspectionInterceptor verifies the bearer token of unary and streaming RPCs and stores sub in context.
+// Streams are authenticated once, from the headers that open them.
+type introspectionInterceptor struct {
+	verifier     mock.IDTokenVerifierInterface
+	client       mock.GocloakClientInterface
+	realm        string
+	clientID     string
+	clientSecret string
+}
 
-			// 3) IntrospectToken（＝Token Introspection エンドポイント呼び出し）
-			result, err := client.RetrospectToken(ctx, token, clientID, clientSecret, realm)
-			if err != nil {
-				slog.Error("failed to introspect token", "error", err)
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-			if result.Active == nil || !*result.Active {
-				slog.Warn("token is not active")
-				return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenNotActive)
-			}
+func (i *introspectionInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
 
-			ctx = context.WithValue(ctx, ctxkeys.TokenKey, token)
-			ctx = context.WithValue(ctx, ctxkeys.SubKey, claims.Sub)
+// WrapStreamingClient is a no-op: the interceptor only guards handlers.
+func (i *introspectionInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
 
-			return next(ctx, req)
+func (i *introspectionInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
 		}
-	})
+		return next(ctx, conn)
+	}
+}
+
+func (i *introspectionInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	// 認証ヘッダが無ければスキップ（非ログインアクセスを許可）
+	if strings.TrimSpace(header.Get("Authorization")) == "" {
+		return ctx, nil
+	}
+
+	// 1) Authorization ヘッダ取得
+	token, err := bearerToken(header)
+	if err != nil {
+		slog.Error("failed to extract bearer token", "error", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	// 2) go-oidc で署名検証 & sub 取得
+	idt, err := i.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	var claims struct {
+		Sub string `json:"sub"`
+	}
+	if err := idt.Claims(&claims); err != nil {
+		slog.Error("failed to extract claims", "error", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	// 3) IntrospectToken（＝Token Introspection エンドポイント呼び出し）
+	result, err := i.client.RetrospectToken(ctx, token, i.clientID, i.clientSecret, i.realm)
+	if err != nil {
+		slog.Error("failed to introspect token", "error", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if result.Active == nil || !*result.Active {
+		slog.Warn("token is not active")
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenNotActive)
+	}
+
+	ctx = context.WithValue(ctx, ctxkeys.TokenKey, token)
+	ctx = context.WithValue(ctx, ctxkeys.SubKey, claims.Sub)
+	return ctx, nil
 }
 
 // CheckPermissionFuncWithInterface is a testable version that accepts interfaces
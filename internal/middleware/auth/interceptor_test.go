@@ -260,6 +260,48 @@ func TestIntrospectionInterceptor(t *testing.T) {
 	}
 }
 
+// mockStreamingConn implements connect.StreamingHandlerConn for testing
+type mockStreamingConn struct {
+	connect.StreamingHandlerConn
+	header http.Header
+}
+
+func (m *mockStreamingConn) RequestHeader() http.Header {
+	return m.header
+}
+
+func TestIntrospectionInterceptor_Streaming(t *testing.T) {
+	activeTrue := true
+	interceptor := IntrospectionInterceptorWithInterfaces(
+		&mockTokenVerifier{token: &mockIDToken{sub: "test-sub"}},
+		&mockGocloakClient{introspectResult: &gocloak.IntroSpectTokenResult{Active: &activeTrue}},
+		"test-realm",
+		"test-client",
+		"test-secret",
+	)
+
+	var ctxSub string
+	handler := interceptor.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctxSub = ctxkeys.UserIDFromContext(ctx)
+		return nil
+	})
+
+	// Streams are authenticated from the headers that open them
+	err := handler(context.Background(), &mockStreamingConn{header: http.Header{"Authorization": []string{"Bearer valid-token"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "test-sub", ctxSub)
+
+	// Anonymous streams are allowed
+	ctxSub = ""
+	err = handler(context.Background(), &mockStreamingConn{header: http.Header{}})
+	require.NoError(t, err)
+	assert.Empty(t, ctxSub)
+
+	err = handler(context.Background(), &mockStreamingConn{header: http.Header{"Authorization": []string{"InvalidFormat"}}})
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
 func TestCheckPermissionFunc(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,106 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+
+	pb "github.com/tikfack/server/gen/actress"
+	actressconnect "github.com/tikfack/server/gen/actress/actressconnect"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/usecase/actress"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// ActressServiceServer は ActressService の Connect サーバー実装です。
+type ActressServiceServer struct {
+	usecase     actress.ActressUsecase
+	presenter   actressPresenter
+	logger      *slog.Logger
+	handlerOpts []connect.HandlerOption
+}
+
+// NewActressServiceHandler はユースケースを受け取り Connect ハンドラを構築する。
+func NewActressServiceHandler(uc actress.ActressUsecase, opts ...connect.HandlerOption) *ActressServiceServer {
+	if uc == nil {
+		panic("actress usecase must be provided")
+	}
+	return &ActressServiceServer{
+		usecase:     uc,
+		presenter:   newActressPresenter(),
+		logger:      slog.Default().With(slog.String("component", "actress_handler")),
+		handlerOpts: append([]connect.HandlerOption{connect.WithCompressMinBytes(0)}, opts...),
+	}
+}
+
+// GetHandler は、Connect サービスのパターンとハンドラーを返します。
+func (s *ActressServiceServer) GetHandler() (string, http.Handler) {
+	pattern, handler := actressconnect.NewActressServiceHandler(s, s.handlerOpts...)
+	return pattern, handler
+}
+
+func (s *ActressServiceServer) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return s.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// GetActress は、ID で女優のプロフィールを取得するエンドポイントの実装。
+func (s *ActressServiceServer) GetActress(ctx context.Context, req *connect.Request[pb.GetActressRequest]) (*connect.Response[pb.GetActressResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: GetActress", "actressId", req.Msg.ActressId)
+
+	if strings.TrimSpace(req.Msg.ActressId) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("actress_id is required"))
+	}
+
+	profile, err := s.usecase.GetActress(ctx, req.Msg.ActressId)
+	if err != nil {
+		logger.Error("女優情報の取得に失敗", "actressId", req.Msg.ActressId, "error", err)
+		return nil, toConnectError(err, "女優情報の取得に失敗しました")
+	}
+
+	logger.Debug("GetActress completed", "actressId", profile.ID, "name", profile.Name)
+	return connect.NewResponse(&pb.GetActressResponse{Actress: s.presenter.Actress(*profile)}), nil
+}
+
+// SearchActresses は、名前やサイズなどの条件で女優を検索するエンドポイントの実装。
+func (s *ActressServiceServer) SearchActresses(ctx context.Context, req *connect.Request[pb.SearchActressesRequest]) (*connect.Response[pb.SearchActressesResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: SearchActresses", "keyword", req.Msg.Keyword, "initial", req.Msg.Initial, "hits", req.Msg.Hits, "offset", req.Msg.Offset, "sort", req.Msg.Sort)
+
+	actresses, metadata, err := s.usecase.SearchActresses(ctx, model.ActressSearchQuery{
+		Keyword:     req.Msg.Keyword,
+		Initial:     req.Msg.Initial,
+		GteBust:     req.Msg.GteBust,
+		LteBust:     req.Msg.LteBust,
+		GteWaist:    req.Msg.GteWaist,
+		LteWaist:    req.Msg.LteWaist,
+		GteHip:      req.Msg.GteHip,
+		LteHip:      req.Msg.LteHip,
+		GteHeight:   req.Msg.GteHeight,
+		LteHeight:   req.Msg.LteHeight,
+		GteBirthday: req.Msg.GteBirthday,
+		LteBirthday: req.Msg.LteBirthday,
+		Sort:        req.Msg.Sort,
+		Hits:        clampHits(req.Msg.Hits),
+		Offset:      clampOffset(req.Msg.Offset),
+	})
+	if err != nil {
+		logger.Error("女優の検索に失敗", "keyword", req.Msg.Keyword, "error", err)
+		return nil, toConnectError(err, "女優の検索に失敗しました")
+	}
+
+	logger.Debug("SearchActresses completed", "count", len(actresses))
+	return connect.NewResponse(&pb.SearchActressesResponse{
+		Actresses: s.presenter.Actresses(actresses),
+		Metadata:  s.presenter.Metadata(metadata),
+	}), nil
+}
@@ -0,0 +1,167 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pb "github.com/tikfack/server/gen/actress"
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	mockactress "github.com/tikfack/server/internal/application/usecase/mock"
+)
+
+var testActressProfile = model.ActressProfile{
+	ID:          "1011199",
+	Name:        "女優A",
+	Ruby:        "じょゆうえー",
+	Bust:        88,
+	Cup:         "E",
+	Waist:       58,
+	Hip:         86,
+	Height:      160,
+	Birthday:    "1995-04-01",
+	BloodType:   "A",
+	Hobby:       "読書",
+	Prefectures: "東京都",
+	ImageURL:    model.ActressImage{Small: "https://example.com/s.jpg", Large: "https://example.com/l.jpg"},
+	ListURL:     model.ActressListURL{Digital: "https://example.com/digital"},
+}
+
+func checkActressFields(t *testing.T, got *pb.ActressProfile, want model.ActressProfile) {
+	require.Equal(t, want.ID, got.Id)
+	require.Equal(t, want.Name, got.Name)
+	require.Equal(t, want.Ruby, got.Ruby)
+	require.Equal(t, int32(want.Bust), got.Bust)
+	require.Equal(t, want.Cup, got.Cup)
+	require.Equal(t, int32(want.Waist), got.Waist)
+	require.Equal(t, int32(want.Hip), got.Hip)
+	require.Equal(t, int32(want.Height), got.Height)
+	require.Equal(t, want.Birthday, got.Birthday)
+	require.Equal(t, want.BloodType, got.BloodType)
+	require.Equal(t, want.Hobby, got.Hobby)
+	require.Equal(t, want.Prefectures, got.Prefectures)
+	require.Equal(t, want.ImageURL.Small, got.ImageUrl.Small)
+	require.Equal(t, want.ImageURL.Large, got.ImageUrl.Large)
+	require.Equal(t, want.ListURL.Digital, got.ListUrl.Digital)
+}
+
+func TestGetActress(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *pb.GetActressRequest
+		setupMock func(m *mockactress.MockActressUsecase)
+		errorCode connect.Code
+	}{
+		{
+			name:    "正常系",
+			request: &pb.GetActressRequest{ActressId: "1011199"},
+			setupMock: func(m *mockactress.MockActressUsecase) {
+				m.EXPECT().GetActress(gomock.Any(), "1011199").Return(&testActressProfile, nil)
+			},
+		},
+		{
+			name:      "異常系 - ID 未指定",
+			request:   &pb.GetActressRequest{ActressId: " "},
+			setupMock: func(m *mockactress.MockActressUsecase) {},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:    "異常系 - 女優が見つからない",
+			request: &pb.GetActressRequest{ActressId: "999"},
+			setupMock: func(m *mockactress.MockActressUsecase) {
+				m.EXPECT().GetActress(gomock.Any(), "999").
+					Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound, Err: errors.New("not found")})
+			},
+			errorCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mockactress.NewMockActressUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			resp, err := NewActressServiceHandler(mockUsecase).GetActress(context.Background(), connect.NewRequest(tt.request))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			checkActressFields(t, resp.Msg.Actress, testActressProfile)
+		})
+	}
+}
+
+func TestSearchActresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *pb.SearchActressesRequest
+		setupMock func(m *mockactress.MockActressUsecase)
+		errorCode connect.Code
+	}{
+		{
+			name: "正常系：条件をユースケースに渡し、hits と offset を制限する",
+			request: &pb.SearchActressesRequest{
+				Initial:     "あ",
+				GteBust:     85,
+				LteHeight:   165,
+				GteBirthday: "1990-01-01",
+				Sort:        "-bust",
+				Hits:        200,
+				Offset:      -1,
+			},
+			setupMock: func(m *mockactress.MockActressUsecase) {
+				m.EXPECT().
+					SearchActresses(gomock.Any(), model.ActressSearchQuery{
+						Initial:     "あ",
+						GteBust:     85,
+						LteHeight:   165,
+						GteBirthday: "1990-01-01",
+						Sort:        "-bust",
+						Hits:        100,
+						Offset:      0,
+					}).
+					Return([]model.ActressProfile{testActressProfile}, testMetadata, nil)
+			},
+		},
+		{
+			name:    "異常系 - 検索条件が不正",
+			request: &pb.SearchActressesRequest{Sort: "date"},
+			setupMock: func(m *mockactress.MockActressUsecase) {
+				m.EXPECT().SearchActresses(gomock.Any(), gomock.Any()).
+					Return(nil, nil, &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Err: errors.New("bad sort")})
+			},
+			errorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mockactress.NewMockActressUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			resp, err := NewActressServiceHandler(mockUsecase).SearchActresses(context.Background(), connect.NewRequest(tt.request))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Actresses, 1)
+			checkActressFields(t, resp.Msg.Actresses[0], testActressProfile)
+			require.Equal(t, int32(testMetadata.TotalCount), resp.Msg.Metadata.TotalCount)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package connect
+
+import (
+	pb "github.com/tikfack/server/gen/actress"
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// actressPresenter は女優のモデルを transport 層の pb メッセージへ変換する。
+type actressPresenter struct{}
+
+func newActressPresenter() actressPresenter {
+	return actressPresenter{}
+}
+
+func (p actressPresenter) Actress(a model.ActressProfile) *pb.ActressProfile {
+	return &pb.ActressProfile{
+		Id:          a.ID,
+		Name:        a.Name,
+		Ruby:        a.Ruby,
+		Bust:        int32(a.Bust),
+		Cup:         a.Cup,
+		Waist:       int32(a.Waist),
+		Hip:         int32(a.Hip),
+		Height:      int32(a.Height),
+		Birthday:    a.Birthday,
+		BloodType:   a.BloodType,
+		Hobby:       a.Hobby,
+		Prefectures: a.Prefectures,
+		ImageUrl:    &pb.ActressImage{Small: a.ImageURL.Small, Large: a.ImageURL.Large},
+		ListUrl: &pb.ActressListURL{
+			Digital: a.ListURL.Digital,
+			Monthly: a.ListURL.Monthly,
+			Mono:    a.ListURL.Mono,
+		},
+	}
+}
+
+func (p actressPresenter) Actresses(actresses []model.ActressProfile) []*pb.ActressProfile {
+	if len(actresses) == 0 {
+		return nil
+	}
+	out := make([]*pb.ActressProfile, 0, len(actresses))
+	for _, a := range actresses {
+		out = append(out, p.Actress(a))
+	}
+	return out
+}
+
+func (p actressPresenter) Metadata(md *model.SearchMetadata) *pb.SearchMetadata {
+	if md == nil {
+		return nil
+	}
+	return &pb.SearchMetadata{
+		ResultCount:   int32(md.ResultCount),
+		TotalCount:    int32(md.TotalCount),
+		FirstPosition: int32(md.FirstPosition),
+	}
+}
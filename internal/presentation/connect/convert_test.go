@@ -2,10 +2,15 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	pb "github.com/tikfack/server/gen/video"
 	"github.com/tikfack/server/internal/application/model"
 )
 
@@ -57,7 +62,7 @@ func TestPresenterVideos(t *testing.T) {
 		{DmmID: "1"},
 		{DmmID: "2", DirectURL: "existing"},
 	}
-	result := presenter.Videos(ctx, videos)
+	result := presenter.Videos(ctx, videos, false)
 	require.Len(t, result, 2)
 	require.Equal(t, "resolved-1", result[0].DirectUrl)
 	require.Equal(t, "existing", result[1].DirectUrl)
@@ -66,8 +71,51 @@ func TestPresenterVideos(t *testing.T) {
 	require.Equal(t, "existing", videos[1].DirectURL)
 }
 
+func TestPresenterVideosLeavesDirectURLEmptyWhenUnresolved(t *testing.T) {
+	ctx := context.Background()
+	failing := videoURLResolverFunc(func(_ context.Context, _ string) (string, error) {
+		return "", errors.New("not found")
+	})
+	presenter := newVideoPresenter(failing)
+	result := presenter.Videos(ctx, []model.Video{
+		{DmmID: "1", SampleURL: "https://example.com/sample.mp4"},
+	}, false)
+	require.Len(t, result, 1)
+	require.Empty(t, result[0].DirectUrl)
+	require.Equal(t, "https://example.com/sample.mp4", result[0].SampleUrl)
+}
+
+func TestPresenterVideosResolvesConcurrently(t *testing.T) {
+	ctx := context.Background()
+	const n = 20
+	release := make(chan struct{})
+	var started atomic.Int32
+	blocking := videoURLResolverFunc(func(_ context.Context, dmmID string) (string, error) {
+		started.Add(1)
+		<-release
+		return "resolved-" + dmmID, nil
+	})
+	presenter := newVideoPresenter(blocking)
+	videos := make([]model.Video, n)
+	for i := range videos {
+		videos[i] = model.Video{DmmID: fmt.Sprintf("id%d", i)}
+	}
+
+	done := make(chan []*pb.Video)
+	go func() { done <- presenter.Videos(ctx, videos, false) }()
+	// 全件の解決が同時に始まる（逐次であれば 1 件目で止まる）
+	require.Eventually(t, func() bool { return started.Load() == n }, time.Second, time.Millisecond)
+	close(release)
+
+	result := <-done
+	require.Len(t, result, n)
+	for i, v := range result {
+		require.Equal(t, fmt.Sprintf("resolved-id%d", i), v.DirectUrl)
+	}
+}
+
 func TestPresenterMetadata(t *testing.T) {
-	presenter := newVideoPresenter()
+	presenter := newVideoPresenter(unresolvedURLs)
 	require.Nil(t, presenter.Metadata(nil))
 	pbMeta := presenter.Metadata(&model.SearchMetadata{ResultCount: 1, TotalCount: 2, FirstPosition: 3})
 	require.Equal(t, int32(1), pbMeta.ResultCount)
@@ -0,0 +1,179 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/tikfack/server/internal/application/port"
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	"github.com/tikfack/server/internal/application/usecase/favorite"
+	"github.com/tikfack/server/internal/application/usecase/playlist"
+	"github.com/tikfack/server/internal/application/usecase/taxonomy"
+	watchhistory "github.com/tikfack/server/internal/application/usecase/watch_history"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+// errorDomain は ErrorInfo に設定するエラーの発生元。
+const errorDomain = "tikfack.server"
+
+// defaultRetryDelay は上流から待機時間の指示がない再試行可能エラーに付与する待機時間。
+const defaultRetryDelay = time.Second
+
+// ErrorInfo.Reason に設定する値。クライアントはこの値で失敗の種類を判別する。
+const (
+	reasonCanceled               = "CANCELED"
+	reasonDeadlineExceeded       = "DEADLINE_EXCEEDED"
+	reasonNotFound               = "NOT_FOUND"
+	reasonInvalidPageToken       = "INVALID_PAGE_TOKEN"
+	reasonInvalidArgument        = "INVALID_ARGUMENT"
+	reasonPermissionDenied       = "PERMISSION_DENIED"
+	reasonPlaylistFull           = "PLAYLIST_FULL"
+	reasonLookupLimitExceeded    = "LOOKUP_LIMIT_EXCEEDED"
+	reasonInternal               = "INTERNAL"
+	reasonCatalogNotFound        = "CATALOG_NOT_FOUND"
+	reasonCatalogInvalidArgument = "CATALOG_INVALID_PARAMETER"
+	reasonCatalogRateLimited     = "CATALOG_RATE_LIMITED"
+	reasonCatalogThrottled       = "CATALOG_THROTTLED"
+	reasonCatalogUnavailable     = "CATALOG_UNAVAILABLE"
+	reasonCatalogDecodeFailure   = "CATALOG_DECODE_FAILURE"
+	reasonCatalogUpstreamStatus  = "CATALOG_UPSTREAM_STATUS"
+)
+
+// errorClass はエラーを Connect のレスポンスへ変換するための分類結果。
+type errorClass struct {
+	code       connect.Code
+	reason     string
+	retryable  bool
+	retryAfter time.Duration
+	metadata   map[string]string
+}
+
+// toConnectError はユースケースが返したエラーを connect.Error に変換する。
+// ErrorInfo（理由と再試行可否）を必ず付与し、再試行可能な場合は RetryInfo も付与する。
+func toConnectError(err error, msg string) *connect.Error {
+	class := classifyError(err)
+	connectErr := connect.NewError(class.code, fmt.Errorf("%s: %w", msg, err))
+
+	metadata := map[string]string{"retryable": strconv.FormatBool(class.retryable)}
+	for k, v := range class.metadata {
+		metadata[k] = v
+	}
+	addErrorDetail(connectErr, &errdetails.ErrorInfo{
+		Reason:   class.reason,
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if class.retryable {
+		delay := class.retryAfter
+		if delay <= 0 {
+			delay = defaultRetryDelay
+		}
+		addErrorDetail(connectErr, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	}
+	return connectErr
+}
+
+func addErrorDetail(connectErr *connect.Error, msg proto.Message) {
+	detail, err := connect.NewErrorDetail(msg)
+	if err != nil {
+		return
+	}
+	connectErr.AddDetail(detail)
+}
+
+// classifyError はエラーの種類から Connect のコードと再試行可否を決める。
+func classifyError(err error) errorClass {
+	// 呼び出し元のキャンセルは上流の失敗より優先する
+	if errors.Is(err, context.Canceled) {
+		return errorClass{code: connect.CodeCanceled, reason: reasonCanceled}
+	}
+
+	var catalogErr *port.CatalogError
+	if errors.As(err, &catalogErr) {
+		return classifyCatalogError(catalogErr)
+	}
+
+	// 不正なイベントは拒否理由をそのまま reason に設定する
+	var validationErr *eventloguc.ValidationError
+	if errors.As(err, &validationErr) {
+		class := errorClass{code: connect.CodeInvalidArgument, reason: validationErr.Reason}
+		if validationErr.Reason == eventloguc.ReasonUserMismatch {
+			class.code = connect.CodePermissionDenied
+		}
+		if validationErr.Field != "" {
+			class.metadata = map[string]string{"field": validationErr.Field}
+		}
+		return class
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorClass{code: connect.CodeDeadlineExceeded, reason: reasonDeadlineExceeded, retryable: true}
+	case errors.Is(err, repository.ErrFavoriteVideoNotFound),
+		errors.Is(err, repository.ErrFavoriteActorNotFound),
+		errors.Is(err, repository.ErrFavoriteArticleNotFound),
+		errors.Is(err, repository.ErrPlaylistNotFound),
+		errors.Is(err, repository.ErrPlaylistItemNotFound):
+		return errorClass{code: connect.CodeNotFound, reason: reasonNotFound}
+	case errors.Is(err, playlist.ErrInvalidPlaylist):
+		return errorClass{code: connect.CodeInvalidArgument, reason: reasonInvalidArgument}
+	case errors.Is(err, playlist.ErrNotPlaylistOwner):
+		return errorClass{code: connect.CodePermissionDenied, reason: reasonPermissionDenied}
+	case errors.Is(err, playlist.ErrPlaylistFull):
+		return errorClass{code: connect.CodeFailedPrecondition, reason: reasonPlaylistFull}
+	case errors.Is(err, taxonomy.ErrLookupLimitExceeded):
+		return errorClass{code: connect.CodeFailedPrecondition, reason: reasonLookupLimitExceeded}
+	case errors.Is(err, favorite.ErrInvalidPageToken),
+		errors.Is(err, watchhistory.ErrInvalidPageToken):
+		return errorClass{code: connect.CodeInvalidArgument, reason: reasonInvalidPageToken}
+	default:
+		return errorClass{code: connect.CodeInternal, reason: reasonInternal}
+	}
+}
+
+func classifyCatalogError(catalogErr *port.CatalogError) errorClass {
+	class := errorClass{
+		retryable:  catalogErr.Retryable(),
+		retryAfter: catalogErr.RetryAfter,
+	}
+	if catalogErr.Status != 0 {
+		class.metadata = map[string]string{"upstream_status": strconv.Itoa(catalogErr.Status)}
+	}
+
+	switch catalogErr.Kind {
+	case port.ErrCatalogNotFound:
+		class.code, class.reason = connect.CodeNotFound, reasonCatalogNotFound
+	case port.ErrCatalogInvalidParameter:
+		class.code, class.reason = connect.CodeInvalidArgument, reasonCatalogInvalidArgument
+	case port.ErrCatalogRateLimited:
+		class.code, class.reason = connect.CodeResourceExhausted, reasonCatalogRateLimited
+	case port.ErrCatalogThrottled:
+		class.code, class.reason = connect.CodeResourceExhausted, reasonCatalogThrottled
+	case port.ErrCatalogUnavailable:
+		class.code, class.reason = connect.CodeUnavailable, reasonCatalogUnavailable
+	case port.ErrCatalogDecode:
+		class.code, class.reason = connect.CodeInternal, reasonCatalogDecodeFailure
+	case port.ErrCatalogUpstreamStatus:
+		class.reason = reasonCatalogUpstreamStatus
+		switch {
+		case catalogErr.Retryable():
+			class.code = connect.CodeUnavailable
+		case catalogErr.Status == http.StatusBadRequest:
+			class.code = connect.CodeInvalidArgument
+		default:
+			class.code = connect.CodeInternal
+		}
+	default:
+		class.code, class.reason = connect.CodeInternal, reasonInternal
+	}
+	return class
+}
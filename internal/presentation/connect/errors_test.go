@@ -0,0 +1,172 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	pb "github.com/tikfack/server/gen/video"
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	"github.com/tikfack/server/internal/application/usecase/favorite"
+	mockvideo "github.com/tikfack/server/internal/application/usecase/mock"
+	"github.com/tikfack/server/internal/application/usecase/taxonomy"
+	"github.com/tikfack/server/internal/domain/repository"
+)
+
+func TestToConnectError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedCode   connect.Code
+		expectedReason string
+		retryable      bool
+		retryDelay     time.Duration
+	}{
+		{
+			name:           "見つからない動画は NotFound",
+			err:            &port.CatalogError{Kind: port.ErrCatalogNotFound, Err: errors.New("動画ID x が見つかりませんでした")},
+			expectedCode:   connect.CodeNotFound,
+			expectedReason: reasonCatalogNotFound,
+		},
+		{
+			name:           "パラメータ不正は InvalidArgument",
+			err:            &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Status: 400},
+			expectedCode:   connect.CodeInvalidArgument,
+			expectedReason: reasonCatalogInvalidArgument,
+		},
+		{
+			name:           "レート制限は ResourceExhausted で上流の待機時間を返す",
+			err:            &port.CatalogError{Kind: port.ErrCatalogRateLimited, Status: 429, RetryAfter: 5 * time.Second},
+			expectedCode:   connect.CodeResourceExhausted,
+			expectedReason: reasonCatalogRateLimited,
+			retryable:      true,
+			retryDelay:     5 * time.Second,
+		},
+		{
+			name:           "クライアント側のレート制限は上流のレート制限と区別する",
+			err:            &port.CatalogError{Kind: port.ErrCatalogThrottled, RetryAfter: 3 * time.Second},
+			expectedCode:   connect.CodeResourceExhausted,
+			expectedReason: reasonCatalogThrottled,
+			retryable:      true,
+			retryDelay:     3 * time.Second,
+		},
+		{
+			name:           "上流障害は Unavailable",
+			err:            fmt.Errorf("wrapped: %w", &port.CatalogError{Kind: port.ErrCatalogUnavailable}),
+			expectedCode:   connect.CodeUnavailable,
+			expectedReason: reasonCatalogUnavailable,
+			retryable:      true,
+			retryDelay:     defaultRetryDelay,
+		},
+		{
+			name:           "デコード失敗は Internal",
+			err:            &port.CatalogError{Kind: port.ErrCatalogDecode},
+			expectedCode:   connect.CodeInternal,
+			expectedReason: reasonCatalogDecodeFailure,
+		},
+		{
+			name:           "result.status が 5xx なら Unavailable",
+			err:            &port.CatalogError{Kind: port.ErrCatalogUpstreamStatus, Status: 500},
+			expectedCode:   connect.CodeUnavailable,
+			expectedReason: reasonCatalogUpstreamStatus,
+			retryable:      true,
+			retryDelay:     defaultRetryDelay,
+		},
+		{
+			name:           "result.status が 400 なら InvalidArgument",
+			err:            &port.CatalogError{Kind: port.ErrCatalogUpstreamStatus, Status: 400},
+			expectedCode:   connect.CodeInvalidArgument,
+			expectedReason: reasonCatalogUpstreamStatus,
+		},
+		{
+			name:           "呼び出し元のキャンセルは Canceled",
+			err:            &port.CatalogError{Kind: port.ErrCatalogUnavailable, Err: context.Canceled},
+			expectedCode:   connect.CodeCanceled,
+			expectedReason: reasonCanceled,
+		},
+		{
+			name:           "お気に入りが存在しない場合は NotFound",
+			err:            repository.ErrFavoriteVideoNotFound,
+			expectedCode:   connect.CodeNotFound,
+			expectedReason: reasonNotFound,
+		},
+		{
+			name:           "不正なページトークンは InvalidArgument",
+			err:            fmt.Errorf("list: %w", favorite.ErrInvalidPageToken),
+			expectedCode:   connect.CodeInvalidArgument,
+			expectedReason: reasonInvalidPageToken,
+		},
+		{
+			name:           "不正なイベントは拒否理由を reason に設定する",
+			err:            &eventloguc.ValidationError{Reason: eventloguc.ReasonUnknownEventType, Field: "event_type", Message: "unknown"},
+			expectedCode:   connect.CodeInvalidArgument,
+			expectedReason: eventloguc.ReasonUnknownEventType,
+		},
+		{
+			name:           "分類の ID 検索が走査上限に達した場合は NotFound と区別する",
+			err:            fmt.Errorf("get: %w", taxonomy.ErrLookupLimitExceeded),
+			expectedCode:   connect.CodeFailedPrecondition,
+			expectedReason: reasonLookupLimitExceeded,
+		},
+		{
+			name:           "分類できないエラーは Internal",
+			err:            errors.New("boom"),
+			expectedCode:   connect.CodeInternal,
+			expectedReason: reasonInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connectErr := toConnectError(tt.err, "失敗しました")
+			require.Equal(t, tt.expectedCode, connectErr.Code())
+			require.ErrorIs(t, connectErr, tt.err)
+
+			var info *errdetails.ErrorInfo
+			var retryInfo *errdetails.RetryInfo
+			for _, detail := range connectErr.Details() {
+				value, err := detail.Value()
+				require.NoError(t, err)
+				switch v := value.(type) {
+				case *errdetails.ErrorInfo:
+					info = v
+				case *errdetails.RetryInfo:
+					retryInfo = v
+				}
+			}
+			require.NotNil(t, info)
+			require.Equal(t, tt.expectedReason, info.Reason)
+			require.Equal(t, errorDomain, info.Domain)
+			require.Equal(t, fmt.Sprint(tt.retryable), info.Metadata["retryable"])
+			if tt.retryable {
+				require.NotNil(t, retryInfo)
+				require.Equal(t, tt.retryDelay, retryInfo.RetryDelay.AsDuration())
+			} else {
+				require.Nil(t, retryInfo)
+			}
+		})
+	}
+}
+
+func TestGetVideoById_CatalogNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
+	mockUsecase.EXPECT().
+		GetVideoById(gomock.Any(), model.FloorSelector{}, "missing").
+		Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound, Err: errors.New("動画ID missing が見つかりませんでした")})
+
+	handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
+	_, err := handler.GetVideoById(context.Background(), connect.NewRequest(&pb.GetVideoByIdRequest{DmmId: "missing"}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
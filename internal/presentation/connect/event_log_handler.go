@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"net/http"
 
@@ -10,6 +11,7 @@ import (
 	pb "github.com/tikfack/server/gen/event_log"
 	eventlogconnect "github.com/tikfack/server/gen/event_log/event_logconnect"
 	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	"github.com/tikfack/server/internal/middleware/ctxkeys"
 )
 
 // EventLogServiceServer はイベントログ用のConnectハンドラ実装。
@@ -53,14 +55,15 @@ func (s *EventLogServiceServer) Record(
 ) (*connect.Response[pb.RecordResponse], error) {
 	domainEvent, err := s.presenter.ToDomain(ctx, req.Msg.Event)
 	if err != nil {
-		s.logger.Error("failed to marshal props", slog.String("error", err.Error()))
-		return nil, connect.NewError(connect.CodeInternal, err)
+		s.logger.Warn("failed to convert event", slog.String("error", err.Error()))
+		return nil, toConnectError(err, "failed to convert event")
 	}
-	if err := s.eventLogUsecase.Record(ctx, domainEvent); err != nil {
+	result, err := s.eventLogUsecase.Record(ctx, callerFromContext(ctx), domainEvent)
+	if err != nil {
 		s.logger.Error("failed to record event", slog.String("error", err.Error()))
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err, "failed to record event")
 	}
-	return connect.NewResponse(&pb.RecordResponse{}), nil
+	return connect.NewResponse(s.presenter.ToRecordResponse(result)), nil
 }
 
 // RecordBatch は複数イベントを一括処理する。
@@ -71,11 +74,81 @@ func (s *EventLogServiceServer) RecordBatch(
 	events, err := s.presenter.ToDomainBatch(ctx, req.Msg.Events)
 	if err != nil {
 		s.logger.Error("failed to marshal props", slog.String("error", err.Error()))
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err, "failed to marshal props")
 	}
-	if err := s.eventLogUsecase.RecordBatch(ctx, events); err != nil {
+	result, err := s.eventLogUsecase.RecordBatch(ctx, callerFromContext(ctx), events)
+	if err != nil {
 		s.logger.Error("failed to record batch events", slog.String("error", err.Error()))
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, toConnectError(err, "failed to record batch events")
+	}
+	if len(result.Rejections) > 0 {
+		s.logger.Warn("rejected invalid events",
+			slog.Int("accepted", result.Accepted),
+			slog.Int("rejected", len(result.Rejections)),
+			slog.String("first_reason", result.Rejections[0].Reason))
+	}
+	return connect.NewResponse(s.presenter.ToRecordResponse(result)), nil
+}
+
+// RecordStream はクライアントストリームのイベントをマイクロバッチで記録し、
+// ストリームが閉じられた後に全バッチの ack をまとめて返す。
+// 途中で失敗した場合は、それまでに記録したバッチの ack を RecordStreamAck のエラー詳細として返す。
+func (s *EventLogServiceServer) RecordStream(
+	ctx context.Context,
+	stream *connect.ClientStream[pb.RecordStreamRequest],
+) (*connect.Response[pb.RecordStreamAck], error) {
+	recv := func() (eventloguc.StreamEvent, error) {
+		if !stream.Receive() {
+			if err := stream.Err(); err != nil {
+				return eventloguc.StreamEvent{}, err
+			}
+			return eventloguc.StreamEvent{}, io.EOF
+		}
+		return s.presenter.ToStreamEvent(ctx, stream.Msg())
+	}
+	summary := &eventloguc.StreamAck{}
+	err := s.eventLogUsecase.RecordStream(ctx, callerFromContext(ctx), recv, func(ack *eventloguc.StreamAck) error {
+		summary.AckIDs = append(summary.AckIDs, ack.AckIDs...)
+		summary.Rejections = append(summary.Rejections, ack.Rejections...)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to record event stream",
+			slog.Int("acked", len(summary.AckIDs)+len(summary.Rejections)),
+			slog.String("error", err.Error()))
+		// 失敗前に記録済みのバッチを再送させないよう、それまでの ack をエラー詳細で返す
+		connectErr := toConnectError(err, "failed to record event stream")
+		addErrorDetail(connectErr, s.presenter.ToStreamAck(summary))
+		return nil, connectErr
+	}
+	return connect.NewResponse(s.presenter.ToStreamAck(summary)), nil
+}
+
+// RecordStreamWithAcks は双方向ストリームのイベントをマイクロバッチで記録し、バッチごとに ack を返す。
+// ack を受け取る前にストリームが切れたイベントは、クライアントが再接続後に再送する。
+func (s *EventLogServiceServer) RecordStreamWithAcks(
+	ctx context.Context,
+	stream *connect.BidiStream[pb.RecordStreamRequest, pb.RecordStreamAck],
+) error {
+	recv := func() (eventloguc.StreamEvent, error) {
+		req, err := stream.Receive()
+		if err != nil {
+			// ストリーム終了時は io.EOF がそのまま返る
+			return eventloguc.StreamEvent{}, err
+		}
+		return s.presenter.ToStreamEvent(ctx, req)
 	}
-	return connect.NewResponse(&pb.RecordResponse{}), nil
+	err := s.eventLogUsecase.RecordStream(ctx, callerFromContext(ctx), recv, func(ack *eventloguc.StreamAck) error {
+		return stream.Send(s.presenter.ToStreamAck(ack))
+	})
+	if err != nil {
+		s.logger.Error("failed to record event stream", slog.String("error", err.Error()))
+		return toConnectError(err, "failed to record event stream")
+	}
+	return nil
+}
+
+// callerFromContext は検証済みトークンの sub を記録者とする。トークンがなければ匿名。
+func callerFromContext(ctx context.Context) eventloguc.Caller {
+	return eventloguc.Caller{UserID: ctxkeys.UserIDFromContext(ctx)}
 }
@@ -0,0 +1,166 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tikfack/server/gen/event_log"
+	eventlogconnect "github.com/tikfack/server/gen/event_log/event_logconnect"
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+// memoryEventLogRepository は記録されたイベントを保持するテスト用リポジトリ。
+type memoryEventLogRepository struct {
+	mu       sync.Mutex
+	inserted []*entity.EventLog
+	// limit が正の場合、limit 件を記録した後の書き込みは失敗する
+	limit int
+	// fail が true の場合、書き込みは常に失敗する
+	fail bool
+}
+
+func (r *memoryEventLogRepository) InsertEventLog(ctx context.Context, log *entity.EventLog) error {
+	return r.InsertEventLogs(ctx, []*entity.EventLog{log})
+}
+
+func (r *memoryEventLogRepository) InsertEventLogs(ctx context.Context, logs []*entity.EventLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail || (r.limit > 0 && len(r.inserted) >= r.limit) {
+		return errors.New("database is down")
+	}
+	r.inserted = append(r.inserted, logs...)
+	return nil
+}
+
+// newEventLogTestClient は双方向ストリームを扱えるよう HTTP/2 のテストサーバーを起動する。
+func newEventLogTestClient(t *testing.T, repo *memoryEventLogRepository) eventlogconnect.EventLogServiceClient {
+	t.Helper()
+	uc := eventloguc.NewEventLogService(repo,
+		eventloguc.DefaultEventTypeRegistry(eventloguc.DefaultTimeWindow),
+		nil,
+		eventloguc.StreamConfig{MaxBatchSize: 2, MaxBatchDelay: time.Hour})
+	mux := http.NewServeMux()
+	mux.Handle(NewEventLogServiceHandler(uc).GetHandler())
+
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return eventlogconnect.NewEventLogServiceClient(server.Client(), server.URL)
+}
+
+func streamRequest(ackID, eventType string) *pb.RecordStreamRequest {
+	return &pb.RecordStreamRequest{
+		AckId: ackID,
+		Event: &pb.Event{
+			DeviceId:   "device-1",
+			VideoDmmId: "abc001",
+			EventType:  eventType,
+			EventTime:  timestamppb.Now(),
+		},
+	}
+}
+
+func TestRecordStream_ClientStreaming(t *testing.T) {
+	repo := &memoryEventLogRepository{}
+	client := newEventLogTestClient(t, repo)
+
+	stream := client.RecordStream(context.Background())
+	require.NoError(t, stream.Send(streamRequest("a", "like")))
+	require.NoError(t, stream.Send(streamRequest("b", "rewind")))
+	require.NoError(t, stream.Send(&pb.RecordStreamRequest{AckId: "c"}))
+	res, err := stream.CloseAndReceive()
+	require.NoError(t, err)
+
+	// ストリーム終了時に全バッチの ack をまとめて返す
+	require.Equal(t, []string{"a"}, res.Msg.AckIds)
+	require.Len(t, res.Msg.Rejections, 2)
+	require.Equal(t, "b", res.Msg.Rejections[0].AckId)
+	require.Equal(t, eventloguc.ReasonUnknownEventType, res.Msg.Rejections[0].Reason)
+	require.Equal(t, "c", res.Msg.Rejections[1].AckId)
+	require.EqualValues(t, 2, res.Msg.Rejections[1].Index)
+	require.Equal(t, eventloguc.ReasonMissingEvent, res.Msg.Rejections[1].Reason)
+	require.Len(t, repo.inserted, 1)
+}
+
+func TestRecordStream_ReturnsPartialSummaryOnFailure(t *testing.T) {
+	repo := &memoryEventLogRepository{limit: 2}
+	client := newEventLogTestClient(t, repo)
+
+	stream := client.RecordStream(context.Background())
+	for _, ackID := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, stream.Send(streamRequest(ackID, "like")))
+	}
+	_, err := stream.CloseAndReceive()
+	require.Error(t, err)
+
+	// 失敗前に記録したバッチの ack はエラー詳細で返す
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	var summary *pb.RecordStreamAck
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		if ack, ok := value.(*pb.RecordStreamAck); ok {
+			summary = ack
+		}
+	}
+	require.NotNil(t, summary)
+	require.Equal(t, []string{"a", "b"}, summary.AckIds)
+	require.Len(t, repo.inserted, 2)
+}
+
+func TestRecordStreamWithAcks_AcksEachBatch(t *testing.T) {
+	repo := &memoryEventLogRepository{}
+	client := newEventLogTestClient(t, repo)
+
+	stream := client.RecordStreamWithAcks(context.Background())
+	require.NoError(t, stream.Send(streamRequest("a", "like")))
+	require.NoError(t, stream.Send(streamRequest("b", "share")))
+
+	// MaxBatchSize に達したバッチはストリームを閉じる前に ack される
+	ack, err := stream.Receive()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, ack.AckIds)
+
+	require.NoError(t, stream.Send(streamRequest("c", "like")))
+	require.NoError(t, stream.CloseRequest())
+	ack, err = stream.Receive()
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, ack.AckIds)
+
+	_, err = stream.Receive()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, stream.CloseResponse())
+	require.Len(t, repo.inserted, 3)
+}
+
+func TestRecordStreamWithAcks_ReturnsErrorWhileClientWaitsForAck(t *testing.T) {
+	repo := &memoryEventLogRepository{fail: true}
+	client := newEventLogTestClient(t, repo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream := client.RecordStreamWithAcks(ctx)
+	require.NoError(t, stream.Send(streamRequest("a", "like")))
+	require.NoError(t, stream.Send(streamRequest("b", "share")))
+
+	// ack を待つ間は送信しないクライアントにも、次の送信を待たずに記録の失敗を返す
+	_, err := stream.Receive()
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	require.Equal(t, connect.CodeInternal, connectErr.Code())
+	require.NoError(t, ctx.Err())
+}
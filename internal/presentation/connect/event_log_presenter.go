@@ -3,10 +3,12 @@ package connect
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 
 	pb "github.com/tikfack/server/gen/event_log"
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
 	"github.com/tikfack/server/internal/domain/entity"
 	"github.com/tikfack/server/internal/middleware/ctxkeys"
 )
@@ -14,7 +16,12 @@ import (
 // eventLogPresenter は pb.Event をドメインの EventLog に変換する責務を持つ。
 type eventLogPresenter interface {
 	ToDomain(ctx context.Context, evt *pb.Event) (*entity.EventLog, error)
+	// ToDomainBatch は nil のイベントを nil のまま残し、ユースケースがその位置で拒否できるようにする。
 	ToDomainBatch(ctx context.Context, events []*pb.Event) ([]*entity.EventLog, error)
+	ToRecordResponse(result *eventloguc.RecordResult) *pb.RecordResponse
+	// ToStreamEvent は nil のイベントを nil のまま渡し、ユースケースで ack_id 付きで拒否させる。
+	ToStreamEvent(ctx context.Context, req *pb.RecordStreamRequest) (eventloguc.StreamEvent, error)
+	ToStreamAck(ack *eventloguc.StreamAck) *pb.RecordStreamAck
 }
 
 type defaultEventLogPresenter struct{}
@@ -25,7 +32,7 @@ func newEventLogPresenter() eventLogPresenter {
 
 func (p *defaultEventLogPresenter) ToDomain(ctx context.Context, evt *pb.Event) (*entity.EventLog, error) {
 	if evt == nil {
-		return nil, nil
+		return nil, &eventloguc.ValidationError{Reason: eventloguc.ReasonMissingEvent, Field: "event", Message: "event is required"}
 	}
 	props := map[string]any{}
 	if evt.GetProps() != nil {
@@ -36,9 +43,12 @@ func (p *defaultEventLogPresenter) ToDomain(ctx context.Context, evt *pb.Event)
 		return nil, err
 	}
 	return &entity.EventLog{
-		EventLogID:  uuid.New().String(),
-		TraceID:     ctxkeys.TraceIDFromContext(ctx),
+		EventLogID:     uuid.New().String(),
+		IdempotencyKey: evt.GetIdempotencyKey(),
+		TraceID:        ctxkeys.TraceIDFromContext(ctx),
+		// user_id はクライアントの申告値。ユースケースで認証済みユーザーと照合して上書きする
 		UserID:      evt.GetUserId(),
+		DeviceID:    evt.GetDeviceId(),
 		SessionID:   evt.GetSessionId(),
 		VideoDmmID:  evt.GetVideoDmmId(),
 		ActressIDs:  evt.GetActressIds(),
@@ -47,7 +57,7 @@ func (p *defaultEventLogPresenter) ToDomain(ctx context.Context, evt *pb.Event)
 		MakerIDs:    evt.GetMakerIds(),
 		SeriesIDs:   evt.GetSeriesIds(),
 		EventType:   evt.GetEventType(),
-		EventTime:   evt.GetEventTime().AsTime(),
+		EventTime:   eventTime(evt),
 		Props:       rawProps,
 	}, nil
 }
@@ -55,6 +65,9 @@ func (p *defaultEventLogPresenter) ToDomain(ctx context.Context, evt *pb.Event)
 func (p *defaultEventLogPresenter) ToDomainBatch(ctx context.Context, events []*pb.Event) ([]*entity.EventLog, error) {
 	result := make([]*entity.EventLog, len(events))
 	for i, evt := range events {
+		if evt == nil {
+			continue
+		}
 		domainEvt, err := p.ToDomain(ctx, evt)
 		if err != nil {
 			return nil, err
@@ -63,3 +76,55 @@ func (p *defaultEventLogPresenter) ToDomainBatch(ctx context.Context, events []*
 	}
 	return result, nil
 }
+
+func (p *defaultEventLogPresenter) ToRecordResponse(result *eventloguc.RecordResult) *pb.RecordResponse {
+	return &pb.RecordResponse{
+		AcceptedCount:  int32(result.Accepted),
+		DuplicateCount: int32(result.Duplicates),
+		Rejections:     toEventRejections(result.Rejections),
+	}
+}
+
+func (p *defaultEventLogPresenter) ToStreamEvent(ctx context.Context, req *pb.RecordStreamRequest) (eventloguc.StreamEvent, error) {
+	evt := eventloguc.StreamEvent{AckID: req.GetAckId()}
+	if req.GetEvent() == nil {
+		return evt, nil
+	}
+	domainEvt, err := p.ToDomain(ctx, req.GetEvent())
+	if err != nil {
+		return eventloguc.StreamEvent{}, err
+	}
+	evt.Event = domainEvt
+	return evt, nil
+}
+
+func (p *defaultEventLogPresenter) ToStreamAck(ack *eventloguc.StreamAck) *pb.RecordStreamAck {
+	return &pb.RecordStreamAck{
+		AckIds:     ack.AckIDs,
+		Rejections: toEventRejections(ack.Rejections),
+	}
+}
+
+func toEventRejections(rejections []eventloguc.EventRejection) []*pb.EventRejection {
+	var result []*pb.EventRejection
+	for _, r := range rejections {
+		result = append(result, &pb.EventRejection{
+			Index:   int32(r.Index),
+			Reason:  r.Reason,
+			Field:   r.Field,
+			Message: r.Message,
+			AckId:   r.AckID,
+		})
+	}
+	return result
+}
+
+// eventTime は event_time を返す。未指定や不正な値はゼロ値とし、ユースケースで拒否させる
+// （AsTime は nil を 1970-01-01 に変換してしまうため）。
+func eventTime(evt *pb.Event) time.Time {
+	ts := evt.GetEventTime()
+	if ts == nil || !ts.IsValid() {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
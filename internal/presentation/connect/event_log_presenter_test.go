@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	pb "github.com/tikfack/server/gen/event_log"
+	eventloguc "github.com/tikfack/server/internal/application/usecase/event_log"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -17,22 +18,24 @@ func TestEventLogPresenter_ToDomain(t *testing.T) {
 	props, _ := structpb.NewStruct(map[string]any{"foo": "bar"})
 	evtTime := timestamppb.New(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
 	evt := &pb.Event{
-		UserId:      "user",
-		SessionId:   "sess",
-		VideoDmmId:  "dmm",
-		ActressIds:  []string{"a"},
-		DirectorIds: []string{"d"},
-		GenreIds:    []string{"g"},
-		MakerIds:    []string{"m"},
-		SeriesIds:   []string{"s"},
-		EventType:   "start",
-		EventTime:   evtTime,
-		Props:       props,
+		UserId:         "user",
+		IdempotencyKey: "key-1",
+		SessionId:      "sess",
+		VideoDmmId:     "dmm",
+		ActressIds:     []string{"a"},
+		DirectorIds:    []string{"d"},
+		GenreIds:       []string{"g"},
+		MakerIds:       []string{"m"},
+		SeriesIds:      []string{"s"},
+		EventType:      "start",
+		EventTime:      evtTime,
+		Props:          props,
 	}
 
 	domain, err := presenter.ToDomain(ctx, evt)
 	require.NoError(t, err)
 	require.Equal(t, "user", domain.UserID)
+	require.Equal(t, "key-1", domain.IdempotencyKey)
 	require.Equal(t, evtTime.AsTime(), domain.EventTime)
 	require.Equal(t, []string{"a"}, domain.ActressIDs)
 	require.NotEmpty(t, domain.EventLogID)
@@ -42,8 +45,41 @@ func TestEventLogPresenter_ToDomain(t *testing.T) {
 func TestEventLogPresenter_Batch(t *testing.T) {
 	presenter := newEventLogPresenter()
 	ctx := context.Background()
-	events := []*pb.Event{{EventTime: timestamppb.Now(), Props: &structpb.Struct{}}}
+	events := []*pb.Event{{EventTime: timestamppb.Now(), Props: &structpb.Struct{}}, nil}
 	domain, err := presenter.ToDomainBatch(ctx, events)
 	require.NoError(t, err)
-	require.Len(t, domain, 1)
+	require.Len(t, domain, 2)
+	require.NotNil(t, domain[0])
+	// nil のイベントは位置を保ったまま残し、ユースケースで拒否させる
+	require.Nil(t, domain[1])
+}
+
+func TestEventLogPresenter_RejectsMissingEvent(t *testing.T) {
+	presenter := newEventLogPresenter()
+	_, err := presenter.ToDomain(context.Background(), nil)
+	var verr *eventloguc.ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, eventloguc.ReasonMissingEvent, verr.Reason)
+
+	// event_time が未指定の場合は 1970-01-01 ではなくゼロ値にする
+	domain, err := presenter.ToDomain(context.Background(), &pb.Event{EventType: "start"})
+	require.NoError(t, err)
+	require.True(t, domain.EventTime.IsZero())
+}
+
+func TestEventLogPresenter_ToRecordResponse(t *testing.T) {
+	presenter := newEventLogPresenter()
+	resp := presenter.ToRecordResponse(&eventloguc.RecordResult{
+		Accepted:   2,
+		Duplicates: 1,
+		Rejections: []eventloguc.EventRejection{{
+			Index:           1,
+			ValidationError: &eventloguc.ValidationError{Reason: eventloguc.ReasonInvalidProps, Field: "props.position", Message: "must be a number"},
+		}},
+	})
+	require.EqualValues(t, 2, resp.AcceptedCount)
+	require.EqualValues(t, 1, resp.DuplicateCount)
+	require.Len(t, resp.Rejections, 1)
+	require.EqualValues(t, 1, resp.Rejections[0].Index)
+	require.Equal(t, "props.position", resp.Rejections[0].Field)
 }
@@ -10,11 +10,9 @@ import (
 	pb "github.com/tikfack/server/gen/favorite"
 	favoriteconnect "github.com/tikfack/server/gen/favorite/favoriteconnect"
 	"github.com/tikfack/server/internal/application/usecase/favorite"
-	"github.com/tikfack/server/internal/domain/repository"
+	"github.com/tikfack/server/internal/domain/entity"
 	"github.com/tikfack/server/internal/middleware/ctxkeys"
 	"github.com/tikfack/server/internal/middleware/logger"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // FavoriteServiceServer is the Connect handler implementing FavoriteService.
@@ -65,7 +63,7 @@ func (s *FavoriteServiceServer) AddFavoriteVideo(ctx context.Context, req *conne
 	favoriteVideo, err := s.usecase.AddFavoriteVideo(ctx, userID, req.Msg.VideoId)
 	if err != nil {
 		log.Error("failed to add favorite video", "video_id", req.Msg.VideoId, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to add favorite video: %v", err)
+		return nil, toConnectError(err, "failed to add favorite video")
 	}
 
 	resp := &pb.AddFavoriteVideoResponse{FavoriteVideo: s.presenter.FavoriteVideo(*favoriteVideo)}
@@ -83,11 +81,8 @@ func (s *FavoriteServiceServer) RemoveFavoriteVideo(ctx context.Context, req *co
 	}
 	removed, err := s.usecase.RemoveFavoriteVideo(ctx, userID, req.Msg.VideoId)
 	if err != nil {
-		if errors.Is(err, repository.ErrFavoriteVideoNotFound) {
-			return nil, status.Error(codes.NotFound, "favorite video not found")
-		}
 		log.Error("failed to remove favorite video", "video_id", req.Msg.VideoId, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to remove favorite video: %v", err)
+		return nil, toConnectError(err, "failed to remove favorite video")
 	}
 
 	resp := &pb.RemoveFavoriteVideoResponse{FavoriteVideoUuid: removed.FavoriteVideoUUID}
@@ -101,14 +96,18 @@ func (s *FavoriteServiceServer) ListFavoriteVideos(ctx context.Context, req *con
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
 	}
 
-	favorites, err := s.usecase.ListFavoriteVideos(ctx, userID)
+	query, err := s.presenter.ListQuery(req.Msg.PageSize, req.Msg.PageToken, req.Msg.SortOrder, req.Msg.IncludeDetails)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	page, err := s.usecase.ListFavoriteVideos(ctx, userID, query)
 	if err != nil {
 		log.Error("failed to list favorite videos", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to list favorite videos: %v", err)
+		return nil, toConnectError(err, "failed to list favorite videos")
 	}
 
-	resp := &pb.ListFavoriteVideosResponse{FavoriteVideos: s.presenter.FavoriteVideos(favorites)}
-	return connect.NewResponse(resp), nil
+	return connect.NewResponse(s.presenter.ListFavoriteVideos(page)), nil
 }
 
 func (s *FavoriteServiceServer) AddFavoriteActor(ctx context.Context, req *connect.Request[pb.AddFavoriteActorRequest]) (*connect.Response[pb.AddFavoriteActorResponse], error) {
@@ -124,7 +123,7 @@ func (s *FavoriteServiceServer) AddFavoriteActor(ctx context.Context, req *conne
 	favoriteActor, err := s.usecase.AddFavoriteActor(ctx, userID, req.Msg.ActorId)
 	if err != nil {
 		log.Error("failed to add favorite actor", "actor_id", req.Msg.ActorId, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to add favorite actor: %v", err)
+		return nil, toConnectError(err, "failed to add favorite actor")
 	}
 
 	resp := &pb.AddFavoriteActorResponse{FavoriteActor: s.presenter.FavoriteActor(*favoriteActor)}
@@ -143,11 +142,8 @@ func (s *FavoriteServiceServer) RemoveFavoriteActor(ctx context.Context, req *co
 
 	removed, err := s.usecase.RemoveFavoriteActor(ctx, userID, req.Msg.ActorId)
 	if err != nil {
-		if errors.Is(err, repository.ErrFavoriteActorNotFound) {
-			return nil, status.Error(codes.NotFound, "favorite actor not found")
-		}
 		log.Error("failed to remove favorite actor", "actor_id", req.Msg.ActorId, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to remove favorite actor: %v", err)
+		return nil, toConnectError(err, "failed to remove favorite actor")
 	}
 
 	resp := &pb.RemoveFavoriteActorResponse{FavoriteActorUuid: removed.FavoriteActorUUID}
@@ -161,12 +157,101 @@ func (s *FavoriteServiceServer) ListFavoriteActors(ctx context.Context, req *con
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
 	}
 
-	favorites, err := s.usecase.ListFavoriteActors(ctx, userID)
+	query, err := s.presenter.ListQuery(req.Msg.PageSize, req.Msg.PageToken, req.Msg.SortOrder, req.Msg.IncludeDetails)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	page, err := s.usecase.ListFavoriteActors(ctx, userID, query)
 	if err != nil {
 		log.Error("failed to list favorite actors", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to list favorite actors: %v", err)
+		return nil, toConnectError(err, "failed to list favorite actors")
+	}
+
+	return connect.NewResponse(s.presenter.ListFavoriteActors(page)), nil
+}
+
+func (s *FavoriteServiceServer) AddFavoriteArticle(ctx context.Context, req *connect.Request[pb.AddFavoriteArticleRequest]) (*connect.Response[pb.AddFavoriteArticleResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	articleType, err := s.requiredArticleType(req.Msg.ArticleType, req.Msg.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+
+	favoriteArticle, err := s.usecase.AddFavoriteArticle(ctx, userID, articleType, req.Msg.ArticleId)
+	if err != nil {
+		log.Error("failed to add favorite article", "article_type", articleType, "article_id", req.Msg.ArticleId, "error", err)
+		return nil, toConnectError(err, "failed to add favorite article")
+	}
+
+	resp := &pb.AddFavoriteArticleResponse{FavoriteArticle: s.presenter.FavoriteArticle(*favoriteArticle)}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *FavoriteServiceServer) RemoveFavoriteArticle(ctx context.Context, req *connect.Request[pb.RemoveFavoriteArticleRequest]) (*connect.Response[pb.RemoveFavoriteArticleResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	articleType, err := s.requiredArticleType(req.Msg.ArticleType, req.Msg.ArticleId)
+	if err != nil {
+		return nil, err
 	}
 
-	resp := &pb.ListFavoriteActorsResponse{FavoriteActors: s.presenter.FavoriteActors(favorites)}
+	removed, err := s.usecase.RemoveFavoriteArticle(ctx, userID, articleType, req.Msg.ArticleId)
+	if err != nil {
+		log.Error("failed to remove favorite article", "article_type", articleType, "article_id", req.Msg.ArticleId, "error", err)
+		return nil, toConnectError(err, "failed to remove favorite article")
+	}
+
+	resp := &pb.RemoveFavoriteArticleResponse{}
+	if removed != nil {
+		resp.FavoriteArticleUuid = removed.FavoriteArticleUUID
+		resp.Removed = true
+	}
 	return connect.NewResponse(resp), nil
 }
+
+func (s *FavoriteServiceServer) ListFavoriteArticles(ctx context.Context, req *connect.Request[pb.ListFavoriteArticlesRequest]) (*connect.Response[pb.ListFavoriteArticlesResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	articleType, err := s.presenter.ArticleType(req.Msg.ArticleType)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	query, err := s.presenter.ListQuery(req.Msg.PageSize, req.Msg.PageToken, req.Msg.SortOrder, false)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	page, err := s.usecase.ListFavoriteArticles(ctx, userID, articleType, query)
+	if err != nil {
+		log.Error("failed to list favorite articles", "article_type", articleType, "error", err)
+		return nil, toConnectError(err, "failed to list favorite articles")
+	}
+
+	return connect.NewResponse(s.presenter.ListFavoriteArticles(page)), nil
+}
+
+// requiredArticleType validates the article type and ID that identify a single favorite article.
+func (s *FavoriteServiceServer) requiredArticleType(t pb.ArticleType, articleID string) (entity.ArticleType, error) {
+	articleType, err := s.presenter.ArticleType(t)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if articleType == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("article_type is required"))
+	}
+	if articleID == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("article_id is required"))
+	}
+	return articleType, nil
+}
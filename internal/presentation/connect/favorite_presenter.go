@@ -1,8 +1,12 @@
 package connect
 
 import (
+	"errors"
+	"fmt"
+
 	pb "github.com/tikfack/server/gen/favorite"
 	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
 )
 
 type favoritePresenter struct{}
@@ -12,21 +16,154 @@ func newFavoritePresenter() favoritePresenter {
 }
 
 func (p favoritePresenter) FavoriteVideo(v model.FavoriteVideo) *pb.FavoriteVideo {
-	return &pb.FavoriteVideo{
+	out := &pb.FavoriteVideo{
 		FavoriteVideoUuid: v.FavoriteVideoUUID,
 		UserId:            v.UserID,
 		VideoId:           v.VideoID,
 		CreatedAt:         v.CreatedAt,
 	}
+	if v.Detail != nil {
+		out.DetailStatus = detailStatus(v.Detail.Status)
+		if v.Detail.Video != nil {
+			out.Video = convertToPbVideo(*v.Detail.Video)
+		}
+	}
+	return out
 }
 
 func (p favoritePresenter) FavoriteActor(a model.FavoriteActor) *pb.FavoriteActor {
-	return &pb.FavoriteActor{
+	out := &pb.FavoriteActor{
 		FavoriteActorUuid: a.FavoriteActorUUID,
 		UserId:            a.UserID,
 		ActorId:           a.ActorID,
 		CreatedAt:         a.CreatedAt,
 	}
+	if a.Detail != nil {
+		out.DetailStatus = detailStatus(a.Detail.Status)
+		if a.Detail.Actress != nil {
+			out.Actress = newActressPresenter().Actress(*a.Detail.Actress)
+		}
+	}
+	return out
+}
+
+// ListFavoriteVideos builds the list response, counting favorites whose details could not be embedded.
+func (p favoritePresenter) ListFavoriteVideos(page *model.FavoriteVideoPage) *pb.ListFavoriteVideosResponse {
+	resp := &pb.ListFavoriteVideosResponse{
+		FavoriteVideos: p.FavoriteVideos(page.Favorites),
+		NextPageToken:  page.NextPageToken,
+		TotalCount:     int32(page.TotalCount),
+	}
+	for _, v := range resp.FavoriteVideos {
+		countDetailStatus(v.DetailStatus, &resp.MissingCount, &resp.FailedCount)
+	}
+	return resp
+}
+
+// ListFavoriteActors builds the list response, counting favorites whose details could not be embedded.
+func (p favoritePresenter) ListFavoriteActors(page *model.FavoriteActorPage) *pb.ListFavoriteActorsResponse {
+	resp := &pb.ListFavoriteActorsResponse{
+		FavoriteActors: p.FavoriteActors(page.Favorites),
+		NextPageToken:  page.NextPageToken,
+		TotalCount:     int32(page.TotalCount),
+	}
+	for _, a := range resp.FavoriteActors {
+		countDetailStatus(a.DetailStatus, &resp.MissingCount, &resp.FailedCount)
+	}
+	return resp
+}
+
+// ListQuery converts the paging fields shared by the list requests.
+func (p favoritePresenter) ListQuery(pageSize int32, pageToken string, order pb.FavoriteSortOrder, includeDetails bool) (model.FavoriteListQuery, error) {
+	query := model.FavoriteListQuery{PageSize: int(pageSize), PageToken: pageToken, IncludeDetails: includeDetails}
+	if pageSize < 0 {
+		return query, errors.New("page_size must not be negative")
+	}
+	switch order {
+	case pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_UNSPECIFIED, pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_OLDEST_FIRST:
+		query.Order = model.FavoriteSortOldestFirst
+	case pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_NEWEST_FIRST:
+		query.Order = model.FavoriteSortNewestFirst
+	default:
+		return query, fmt.Errorf("unknown sort_order %d", order)
+	}
+	return query, nil
+}
+
+func (p favoritePresenter) FavoriteArticle(a model.FavoriteArticle) *pb.FavoriteArticle {
+	return &pb.FavoriteArticle{
+		FavoriteArticleUuid: a.FavoriteArticleUUID,
+		UserId:              a.UserID,
+		ArticleType:         pbArticleType(a.ArticleType),
+		ArticleId:           a.ArticleID,
+		CreatedAt:           a.CreatedAt,
+	}
+}
+
+func (p favoritePresenter) ListFavoriteArticles(page *model.FavoriteArticlePage) *pb.ListFavoriteArticlesResponse {
+	resp := &pb.ListFavoriteArticlesResponse{
+		NextPageToken: page.NextPageToken,
+		TotalCount:    int32(page.TotalCount),
+	}
+	for _, a := range page.Favorites {
+		resp.FavoriteArticles = append(resp.FavoriteArticles, p.FavoriteArticle(a))
+	}
+	return resp
+}
+
+// ArticleType converts the requested article type. UNSPECIFIED maps to "" and is only meaningful for listing.
+func (p favoritePresenter) ArticleType(t pb.ArticleType) (entity.ArticleType, error) {
+	switch t {
+	case pb.ArticleType_ARTICLE_TYPE_UNSPECIFIED:
+		return "", nil
+	case pb.ArticleType_ARTICLE_TYPE_GENRE:
+		return entity.ArticleGenre, nil
+	case pb.ArticleType_ARTICLE_TYPE_MAKER:
+		return entity.ArticleMaker, nil
+	case pb.ArticleType_ARTICLE_TYPE_SERIES:
+		return entity.ArticleSeries, nil
+	case pb.ArticleType_ARTICLE_TYPE_DIRECTOR:
+		return entity.ArticleDirector, nil
+	default:
+		return "", fmt.Errorf("unknown article_type %d", t)
+	}
+}
+
+func pbArticleType(t entity.ArticleType) pb.ArticleType {
+	switch t {
+	case entity.ArticleGenre:
+		return pb.ArticleType_ARTICLE_TYPE_GENRE
+	case entity.ArticleMaker:
+		return pb.ArticleType_ARTICLE_TYPE_MAKER
+	case entity.ArticleSeries:
+		return pb.ArticleType_ARTICLE_TYPE_SERIES
+	case entity.ArticleDirector:
+		return pb.ArticleType_ARTICLE_TYPE_DIRECTOR
+	default:
+		return pb.ArticleType_ARTICLE_TYPE_UNSPECIFIED
+	}
+}
+
+func detailStatus(status model.LookupStatus) pb.DetailStatus {
+	switch status {
+	case model.LookupFound:
+		return pb.DetailStatus_DETAIL_STATUS_FOUND
+	case model.LookupNotFound:
+		return pb.DetailStatus_DETAIL_STATUS_NOT_FOUND
+	case model.LookupFailed:
+		return pb.DetailStatus_DETAIL_STATUS_FAILED
+	default:
+		return pb.DetailStatus_DETAIL_STATUS_UNSPECIFIED
+	}
+}
+
+func countDetailStatus(status pb.DetailStatus, missing, failed *int32) {
+	switch status {
+	case pb.DetailStatus_DETAIL_STATUS_NOT_FOUND:
+		*missing++
+	case pb.DetailStatus_DETAIL_STATUS_FAILED:
+		*failed++
+	}
 }
 
 func (p favoritePresenter) FavoriteVideos(videos []model.FavoriteVideo) []*pb.FavoriteVideo {
@@ -0,0 +1,67 @@
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/tikfack/server/gen/favorite"
+	"github.com/tikfack/server/internal/application/model"
+)
+
+func TestFavoritePresenter_ListFavoriteVideos(t *testing.T) {
+	presenter := newFavoritePresenter()
+	resp := presenter.ListFavoriteVideos(&model.FavoriteVideoPage{
+		Favorites: []model.FavoriteVideo{
+			{VideoID: "found", Detail: &model.VideoLookup{DmmID: "found", Status: model.LookupFound, Video: &model.Video{DmmID: "found", Title: "動画"}}},
+			{VideoID: "gone", Detail: &model.VideoLookup{DmmID: "gone", Status: model.LookupNotFound}},
+			{VideoID: "broken", Detail: &model.VideoLookup{DmmID: "broken", Status: model.LookupFailed}},
+			{VideoID: "plain"},
+		},
+		NextPageToken: "next",
+		TotalCount:    10,
+	})
+
+	require.Len(t, resp.FavoriteVideos, 4)
+	require.Equal(t, pb.DetailStatus_DETAIL_STATUS_FOUND, resp.FavoriteVideos[0].DetailStatus)
+	require.Equal(t, "動画", resp.FavoriteVideos[0].Video.Title)
+	require.Nil(t, resp.FavoriteVideos[1].Video)
+	require.Equal(t, pb.DetailStatus_DETAIL_STATUS_UNSPECIFIED, resp.FavoriteVideos[3].DetailStatus)
+	require.Equal(t, int32(1), resp.MissingCount)
+	require.Equal(t, int32(1), resp.FailedCount)
+	require.Equal(t, "next", resp.NextPageToken)
+	require.Equal(t, int32(10), resp.TotalCount)
+}
+
+func TestFavoritePresenter_ListFavoriteActors(t *testing.T) {
+	presenter := newFavoritePresenter()
+	resp := presenter.ListFavoriteActors(&model.FavoriteActorPage{
+		Favorites: []model.FavoriteActor{
+			{ActorID: "1011199", Detail: &model.ActressLookup{ActressID: "1011199", Status: model.LookupFound, Actress: &model.ActressProfile{ID: "1011199", Name: "女優A"}}},
+			{ActorID: "999", Detail: &model.ActressLookup{ActressID: "999", Status: model.LookupNotFound}},
+		},
+		TotalCount: 2,
+	})
+
+	require.Len(t, resp.FavoriteActors, 2)
+	require.Equal(t, "女優A", resp.FavoriteActors[0].Actress.Name)
+	require.Equal(t, int32(1), resp.MissingCount)
+	require.Zero(t, resp.FailedCount)
+	require.Empty(t, resp.NextPageToken)
+}
+
+func TestFavoritePresenter_ListQuery(t *testing.T) {
+	presenter := newFavoritePresenter()
+
+	query, err := presenter.ListQuery(20, "token", pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_NEWEST_FIRST, true)
+	require.NoError(t, err)
+	require.Equal(t, model.FavoriteListQuery{PageSize: 20, PageToken: "token", Order: model.FavoriteSortNewestFirst, IncludeDetails: true}, query)
+
+	query, err = presenter.ListQuery(0, "", pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_UNSPECIFIED, false)
+	require.NoError(t, err)
+	require.Equal(t, model.FavoriteSortOldestFirst, query.Order)
+
+	_, err = presenter.ListQuery(-1, "", pb.FavoriteSortOrder_FAVORITE_SORT_ORDER_UNSPECIFIED, false)
+	require.Error(t, err)
+	_, err = presenter.ListQuery(10, "", pb.FavoriteSortOrder(99), false)
+	require.Error(t, err)
+}
@@ -72,6 +72,21 @@ func (mr *MockVideoServiceClientMockRecorder) GetVideosByDate(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoServiceClient)(nil).GetVideosByDate), arg0, arg1)
 }
 
+// GetVideosByDmmIds mocks base method.
+func (m *MockVideoServiceClient) GetVideosByDmmIds(arg0 context.Context, arg1 *connect.Request[video.GetVideosByDmmIdsRequest]) (*connect.Response[video.GetVideosByDmmIdsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideosByDmmIds", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.GetVideosByDmmIdsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideosByDmmIds indicates an expected call of GetVideosByDmmIds.
+func (mr *MockVideoServiceClientMockRecorder) GetVideosByDmmIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDmmIds", reflect.TypeOf((*MockVideoServiceClient)(nil).GetVideosByDmmIds), arg0, arg1)
+}
+
 // GetVideosByID mocks base method.
 func (m *MockVideoServiceClient) GetVideosByID(arg0 context.Context, arg1 *connect.Request[video.GetVideosByIDRequest]) (*connect.Response[video.GetVideosByIDResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +117,36 @@ func (mr *MockVideoServiceClientMockRecorder) GetVideosByKeyword(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoServiceClient)(nil).GetVideosByKeyword), arg0, arg1)
 }
 
+// ListFloors mocks base method.
+func (m *MockVideoServiceClient) ListFloors(arg0 context.Context, arg1 *connect.Request[video.ListFloorsRequest]) (*connect.Response[video.ListFloorsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.ListFloorsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockVideoServiceClientMockRecorder) ListFloors(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockVideoServiceClient)(nil).ListFloors), arg0, arg1)
+}
+
+// ResolvePlaybackURLs mocks base method.
+func (m *MockVideoServiceClient) ResolvePlaybackURLs(arg0 context.Context, arg1 *connect.Request[video.ResolvePlaybackURLsRequest]) (*connect.Response[video.ResolvePlaybackURLsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePlaybackURLs", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.ResolvePlaybackURLsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePlaybackURLs indicates an expected call of ResolvePlaybackURLs.
+func (mr *MockVideoServiceClientMockRecorder) ResolvePlaybackURLs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePlaybackURLs", reflect.TypeOf((*MockVideoServiceClient)(nil).ResolvePlaybackURLs), arg0, arg1)
+}
+
 // SearchVideos mocks base method.
 func (m *MockVideoServiceClient) SearchVideos(arg0 context.Context, arg1 *connect.Request[video.SearchVideosRequest]) (*connect.Response[video.SearchVideosResponse], error) {
 	m.ctrl.T.Helper()
@@ -171,6 +216,21 @@ func (mr *MockVideoServiceHandlerMockRecorder) GetVideosByDate(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDate", reflect.TypeOf((*MockVideoServiceHandler)(nil).GetVideosByDate), arg0, arg1)
 }
 
+// GetVideosByDmmIds mocks base method.
+func (m *MockVideoServiceHandler) GetVideosByDmmIds(arg0 context.Context, arg1 *connect.Request[video.GetVideosByDmmIdsRequest]) (*connect.Response[video.GetVideosByDmmIdsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideosByDmmIds", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.GetVideosByDmmIdsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideosByDmmIds indicates an expected call of GetVideosByDmmIds.
+func (mr *MockVideoServiceHandlerMockRecorder) GetVideosByDmmIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByDmmIds", reflect.TypeOf((*MockVideoServiceHandler)(nil).GetVideosByDmmIds), arg0, arg1)
+}
+
 // GetVideosByID mocks base method.
 func (m *MockVideoServiceHandler) GetVideosByID(arg0 context.Context, arg1 *connect.Request[video.GetVideosByIDRequest]) (*connect.Response[video.GetVideosByIDResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +261,36 @@ func (mr *MockVideoServiceHandlerMockRecorder) GetVideosByKeyword(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByKeyword", reflect.TypeOf((*MockVideoServiceHandler)(nil).GetVideosByKeyword), arg0, arg1)
 }
 
+// ListFloors mocks base method.
+func (m *MockVideoServiceHandler) ListFloors(arg0 context.Context, arg1 *connect.Request[video.ListFloorsRequest]) (*connect.Response[video.ListFloorsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.ListFloorsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockVideoServiceHandlerMockRecorder) ListFloors(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockVideoServiceHandler)(nil).ListFloors), arg0, arg1)
+}
+
+// ResolvePlaybackURLs mocks base method.
+func (m *MockVideoServiceHandler) ResolvePlaybackURLs(arg0 context.Context, arg1 *connect.Request[video.ResolvePlaybackURLsRequest]) (*connect.Response[video.ResolvePlaybackURLsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePlaybackURLs", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[video.ResolvePlaybackURLsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePlaybackURLs indicates an expected call of ResolvePlaybackURLs.
+func (mr *MockVideoServiceHandlerMockRecorder) ResolvePlaybackURLs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePlaybackURLs", reflect.TypeOf((*MockVideoServiceHandler)(nil).ResolvePlaybackURLs), arg0, arg1)
+}
+
 // SearchVideos mocks base method.
 func (m *MockVideoServiceHandler) SearchVideos(arg0 context.Context, arg1 *connect.Request[video.SearchVideosRequest]) (*connect.Response[video.SearchVideosResponse], error) {
 	m.ctrl.T.Helper()
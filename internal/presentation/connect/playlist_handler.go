@@ -0,0 +1,231 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	pb "github.com/tikfack/server/gen/playlist"
+	playlistconnect "github.com/tikfack/server/gen/playlist/playlistconnect"
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/usecase/playlist"
+	"github.com/tikfack/server/internal/middleware/ctxkeys"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// PlaylistServiceServer is the Connect handler implementing PlaylistService.
+type PlaylistServiceServer struct {
+	usecase     playlist.PlaylistUsecase
+	presenter   playlistPresenter
+	logger      *slog.Logger
+	handlerOpts []connect.HandlerOption
+}
+
+// NewPlaylistServiceHandler constructs a new handler.
+func NewPlaylistServiceHandler(uc playlist.PlaylistUsecase, opts ...connect.HandlerOption) *PlaylistServiceServer {
+	if uc == nil {
+		panic("playlist usecase must be provided")
+	}
+	return &PlaylistServiceServer{
+		usecase:     uc,
+		presenter:   newPlaylistPresenter(),
+		logger:      slog.Default().With(slog.String("component", "playlist_handler")),
+		handlerOpts: append([]connect.HandlerOption{connect.WithCompressMinBytes(0)}, opts...),
+	}
+}
+
+// GetHandler exposes the Connect handler pair.
+func (s *PlaylistServiceServer) GetHandler() (string, http.Handler) {
+	pattern, handler := playlistconnect.NewPlaylistServiceHandler(s, s.handlerOpts...)
+	return pattern, handler
+}
+
+func (s *PlaylistServiceServer) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return s.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+func (s *PlaylistServiceServer) CreatePlaylist(ctx context.Context, req *connect.Request[pb.CreatePlaylistRequest]) (*connect.Response[pb.CreatePlaylistResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	visibility, err := s.presenter.Visibility(req.Msg.Visibility)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	created, err := s.usecase.CreatePlaylist(ctx, userID, model.PlaylistInput{
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+		Visibility:  visibility,
+	})
+	if err != nil {
+		log.Error("failed to create playlist", "error", err)
+		return nil, toConnectError(err, "failed to create playlist")
+	}
+
+	return connect.NewResponse(&pb.CreatePlaylistResponse{Playlist: s.presenter.Playlist(*created)}), nil
+}
+
+func (s *PlaylistServiceServer) UpdatePlaylist(ctx context.Context, req *connect.Request[pb.UpdatePlaylistRequest]) (*connect.Response[pb.UpdatePlaylistResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.PlaylistUuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("playlist_uuid is required"))
+	}
+	visibility, err := s.presenter.Visibility(req.Msg.Visibility)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	updated, err := s.usecase.UpdatePlaylist(ctx, userID, req.Msg.PlaylistUuid, model.PlaylistUpdate{
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+		Visibility:  visibility,
+	})
+	if err != nil {
+		log.Error("failed to update playlist", "playlist_uuid", req.Msg.PlaylistUuid, "error", err)
+		return nil, toConnectError(err, "failed to update playlist")
+	}
+
+	return connect.NewResponse(&pb.UpdatePlaylistResponse{Playlist: s.presenter.Playlist(*updated)}), nil
+}
+
+func (s *PlaylistServiceServer) DeletePlaylist(ctx context.Context, req *connect.Request[pb.DeletePlaylistRequest]) (*connect.Response[pb.DeletePlaylistResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.PlaylistUuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("playlist_uuid is required"))
+	}
+
+	if err := s.usecase.DeletePlaylist(ctx, userID, req.Msg.PlaylistUuid); err != nil {
+		log.Error("failed to delete playlist", "playlist_uuid", req.Msg.PlaylistUuid, "error", err)
+		return nil, toConnectError(err, "failed to delete playlist")
+	}
+
+	return connect.NewResponse(&pb.DeletePlaylistResponse{}), nil
+}
+
+func (s *PlaylistServiceServer) GetPlaylist(ctx context.Context, req *connect.Request[pb.GetPlaylistRequest]) (*connect.Response[pb.GetPlaylistResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.PlaylistUuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("playlist_uuid is required"))
+	}
+
+	found, err := s.usecase.GetPlaylist(ctx, userID, req.Msg.PlaylistUuid)
+	if err != nil {
+		log.Error("failed to get playlist", "playlist_uuid", req.Msg.PlaylistUuid, "error", err)
+		return nil, toConnectError(err, "failed to get playlist")
+	}
+
+	resp := &pb.GetPlaylistResponse{
+		Playlist: s.presenter.Playlist(*found),
+		Items:    s.presenter.Items(found.Items),
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *PlaylistServiceServer) ListPlaylists(ctx context.Context, req *connect.Request[pb.ListPlaylistsRequest]) (*connect.Response[pb.ListPlaylistsResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+
+	playlists, err := s.usecase.ListPlaylists(ctx, userID, req.Msg.OwnerId)
+	if err != nil {
+		log.Error("failed to list playlists", "owner_id", req.Msg.OwnerId, "error", err)
+		return nil, toConnectError(err, "failed to list playlists")
+	}
+
+	return connect.NewResponse(&pb.ListPlaylistsResponse{Playlists: s.presenter.Playlists(playlists)}), nil
+}
+
+func (s *PlaylistServiceServer) AddPlaylistItem(ctx context.Context, req *connect.Request[pb.AddPlaylistItemRequest]) (*connect.Response[pb.AddPlaylistItemResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if err := requirePlaylistItem(req.Msg.PlaylistUuid, req.Msg.VideoId); err != nil {
+		return nil, err
+	}
+
+	item, err := s.usecase.AddPlaylistItem(ctx, userID, req.Msg.PlaylistUuid, req.Msg.VideoId)
+	if err != nil {
+		log.Error("failed to add playlist item", "playlist_uuid", req.Msg.PlaylistUuid, "video_id", req.Msg.VideoId, "error", err)
+		return nil, toConnectError(err, "failed to add playlist item")
+	}
+
+	return connect.NewResponse(&pb.AddPlaylistItemResponse{Item: s.presenter.Item(*item)}), nil
+}
+
+func (s *PlaylistServiceServer) RemovePlaylistItem(ctx context.Context, req *connect.Request[pb.RemovePlaylistItemRequest]) (*connect.Response[pb.RemovePlaylistItemResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if err := requirePlaylistItem(req.Msg.PlaylistUuid, req.Msg.VideoId); err != nil {
+		return nil, err
+	}
+
+	if err := s.usecase.RemovePlaylistItem(ctx, userID, req.Msg.PlaylistUuid, req.Msg.VideoId); err != nil {
+		log.Error("failed to remove playlist item", "playlist_uuid", req.Msg.PlaylistUuid, "video_id", req.Msg.VideoId, "error", err)
+		return nil, toConnectError(err, "failed to remove playlist item")
+	}
+
+	return connect.NewResponse(&pb.RemovePlaylistItemResponse{}), nil
+}
+
+func (s *PlaylistServiceServer) MovePlaylistItem(ctx context.Context, req *connect.Request[pb.MovePlaylistItemRequest]) (*connect.Response[pb.MovePlaylistItemResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if err := requirePlaylistItem(req.Msg.PlaylistUuid, req.Msg.VideoId); err != nil {
+		return nil, err
+	}
+	if req.Msg.Position < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("position must not be negative"))
+	}
+
+	items, err := s.usecase.MovePlaylistItem(ctx, userID, req.Msg.PlaylistUuid, req.Msg.VideoId, int(req.Msg.Position))
+	if err != nil {
+		log.Error("failed to move playlist item", "playlist_uuid", req.Msg.PlaylistUuid, "video_id", req.Msg.VideoId, "error", err)
+		return nil, toConnectError(err, "failed to move playlist item")
+	}
+
+	return connect.NewResponse(&pb.MovePlaylistItemResponse{Items: s.presenter.Items(items)}), nil
+}
+
+func requirePlaylistItem(playlistUUID, videoID string) error {
+	if playlistUUID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("playlist_uuid is required"))
+	}
+	if videoID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("video_id is required"))
+	}
+	return nil
+}
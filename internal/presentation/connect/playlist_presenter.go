@@ -0,0 +1,87 @@
+package connect
+
+import (
+	"fmt"
+
+	pb "github.com/tikfack/server/gen/playlist"
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/domain/entity"
+)
+
+type playlistPresenter struct{}
+
+func newPlaylistPresenter() playlistPresenter {
+	return playlistPresenter{}
+}
+
+func (p playlistPresenter) Playlist(pl model.Playlist) *pb.Playlist {
+	return &pb.Playlist{
+		PlaylistUuid: pl.PlaylistUUID,
+		OwnerId:      pl.OwnerID,
+		Name:         pl.Name,
+		Description:  pl.Description,
+		Visibility:   pbPlaylistVisibility(pl.Visibility),
+		ItemCount:    int32(pl.ItemCount),
+		CreatedAt:    pl.CreatedAt,
+		UpdatedAt:    pl.UpdatedAt,
+	}
+}
+
+func (p playlistPresenter) Playlists(playlists []model.Playlist) []*pb.Playlist {
+	if len(playlists) == 0 {
+		return nil
+	}
+	out := make([]*pb.Playlist, 0, len(playlists))
+	for _, pl := range playlists {
+		out = append(out, p.Playlist(pl))
+	}
+	return out
+}
+
+func (p playlistPresenter) Item(item model.PlaylistItem) *pb.PlaylistItem {
+	return &pb.PlaylistItem{
+		VideoId:  item.VideoID,
+		Position: int32(item.Position),
+		AddedAt:  item.AddedAt,
+	}
+}
+
+func (p playlistPresenter) Items(items []model.PlaylistItem) []*pb.PlaylistItem {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]*pb.PlaylistItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, p.Item(item))
+	}
+	return out
+}
+
+// Visibility converts the requested visibility. UNSPECIFIED maps to "", which the usecase treats as the default.
+func (p playlistPresenter) Visibility(v pb.PlaylistVisibility) (entity.PlaylistVisibility, error) {
+	switch v {
+	case pb.PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED:
+		return "", nil
+	case pb.PlaylistVisibility_PLAYLIST_VISIBILITY_PRIVATE:
+		return entity.PlaylistPrivate, nil
+	case pb.PlaylistVisibility_PLAYLIST_VISIBILITY_UNLISTED:
+		return entity.PlaylistUnlisted, nil
+	case pb.PlaylistVisibility_PLAYLIST_VISIBILITY_PUBLIC:
+		return entity.PlaylistPublic, nil
+	default:
+		return "", fmt.Errorf("unknown visibility %d", v)
+	}
+}
+
+func pbPlaylistVisibility(v entity.PlaylistVisibility) pb.PlaylistVisibility {
+	switch v {
+	case entity.PlaylistPrivate:
+		return pb.PlaylistVisibility_PLAYLIST_VISIBILITY_PRIVATE
+	case entity.PlaylistUnlisted:
+		return pb.PlaylistVisibility_PLAYLIST_VISIBILITY_UNLISTED
+	case entity.PlaylistPublic:
+		return pb.PlaylistVisibility_PLAYLIST_VISIBILITY_PUBLIC
+	default:
+		return pb.PlaylistVisibility_PLAYLIST_VISIBILITY_UNSPECIFIED
+	}
+}
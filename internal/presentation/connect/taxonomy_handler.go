@@ -0,0 +1,124 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+
+	pb "github.com/tikfack/server/gen/taxonomy"
+	taxonomyconnect "github.com/tikfack/server/gen/taxonomy/taxonomyconnect"
+
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/usecase/taxonomy"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// CatalogTaxonomyServiceServer は CatalogTaxonomyService の Connect サーバー実装です。
+type CatalogTaxonomyServiceServer struct {
+	usecase     taxonomy.TaxonomyUsecase
+	presenter   taxonomyPresenter
+	logger      *slog.Logger
+	handlerOpts []connect.HandlerOption
+}
+
+// NewCatalogTaxonomyServiceHandler はユースケースを受け取り Connect ハンドラを構築する。
+func NewCatalogTaxonomyServiceHandler(uc taxonomy.TaxonomyUsecase, opts ...connect.HandlerOption) *CatalogTaxonomyServiceServer {
+	if uc == nil {
+		panic("taxonomy usecase must be provided")
+	}
+	return &CatalogTaxonomyServiceServer{
+		usecase:     uc,
+		presenter:   newTaxonomyPresenter(),
+		logger:      slog.Default().With(slog.String("component", "taxonomy_handler")),
+		handlerOpts: append([]connect.HandlerOption{connect.WithCompressMinBytes(0)}, opts...),
+	}
+}
+
+// GetHandler は、Connect サービスのパターンとハンドラーを返します。
+func (s *CatalogTaxonomyServiceServer) GetHandler() (string, http.Handler) {
+	pattern, handler := taxonomyconnect.NewCatalogTaxonomyServiceHandler(s, s.handlerOpts...)
+	return pattern, handler
+}
+
+func (s *CatalogTaxonomyServiceServer) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return s.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+// ListGenres は、ジャンルの一覧を取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) ListGenres(ctx context.Context, req *connect.Request[pb.ListTaxonomyRequest]) (*connect.Response[pb.ListTaxonomyResponse], error) {
+	return s.list(ctx, model.TaxonomyGenre, req.Msg)
+}
+
+// ListMakers は、メーカーの一覧を取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) ListMakers(ctx context.Context, req *connect.Request[pb.ListTaxonomyRequest]) (*connect.Response[pb.ListTaxonomyResponse], error) {
+	return s.list(ctx, model.TaxonomyMaker, req.Msg)
+}
+
+// ListSeries は、シリーズの一覧を取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) ListSeries(ctx context.Context, req *connect.Request[pb.ListTaxonomyRequest]) (*connect.Response[pb.ListTaxonomyResponse], error) {
+	return s.list(ctx, model.TaxonomySeries, req.Msg)
+}
+
+// GetGenre は、ID でジャンルを取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) GetGenre(ctx context.Context, req *connect.Request[pb.GetTaxonomyRequest]) (*connect.Response[pb.GetTaxonomyResponse], error) {
+	return s.get(ctx, model.TaxonomyGenre, req.Msg)
+}
+
+// GetMaker は、ID でメーカーを取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) GetMaker(ctx context.Context, req *connect.Request[pb.GetTaxonomyRequest]) (*connect.Response[pb.GetTaxonomyResponse], error) {
+	return s.get(ctx, model.TaxonomyMaker, req.Msg)
+}
+
+// GetSeries は、ID でシリーズを取得するエンドポイントの実装。
+func (s *CatalogTaxonomyServiceServer) GetSeries(ctx context.Context, req *connect.Request[pb.GetTaxonomyRequest]) (*connect.Response[pb.GetTaxonomyResponse], error) {
+	return s.get(ctx, model.TaxonomySeries, req.Msg)
+}
+
+func (s *CatalogTaxonomyServiceServer) list(ctx context.Context, kind model.TaxonomyKind, msg *pb.ListTaxonomyRequest) (*connect.Response[pb.ListTaxonomyResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: ListTaxonomy", "kind", kind, "floorId", msg.FloorId, "initial", msg.Initial, "hits", msg.Hits, "offset", msg.Offset)
+
+	entries, metadata, err := s.usecase.List(ctx, kind, model.TaxonomyQuery{
+		FloorID: strings.TrimSpace(msg.FloorId),
+		Initial: msg.Initial,
+		Hits:    msg.Hits,
+		Offset:  msg.Offset,
+	})
+	if err != nil {
+		logger.Error("分類一覧の取得に失敗", "kind", kind, "error", err)
+		return nil, toConnectError(err, "分類一覧の取得に失敗しました")
+	}
+
+	logger.Debug("ListTaxonomy completed", "kind", kind, "count", len(entries))
+	return connect.NewResponse(&pb.ListTaxonomyResponse{
+		Entries:  s.presenter.Entries(entries),
+		Metadata: s.presenter.Metadata(metadata),
+	}), nil
+}
+
+func (s *CatalogTaxonomyServiceServer) get(ctx context.Context, kind model.TaxonomyKind, msg *pb.GetTaxonomyRequest) (*connect.Response[pb.GetTaxonomyResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: GetTaxonomy", "kind", kind, "id", msg.Id, "floorId", msg.FloorId, "initial", msg.Initial)
+
+	id := strings.TrimSpace(msg.Id)
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	entry, err := s.usecase.Get(ctx, kind, strings.TrimSpace(msg.FloorId), id, msg.Initial)
+	if err != nil {
+		logger.Error("分類の取得に失敗", "kind", kind, "id", id, "error", err)
+		return nil, toConnectError(err, "分類の取得に失敗しました")
+	}
+
+	logger.Debug("GetTaxonomy completed", "kind", kind, "id", entry.ID, "name", entry.Name)
+	return connect.NewResponse(&pb.GetTaxonomyResponse{Entry: s.presenter.Entry(*entry)}), nil
+}
@@ -0,0 +1,147 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pb "github.com/tikfack/server/gen/taxonomy"
+	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
+	mocktaxonomy "github.com/tikfack/server/internal/application/usecase/mock"
+)
+
+var testTaxonomyEntry = model.TaxonomyEntry{
+	ID:      "6003",
+	Name:    "ドラマ",
+	Ruby:    "どらま",
+	ListURL: "https://example.com/genre/6003",
+}
+
+func checkTaxonomyFields(t *testing.T, got *pb.TaxonomyEntry, want model.TaxonomyEntry) {
+	require.Equal(t, want.ID, got.Id)
+	require.Equal(t, want.Name, got.Name)
+	require.Equal(t, want.Ruby, got.Ruby)
+	require.Equal(t, want.ListURL, got.ListUrl)
+}
+
+func TestListTaxonomy(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(s *CatalogTaxonomyServiceServer, ctx context.Context, req *connect.Request[pb.ListTaxonomyRequest]) (*connect.Response[pb.ListTaxonomyResponse], error)
+		kind      model.TaxonomyKind
+		request   *pb.ListTaxonomyRequest
+		query     model.TaxonomyQuery
+		err       error
+		errorCode connect.Code
+	}{
+		{
+			name:    "正常系 - ジャンル",
+			call:    (*CatalogTaxonomyServiceServer).ListGenres,
+			kind:    model.TaxonomyGenre,
+			request: &pb.ListTaxonomyRequest{FloorId: " 43 ", Initial: "ど", Hits: 500, Offset: 1},
+			query:   model.TaxonomyQuery{FloorID: "43", Initial: "ど", Hits: 500, Offset: 1},
+		},
+		{
+			name:    "正常系 - メーカー",
+			call:    (*CatalogTaxonomyServiceServer).ListMakers,
+			kind:    model.TaxonomyMaker,
+			request: &pb.ListTaxonomyRequest{},
+			query:   model.TaxonomyQuery{},
+		},
+		{
+			name:      "異常系 - シリーズ：フロアIDが不正",
+			call:      (*CatalogTaxonomyServiceServer).ListSeries,
+			kind:      model.TaxonomySeries,
+			request:   &pb.ListTaxonomyRequest{FloorId: "abc"},
+			query:     model.TaxonomyQuery{FloorID: "abc"},
+			err:       &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Err: errors.New("bad floor_id")},
+			errorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocktaxonomy.NewMockTaxonomyUsecase(ctrl)
+			if tt.err != nil {
+				mockUsecase.EXPECT().List(gomock.Any(), tt.kind, tt.query).Return(nil, nil, tt.err)
+			} else {
+				mockUsecase.EXPECT().List(gomock.Any(), tt.kind, tt.query).
+					Return([]model.TaxonomyEntry{testTaxonomyEntry}, testMetadata, nil)
+			}
+
+			resp, err := tt.call(NewCatalogTaxonomyServiceHandler(mockUsecase), context.Background(), connect.NewRequest(tt.request))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Entries, 1)
+			checkTaxonomyFields(t, resp.Msg.Entries[0], testTaxonomyEntry)
+			require.Equal(t, int32(testMetadata.TotalCount), resp.Msg.Metadata.TotalCount)
+		})
+	}
+}
+
+func TestGetTaxonomy(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(s *CatalogTaxonomyServiceServer, ctx context.Context, req *connect.Request[pb.GetTaxonomyRequest]) (*connect.Response[pb.GetTaxonomyResponse], error)
+		request   *pb.GetTaxonomyRequest
+		setupMock func(m *mocktaxonomy.MockTaxonomyUsecase)
+		errorCode connect.Code
+	}{
+		{
+			name:    "正常系 - ジャンル",
+			call:    (*CatalogTaxonomyServiceServer).GetGenre,
+			request: &pb.GetTaxonomyRequest{Id: "6003", Initial: "ど"},
+			setupMock: func(m *mocktaxonomy.MockTaxonomyUsecase) {
+				m.EXPECT().Get(gomock.Any(), model.TaxonomyGenre, "", "6003", "ど").Return(&testTaxonomyEntry, nil)
+			},
+		},
+		{
+			name:      "異常系 - ID 未指定",
+			call:      (*CatalogTaxonomyServiceServer).GetMaker,
+			request:   &pb.GetTaxonomyRequest{Id: " "},
+			setupMock: func(m *mocktaxonomy.MockTaxonomyUsecase) {},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:    "異常系 - シリーズが見つからない",
+			call:    (*CatalogTaxonomyServiceServer).GetSeries,
+			request: &pb.GetTaxonomyRequest{Id: "999", FloorId: "43"},
+			setupMock: func(m *mocktaxonomy.MockTaxonomyUsecase) {
+				m.EXPECT().Get(gomock.Any(), model.TaxonomySeries, "43", "999", "").
+					Return(nil, &port.CatalogError{Kind: port.ErrCatalogNotFound, Err: errors.New("not found")})
+			},
+			errorCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mocktaxonomy.NewMockTaxonomyUsecase(ctrl)
+			tt.setupMock(mockUsecase)
+
+			resp, err := tt.call(NewCatalogTaxonomyServiceHandler(mockUsecase), context.Background(), connect.NewRequest(tt.request))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			checkTaxonomyFields(t, resp.Msg.Entry, testTaxonomyEntry)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package connect
+
+import (
+	pb "github.com/tikfack/server/gen/taxonomy"
+	"github.com/tikfack/server/internal/application/model"
+)
+
+// taxonomyPresenter はジャンル・メーカー・シリーズのモデルを transport 層の pb メッセージへ変換する。
+type taxonomyPresenter struct{}
+
+func newTaxonomyPresenter() taxonomyPresenter {
+	return taxonomyPresenter{}
+}
+
+func (p taxonomyPresenter) Entry(e model.TaxonomyEntry) *pb.TaxonomyEntry {
+	return &pb.TaxonomyEntry{
+		Id:      e.ID,
+		Name:    e.Name,
+		Ruby:    e.Ruby,
+		ListUrl: e.ListURL,
+	}
+}
+
+func (p taxonomyPresenter) Entries(entries []model.TaxonomyEntry) []*pb.TaxonomyEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	out := make([]*pb.TaxonomyEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, p.Entry(e))
+	}
+	return out
+}
+
+func (p taxonomyPresenter) Metadata(md *model.SearchMetadata) *pb.SearchMetadata {
+	if md == nil {
+		return nil
+	}
+	return &pb.SearchMetadata{
+		ResultCount:   int32(md.ResultCount),
+		TotalCount:    int32(md.TotalCount),
+		FirstPosition: int32(md.FirstPosition),
+	}
+}
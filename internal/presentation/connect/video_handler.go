@@ -2,21 +2,43 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/bufbuild/connect-go"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	pb "github.com/tikfack/server/gen/video"
 	videoconnect "github.com/tikfack/server/gen/video/videoconnect"
 
+	"github.com/tikfack/server/internal/application/model"
 	video "github.com/tikfack/server/internal/application/usecase/video"
+	"github.com/tikfack/server/internal/infrastructure/util"
 	"github.com/tikfack/server/internal/middleware/logger"
 )
 
+// maxPlaybackURLs は ResolvePlaybackURLs で一度に解決できる動画数の上限。
+const maxPlaybackURLs = 100
+
+// maxBatchVideoIDs は GetVideosByDmmIds で一度に取得できる動画数の上限。
+const maxBatchVideoIDs = 100
+
+// validateDmmIDs は dmm_ids の各値が空でなく、DMM の content_id の文字種に収まっていることを検証する。
+func validateDmmIDs(ids []string) error {
+	for _, id := range ids {
+		if strings.TrimSpace(id) == "" {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("dmm_ids に空の値が含まれています"))
+		}
+		if !util.IsValidDmmID(id) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dmm_ids に不正な値が含まれています: %q", id))
+		}
+	}
+	return nil
+}
+
 // VideoServiceServer は Connect のサーバー実装です。
 type VideoServiceServer struct {
 	videoUsecase video.VideoUsecase
@@ -25,9 +47,13 @@ type VideoServiceServer struct {
 	handlerOpts  []connect.HandlerOption
 }
 
-// NewVideoServiceHandler はユースケースを受け取り Connect ハンドラを構築する。
-func NewVideoServiceHandler(vu video.VideoUsecase, opts ...connect.HandlerOption) *VideoServiceServer {
-	return newVideoServiceServer(vu, nil, opts...)
+// NewVideoServiceHandler はユースケースと DirectURL のリゾルバを受け取り Connect ハンドラを構築する。
+// 同時実行数の上限とキャッシュをプロセス全体で共有するため、resolver は呼び出し元で 1 つだけ作って渡す。
+func NewVideoServiceHandler(vu video.VideoUsecase, resolver *util.DirectURLResolver, opts ...connect.HandlerOption) *VideoServiceServer {
+	if resolver == nil {
+		panic("direct url resolver must be provided")
+	}
+	return newVideoServiceServer(vu, newVideoPresenter(resolver), opts...)
 }
 
 func newVideoServiceServer(vu video.VideoUsecase, presenter videoPresenter, opts ...connect.HandlerOption) *VideoServiceServer {
@@ -35,7 +61,7 @@ func newVideoServiceServer(vu video.VideoUsecase, presenter videoPresenter, opts
 		panic("video usecase must be provided")
 	}
 	if presenter == nil {
-		presenter = newVideoPresenter()
+		panic("video presenter must be provided")
 	}
 	return &VideoServiceServer{
 		videoUsecase: vu,
@@ -62,24 +88,24 @@ func (s *VideoServiceServer) loggerWithCtx(ctx context.Context) *slog.Logger {
 // GetVideosByDate は、動画一覧を取得するエンドポイントの実装。
 func (s *VideoServiceServer) GetVideosByDate(ctx context.Context, req *connect.Request[pb.GetVideosByDateRequest]) (*connect.Response[pb.GetVideosByDateResponse], error) {
 	logger := s.loggerWithCtx(ctx)
-	logger.Debug("API: GetVideosByDate", "date", req.Msg.Date, "hits", req.Msg.Hits, "offset", req.Msg.Offset)
+	logger.Debug("API: GetVideosByDate", "date", req.Msg.Date, "hits", req.Msg.Hits, "offset", req.Msg.Offset, "floor", req.Msg.Floor)
 
 	targetDate, err := parseDate(req.Msg.Date)
 	if err != nil {
 		logger.Error("invalid date supplied", "date", req.Msg.Date, "error", err)
-		return nil, status.Error(codes.InvalidArgument, "不正な日付形式です")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("不正な日付形式です"))
 	}
 
 	hits := clampHits(req.Msg.Hits)
 	offset := clampOffset(req.Msg.Offset)
 
-	videos, metadata, err := s.videoUsecase.GetVideosByDate(ctx, targetDate, hits, offset)
+	videos, metadata, err := s.videoUsecase.GetVideosByDate(ctx, floorSelector(req.Msg), targetDate, hits, offset, pageSaleOptions(req.Msg))
 	if err != nil {
 		logger.Error("動画の取得に失敗", "date", targetDate.Format("2006-01-02"), "hits", hits, "offset", offset, "error", err)
-		return nil, status.Errorf(codes.Internal, "動画の取得に失敗しました: %v", err)
+		return nil, toConnectError(err, "動画の取得に失敗しました")
 	}
 
-	pbVideos := s.presenter.Videos(ctx, videos)
+	pbVideos := s.presenter.Videos(ctx, videos, req.Msg.SkipDirectUrl)
 	pbMetadata := s.presenter.Metadata(metadata)
 	logger.Debug("GetVideosByDate completed", "count", len(pbVideos), "hits", hits, "offset", offset)
 	res := &pb.GetVideosByDateResponse{Videos: pbVideos, Metadata: pbMetadata}
@@ -89,16 +115,16 @@ func (s *VideoServiceServer) GetVideosByDate(ctx context.Context, req *connect.R
 // GetVideoById は、ID で動画を取得するエンドポイントの実装。
 func (s *VideoServiceServer) GetVideoById(ctx context.Context, req *connect.Request[pb.GetVideoByIdRequest]) (*connect.Response[pb.GetVideoByIdResponse], error) {
 	logger := s.loggerWithCtx(ctx)
-	logger.Debug("API: GetVideoById", "dmmId", req.Msg.DmmId)
+	logger.Debug("API: GetVideoById", "dmmId", req.Msg.DmmId, "floor", req.Msg.Floor)
 
-	video, err := s.videoUsecase.GetVideoById(ctx, req.Msg.DmmId)
+	video, err := s.videoUsecase.GetVideoById(ctx, floorSelector(req.Msg), req.Msg.DmmId)
 	if err != nil {
 		logger.Error("動画の取得に失敗", "dmmId", req.Msg.DmmId, "error", err)
-		return nil, status.Errorf(codes.Internal, "動画の取得に失敗しました: %v", err)
+		return nil, toConnectError(err, "動画の取得に失敗しました")
 	}
 	if video == nil {
 		logger.Info("動画が見つかりません", "dmmId", req.Msg.DmmId)
-		return nil, status.Error(codes.NotFound, "video not found")
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("video not found"))
 	}
 
 	logger.Debug("GetVideoById completed", "dmmId", req.Msg.DmmId, "title", video.Title)
@@ -120,19 +146,21 @@ func (s *VideoServiceServer) SearchVideos(ctx context.Context, req *connect.Requ
 	)
 
 	videos, metadata, err := s.videoUsecase.SearchVideos(ctx,
+		floorSelector(req.Msg),
 		req.Msg.Keyword,
 		req.Msg.ActressId,
 		req.Msg.GenreId,
 		req.Msg.MakerId,
 		req.Msg.SeriesId,
 		req.Msg.DirectorId,
+		pageSaleOptions(req.Msg),
 	)
 	if err != nil {
 		logger.Error("動画の検索に失敗", "keyword", req.Msg.Keyword, "error", err)
-		return nil, status.Errorf(codes.Internal, "動画の検索に失敗しました: %v", err)
+		return nil, toConnectError(err, "動画の検索に失敗しました")
 	}
 
-	pbVideos := s.presenter.Videos(ctx, videos)
+	pbVideos := s.presenter.Videos(ctx, videos, req.Msg.SkipDirectUrl)
 	pbMetadata := s.presenter.Metadata(metadata)
 	logger.Debug("SearchVideos completed", "count", len(pbVideos))
 	return connect.NewResponse(&pb.SearchVideosResponse{Videos: pbVideos, Metadata: pbMetadata}), nil
@@ -156,6 +184,7 @@ func (s *VideoServiceServer) GetVideosByID(ctx context.Context, req *connect.Req
 	offset := clampOffset(req.Msg.Offset)
 
 	videos, metadata, err := s.videoUsecase.GetVideosByID(ctx,
+		floorSelector(req.Msg),
 		req.Msg.ActressId,
 		req.Msg.GenreId,
 		req.Msg.MakerId,
@@ -166,16 +195,14 @@ func (s *VideoServiceServer) GetVideosByID(ctx context.Context, req *connect.Req
 		req.Msg.Sort,
 		req.Msg.GteDate,
 		req.Msg.LteDate,
-		req.Msg.Site,
-		req.Msg.Service,
-		req.Msg.Floor,
+		pageSaleOptions(req.Msg),
 	)
 	if err != nil {
 		logger.Error("動画の検索に失敗", "error", err)
-		return nil, status.Errorf(codes.Internal, "動画の検索に失敗しました: %v", err)
+		return nil, toConnectError(err, "動画の検索に失敗しました")
 	}
 
-	pbVideos := s.presenter.Videos(ctx, videos)
+	pbVideos := s.presenter.Videos(ctx, videos, req.Msg.SkipDirectUrl)
 	pbMetadata := s.presenter.Metadata(metadata)
 	logger.Debug("GetVideosByID completed", "count", len(pbVideos))
 	return connect.NewResponse(&pb.GetVideosByIDResponse{Videos: pbVideos, Metadata: pbMetadata}), nil
@@ -190,27 +217,106 @@ func (s *VideoServiceServer) GetVideosByKeyword(ctx context.Context, req *connec
 	offset := clampOffset(req.Msg.Offset)
 
 	videos, metadata, err := s.videoUsecase.GetVideosByKeyword(ctx,
+		floorSelector(req.Msg),
 		req.Msg.Keyword,
 		hits,
 		offset,
 		req.Msg.Sort,
 		req.Msg.GteDate,
 		req.Msg.LteDate,
-		req.Msg.Site,
-		req.Msg.Service,
-		req.Msg.Floor,
+		pageSaleOptions(req.Msg),
 	)
 	if err != nil {
 		logger.Error("動画の検索に失敗", "keyword", req.Msg.Keyword, "error", err)
-		return nil, status.Errorf(codes.Internal, "動画の検索に失敗しました: %v", err)
+		return nil, toConnectError(err, "動画の検索に失敗しました")
 	}
 
-	pbVideos := s.presenter.Videos(ctx, videos)
+	pbVideos := s.presenter.Videos(ctx, videos, req.Msg.SkipDirectUrl)
 	pbMetadata := s.presenter.Metadata(metadata)
 	logger.Debug("GetVideosByKeyword completed", "count", len(pbVideos))
 	return connect.NewResponse(&pb.GetVideosByKeywordResponse{Videos: pbVideos, Metadata: pbMetadata}), nil
 }
 
+// ResolvePlaybackURLs は、動画IDごとに直接再生URLを解決するエンドポイント。
+// 一覧取得時に skip_direct_url を指定したクライアントが、再生する動画の URL だけを後から取得するために使う。
+func (s *VideoServiceServer) ResolvePlaybackURLs(ctx context.Context, req *connect.Request[pb.ResolvePlaybackURLsRequest]) (*connect.Response[pb.ResolvePlaybackURLsResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: ResolvePlaybackURLs", "count", len(req.Msg.DmmIds))
+
+	if len(req.Msg.DmmIds) > maxPlaybackURLs {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dmm_ids は最大 %d 件まで指定できます", maxPlaybackURLs))
+	}
+	if err := validateDmmIDs(req.Msg.DmmIds); err != nil {
+		return nil, err
+	}
+
+	urls := s.presenter.PlaybackURLs(ctx, req.Msg.DmmIds)
+	logger.Debug("ResolvePlaybackURLs completed", "count", len(urls))
+	return connect.NewResponse(&pb.ResolvePlaybackURLsResponse{Urls: urls}), nil
+}
+
+// GetVideosByDmmIds は、複数の DMM ID の動画を一括で取得するエンドポイント。
+// 見つからない・取得に失敗した ID があっても全体は失敗させず、ID ごとの状態で返す。
+func (s *VideoServiceServer) GetVideosByDmmIds(ctx context.Context, req *connect.Request[pb.GetVideosByDmmIdsRequest]) (*connect.Response[pb.GetVideosByDmmIdsResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: GetVideosByDmmIds", "count", len(req.Msg.DmmIds))
+
+	if len(req.Msg.DmmIds) > maxBatchVideoIDs {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dmm_ids は最大 %d 件まで指定できます", maxBatchVideoIDs))
+	}
+	if err := validateDmmIDs(req.Msg.DmmIds); err != nil {
+		return nil, err
+	}
+
+	lookups, err := s.videoUsecase.GetVideosByDmmIds(ctx, floorSelector(req.Msg), req.Msg.DmmIds)
+	if err != nil {
+		logger.Error("動画の一括取得に失敗", "count", len(req.Msg.DmmIds), "error", err)
+		return nil, toConnectError(err, "動画の取得に失敗しました")
+	}
+
+	results := s.presenter.VideoLookups(ctx, lookups, req.Msg.SkipDirectUrl)
+	logger.Debug("GetVideosByDmmIds completed", "count", len(results))
+	return connect.NewResponse(&pb.GetVideosByDmmIdsResponse{Results: results}), nil
+}
+
+// ListFloors は、検索対象に指定できるフロアの一覧を返すエンドポイント。
+func (s *VideoServiceServer) ListFloors(ctx context.Context, req *connect.Request[pb.ListFloorsRequest]) (*connect.Response[pb.ListFloorsResponse], error) {
+	logger := s.loggerWithCtx(ctx)
+	logger.Debug("API: ListFloors")
+
+	floors, err := s.videoUsecase.ListFloors(ctx)
+	if err != nil {
+		logger.Error("フロア一覧の取得に失敗", "error", err)
+		return nil, toConnectError(err, "フロア一覧の取得に失敗しました")
+	}
+
+	logger.Debug("ListFloors completed", "count", len(floors))
+	return connect.NewResponse(&pb.ListFloorsResponse{Floors: s.presenter.Floors(floors)}), nil
+}
+
+// floorRequest はフロアを指定できるリクエストメッセージ。
+type floorRequest interface {
+	GetSite() string
+	GetService() string
+	GetFloor() string
+}
+
+// floorSelector はリクエストのサイト・サービス・フロアを FloorSelector にする。既定値の補完と検証はユースケースで行う。
+func floorSelector(req floorRequest) model.FloorSelector {
+	return model.FloorSelector{Site: req.GetSite(), Service: req.GetService(), Floor: req.GetFloor()}
+}
+
+// pageSaleRequest は取得したページ内に適用するセール条件を持つリクエスト。
+type pageSaleRequest interface {
+	GetFilterPageOnSale() bool
+	GetSortPageByDiscount() bool
+}
+
+// pageSaleOptions はリクエストのセール条件を PageSaleOptions にする。
+func pageSaleOptions(req pageSaleRequest) model.PageSaleOptions {
+	return model.PageSaleOptions{FilterOnSale: req.GetFilterPageOnSale(), SortByDiscount: req.GetSortPageByDiscount()}
+}
+
 func parseDate(date string) (time.Time, error) {
 	if date == "" {
 		return time.Now(), nil
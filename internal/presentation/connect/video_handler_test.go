@@ -9,16 +9,22 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	pb "github.com/tikfack/server/gen/video"
 	"github.com/tikfack/server/internal/application/model"
+	"github.com/tikfack/server/internal/application/port"
 	mockvideo "github.com/tikfack/server/internal/application/usecase/mock"
+	"github.com/tikfack/server/internal/infrastructure/util"
 	"github.com/tikfack/server/internal/middleware/ctxkeys"
 )
 
 // ===== 共通テストデータ =====
+
+// unresolvedURLs は DirectURL を解決できないリゾルバ。テストから DMM へ HEAD リクエストを送らないために使う。
+var unresolvedURLs = videoURLResolverFunc(func(_ context.Context, _ string) (string, error) {
+	return "", util.ErrDirectURLNotFound
+})
+
 var (
 	testTime  = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
 	testVideo = model.Video{
@@ -35,7 +41,24 @@ var (
 		Makers:       []model.Maker{{ID: "m1", Name: "メーカーA"}},
 		Series:       []model.Series{{ID: "s1", Name: "シリーズA"}},
 		Directors:    []model.Director{{ID: "d1", Name: "監督A"}},
+		Labels:       []model.Label{{ID: "l1", Name: "レーベルA"}},
 		Review:       model.Review{Count: 100, Average: 4.5},
+
+		ProductID:      "test123",
+		MakerProduct:   "TEST-123",
+		RuntimeMinutes: 120,
+		ListPrice:      1480,
+		Pricing: model.Pricing{
+			Currency:   model.CurrencyJPY,
+			Deliveries: []model.DeliveryPrice{model.NewDeliveryPrice(model.DeliveryStream, 1000, 1480)},
+		},
+		SampleImages: []model.SampleImage{{Small: "https://example.com/s1.jpg", Large: "https://example.com/l1.jpg"}},
+		SampleMovies: model.SampleMovies{Size720x480: "https://example.com/sample"},
+		Campaigns: []model.Campaign{{
+			Title:   "キャンペーンA",
+			BeginAt: testTime,
+			EndAt:   testTime.Add(7 * 24 * time.Hour),
+		}},
 	}
 	testMetadata = &model.SearchMetadata{
 		ResultCount:   10,
@@ -56,6 +79,11 @@ func checkVideoFields(t *testing.T, got *pb.Video, want model.Video) {
 	require.Equal(t, int32(want.LikesCount), got.LikesCount)
 	require.Equal(t, int32(want.Review.Count), got.Review.Count)
 	require.Equal(t, want.Review.Average, got.Review.Average)
+	require.Equal(t, want.ProductID, got.ProductId)
+	require.Equal(t, want.MakerProduct, got.MakerProduct)
+	require.Equal(t, int32(want.RuntimeMinutes), got.RuntimeMinutes)
+	require.Equal(t, int32(want.ListPrice), got.ListPrice)
+	require.Equal(t, want.SampleMovies.Size720x480, got.SampleMovies.XlargeUrl)
 
 	require.Len(t, got.Actresses, len(want.Actresses))
 	for i, a := range want.Actresses {
@@ -86,6 +114,36 @@ func checkVideoFields(t *testing.T, got *pb.Video, want model.Video) {
 		require.Equal(t, d.ID, got.Directors[i].Id)
 		require.Equal(t, d.Name, got.Directors[i].Name)
 	}
+
+	require.Len(t, got.Labels, len(want.Labels))
+	for i, l := range want.Labels {
+		require.Equal(t, l.ID, got.Labels[i].Id)
+		require.Equal(t, l.Name, got.Labels[i].Name)
+	}
+
+	require.Equal(t, want.Pricing.Currency, got.Pricing.Currency)
+	require.Equal(t, want.Pricing.OnSale(), got.Pricing.OnSale)
+	require.Equal(t, int32(want.Pricing.MaxDiscountPercent()), got.Pricing.MaxDiscountPercent)
+	require.Len(t, got.Pricing.Deliveries, len(want.Pricing.Deliveries))
+	for i, d := range want.Pricing.Deliveries {
+		require.Equal(t, string(d.Type), got.Pricing.Deliveries[i].Type)
+		require.Equal(t, int32(d.Price), got.Pricing.Deliveries[i].Price)
+		require.Equal(t, int32(d.ListPrice), got.Pricing.Deliveries[i].ListPrice)
+		require.Equal(t, int32(d.DiscountPercent), got.Pricing.Deliveries[i].DiscountPercent)
+	}
+
+	require.Len(t, got.SampleImages, len(want.SampleImages))
+	for i, img := range want.SampleImages {
+		require.Equal(t, img.Small, got.SampleImages[i].SmallUrl)
+		require.Equal(t, img.Large, got.SampleImages[i].LargeUrl)
+	}
+
+	require.Len(t, got.Campaigns, len(want.Campaigns))
+	for i, c := range want.Campaigns {
+		require.Equal(t, c.Title, got.Campaigns[i].Title)
+		require.Equal(t, c.BeginAt.Format(time.RFC3339), got.Campaigns[i].BeginAt)
+		require.Equal(t, c.EndAt.Format(time.RFC3339), got.Campaigns[i].EndAt)
+	}
 }
 
 func checkMetadata(t *testing.T, got *pb.SearchMetadata, want *model.SearchMetadata) {
@@ -118,7 +176,25 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(20), int32(0)).
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(20), int32(0), model.PageSaleOptions{}).
+					Return([]model.Video{testVideo}, testMetadata, nil)
+			},
+			expectedVideos: []model.Video{testVideo},
+			expectedMD:     testMetadata,
+			expectedError:  nil,
+		},
+		{
+			name: "正常系：ページ内のセール条件を渡す",
+			req: &pb.GetVideosByDateRequest{
+				Date:               "2024-01-01",
+				Hits:               20,
+				FilterPageOnSale:   true,
+				SortPageByDiscount: true,
+			},
+			mockSetup: func(m *mockvideo.MockVideoUsecase) {
+				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
+				m.EXPECT().
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(20), int32(0), model.PageSaleOptions{FilterOnSale: true, SortByDiscount: true}).
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expectedVideos: []model.Video{testVideo},
@@ -133,8 +209,8 @@ func TestGetVideosByDate(t *testing.T) {
 			},
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), gomock.Any(), int32(20), int32(0)).
-					DoAndReturn(func(_ context.Context, date time.Time, hits, offset int32) ([]model.Video, *model.SearchMetadata, error) {
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, gomock.Any(), int32(20), int32(0), model.PageSaleOptions{}).
+					DoAndReturn(func(_ context.Context, _ model.FloorSelector, date time.Time, hits, offset int32, _ model.PageSaleOptions) ([]model.Video, *model.SearchMetadata, error) {
 						// 現在時刻との差分が1秒以内であることを確認
 						if time.Since(date) > time.Second {
 							t.Error("期待される日時との差分が大きすぎます")
@@ -156,7 +232,7 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(10), int32(20)).
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(10), int32(20), model.PageSaleOptions{}).
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expectedVideos: []model.Video{testVideo},
@@ -173,7 +249,7 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(100), int32(0)). // 100に制限される
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(100), int32(0), model.PageSaleOptions{}). // 100に制限される
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expectedVideos: []model.Video{testVideo},
@@ -190,7 +266,7 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(20), int32(50000)). // 50000に制限される
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(20), int32(50000), model.PageSaleOptions{}). // 50000に制限される
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expectedVideos: []model.Video{testVideo},
@@ -207,7 +283,7 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(20), int32(0)). // 0に制限される
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(20), int32(0), model.PageSaleOptions{}). // 0に制限される
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expectedVideos: []model.Video{testVideo},
@@ -224,7 +300,7 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup:      func(m *mockvideo.MockVideoUsecase) {},
 			expectedVideos: nil,
 			expectedMD:     nil,
-			expectedError:  status.Error(codes.InvalidArgument, "不正な日付形式です"),
+			expectedError:  connect.NewError(connect.CodeInvalidArgument, errors.New("不正な日付形式です")),
 		},
 		{
 			name: "エラー系：ユースケースでエラー",
@@ -236,12 +312,12 @@ func TestGetVideosByDate(t *testing.T) {
 			mockSetup: func(m *mockvideo.MockVideoUsecase) {
 				targetDate, _ := time.Parse("2006-01-02", "2024-01-01")
 				m.EXPECT().
-					GetVideosByDate(gomock.Any(), targetDate, int32(20), int32(0)).
+					GetVideosByDate(gomock.Any(), model.FloorSelector{}, targetDate, int32(20), int32(0), model.PageSaleOptions{}).
 					Return(nil, nil, errors.New("database error"))
 			},
 			expectedVideos: nil,
 			expectedMD:     nil,
-			expectedError:  status.Error(codes.Internal, "動画の取得に失敗しました: database error"),
+			expectedError:  connect.NewError(connect.CodeInternal, errors.New("動画の取得に失敗しました: database error")),
 		},
 	}
 
@@ -251,7 +327,7 @@ func TestGetVideosByDate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
-			handler := NewVideoServiceHandler(mockUsecase)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
 			tt.mockSetup(mockUsecase)
 
 			req := connect.NewRequest(tt.req)
@@ -289,7 +365,7 @@ func TestSearchVideos(t *testing.T) {
 		expected    []model.Video
 		expectedMD  *model.SearchMetadata
 		expectError bool
-		errorCode   codes.Code
+		errorCode   connect.Code
 	}{
 		{
 			name: "正常系",
@@ -303,7 +379,19 @@ func TestSearchVideos(t *testing.T) {
 			},
 			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
 				mockUsecase.EXPECT().
-					SearchVideos(gomock.Any(), "keyword", "1", "2", "3", "4", "5").
+					SearchVideos(gomock.Any(), model.FloorSelector{}, "keyword", "1", "2", "3", "4", "5", model.PageSaleOptions{}).
+					Return([]model.Video{testVideo}, testMetadata, nil)
+			},
+			expected:    []model.Video{testVideo},
+			expectedMD:  testMetadata,
+			expectError: false,
+		},
+		{
+			name:    "正常系 - ページ内のセール中のみ",
+			request: &pb.SearchVideosRequest{Keyword: "keyword", FilterPageOnSale: true},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					SearchVideos(gomock.Any(), model.FloorSelector{}, "keyword", "", "", "", "", "", model.PageSaleOptions{FilterOnSale: true}).
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
 			expected:    []model.Video{testVideo},
@@ -315,13 +403,13 @@ func TestSearchVideos(t *testing.T) {
 			request: &pb.SearchVideosRequest{},
 			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
 				mockUsecase.EXPECT().
-					SearchVideos(gomock.Any(), "", "", "", "", "", "").
+					SearchVideos(gomock.Any(), model.FloorSelector{}, "", "", "", "", "", "", model.PageSaleOptions{}).
 					Return(nil, nil, errors.New("search error"))
 			},
 			expected:    nil,
 			expectedMD:  nil,
 			expectError: true,
-			errorCode:   codes.Internal,
+			errorCode:   connect.CodeInternal,
 		},
 	}
 
@@ -331,7 +419,7 @@ func TestSearchVideos(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
-			handler := NewVideoServiceHandler(mockUsecase)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
 			tt.setupMock(mockUsecase)
 
 			req := connect.NewRequest(tt.request)
@@ -340,9 +428,7 @@ func TestSearchVideos(t *testing.T) {
 			if tt.expectError {
 				require.Error(t, err)
 				if tt.errorCode != 0 {
-					s, ok := status.FromError(err)
-					require.True(t, ok)
-					require.Equal(t, tt.errorCode, s.Code())
+					require.Equal(t, tt.errorCode, connect.CodeOf(err))
 				}
 				return
 			}
@@ -373,7 +459,7 @@ func TestGetVideosByID(t *testing.T) {
 		expected    []model.Video
 		expectedMD  *model.SearchMetadata
 		expectError bool
-		errorCode   codes.Code
+		errorCode   connect.Code
 	}{
 		{
 			name: "正常系",
@@ -396,10 +482,11 @@ func TestGetVideosByID(t *testing.T) {
 				mockUsecase.EXPECT().
 					GetVideosByID(
 						gomock.Any(),
+						model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoa"},
 						[]string{"1"}, []string{"2"}, []string{"3"}, []string{"4"}, []string{"5"},
 						int32(10), int32(0), "rank",
 						"2023-01-01", "2023-12-31",
-						"FANZA", "digital", "videoa",
+						model.PageSaleOptions{},
 					).
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
@@ -414,17 +501,18 @@ func TestGetVideosByID(t *testing.T) {
 				mockUsecase.EXPECT().
 					GetVideosByID(
 						gomock.Any(),
+						model.FloorSelector{},
 						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 						int32(0), int32(0), "",
 						"", "",
-						"", "", "",
+						model.PageSaleOptions{},
 					).
 					Return(nil, nil, errors.New("search error"))
 			},
 			expected:    nil,
 			expectedMD:  nil,
 			expectError: true,
-			errorCode:   codes.Internal,
+			errorCode:   connect.CodeInternal,
 		},
 	}
 
@@ -434,7 +522,7 @@ func TestGetVideosByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
-			handler := NewVideoServiceHandler(mockUsecase)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
 			tt.setupMock(mockUsecase)
 
 			req := connect.NewRequest(tt.request)
@@ -443,9 +531,7 @@ func TestGetVideosByID(t *testing.T) {
 			if tt.expectError {
 				require.Error(t, err)
 				if tt.errorCode != 0 {
-					s, ok := status.FromError(err)
-					require.True(t, ok)
-					require.Equal(t, tt.errorCode, s.Code())
+					require.Equal(t, tt.errorCode, connect.CodeOf(err))
 				}
 				return
 			}
@@ -476,7 +562,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 		expected    []model.Video
 		expectedMD  *model.SearchMetadata
 		expectError bool
-		errorCode   codes.Code
+		errorCode   connect.Code
 	}{
 		{
 			name: "正常系",
@@ -495,11 +581,37 @@ func TestGetVideosByKeyword(t *testing.T) {
 				mockUsecase.EXPECT().
 					GetVideosByKeyword(
 						gomock.Any(),
+						model.FloorSelector{Site: "FANZA", Service: "digital", Floor: "videoa"},
 						"test",
 						int32(10), int32(0),
 						"rank",
 						"2023-01-01", "2023-12-31",
-						"FANZA", "digital", "videoa",
+						model.PageSaleOptions{},
+					).
+					Return([]model.Video{testVideo}, testMetadata, nil)
+			},
+			expected:    []model.Video{testVideo},
+			expectedMD:  testMetadata,
+			expectError: false,
+		},
+		{
+			name: "正常系 - セール中のみを割引率順",
+			request: &pb.GetVideosByKeywordRequest{
+				Keyword:            "test",
+				Sort:               "date",
+				FilterPageOnSale:   true,
+				SortPageByDiscount: true,
+			},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					GetVideosByKeyword(
+						gomock.Any(),
+						model.FloorSelector{},
+						"test",
+						int32(0), int32(0),
+						"date",
+						"", "",
+						model.PageSaleOptions{FilterOnSale: true, SortByDiscount: true},
 					).
 					Return([]model.Video{testVideo}, testMetadata, nil)
 			},
@@ -514,18 +626,19 @@ func TestGetVideosByKeyword(t *testing.T) {
 				mockUsecase.EXPECT().
 					GetVideosByKeyword(
 						gomock.Any(),
+						model.FloorSelector{},
 						"",
 						int32(0), int32(0),
 						"",
 						"", "",
-						"", "", "",
+						model.PageSaleOptions{},
 					).
 					Return(nil, nil, errors.New("search error"))
 			},
 			expected:    nil,
 			expectedMD:  nil,
 			expectError: true,
-			errorCode:   codes.Internal,
+			errorCode:   connect.CodeInternal,
 		},
 	}
 
@@ -535,7 +648,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
-			handler := NewVideoServiceHandler(mockUsecase)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
 			tt.setupMock(mockUsecase)
 
 			req := connect.NewRequest(tt.request)
@@ -544,9 +657,7 @@ func TestGetVideosByKeyword(t *testing.T) {
 			if tt.expectError {
 				require.Error(t, err)
 				if tt.errorCode != 0 {
-					s, ok := status.FromError(err)
-					require.True(t, ok)
-					require.Equal(t, tt.errorCode, s.Code())
+					require.Equal(t, tt.errorCode, connect.CodeOf(err))
 				}
 				return
 			}
@@ -576,42 +687,65 @@ func TestGetVideoById(t *testing.T) {
 		setupMock   func(mockUsecase *mockvideo.MockVideoUsecase)
 		expected    *model.Video
 		expectError bool
-		errorCode   codes.Code
+		errorCode   connect.Code
 	}{
 		{
 			name:    "正常系",
 			request: &pb.GetVideoByIdRequest{DmmId: "test123"},
 			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
 				mockUsecase.EXPECT().
-					GetVideoById(gomock.Any(), "test123").
+					GetVideoById(gomock.Any(), model.FloorSelector{}, "test123").
+					Return(&testVideo, nil)
+			},
+			expected:    &testVideo,
+			expectError: false,
+		},
+		{
+			name:    "正常系 - フロア指定",
+			request: &pb.GetVideoByIdRequest{DmmId: "test123", Service: "digital", Floor: "videoc"},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					GetVideoById(gomock.Any(), model.FloorSelector{Service: "digital", Floor: "videoc"}, "test123").
 					Return(&testVideo, nil)
 			},
 			expected:    &testVideo,
 			expectError: false,
 		},
+		{
+			name:    "異常系 - 存在しないフロア",
+			request: &pb.GetVideoByIdRequest{DmmId: "test123", Floor: "unknown"},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					GetVideoById(gomock.Any(), model.FloorSelector{Floor: "unknown"}, "test123").
+					Return(nil, &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Err: errors.New("unknown floor")})
+			},
+			expected:    nil,
+			expectError: true,
+			errorCode:   connect.CodeInvalidArgument,
+		},
 		{
 			name:    "異常系 - 動画が見つからない",
 			request: &pb.GetVideoByIdRequest{DmmId: "notfound"},
 			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
 				mockUsecase.EXPECT().
-					GetVideoById(gomock.Any(), "notfound").
+					GetVideoById(gomock.Any(), model.FloorSelector{}, "notfound").
 					Return(nil, nil)
 			},
 			expected:    nil,
 			expectError: true,
-			errorCode:   codes.NotFound,
+			errorCode:   connect.CodeNotFound,
 		},
 		{
 			name:    "異常系 - ユースケースエラー",
 			request: &pb.GetVideoByIdRequest{DmmId: "test123"},
 			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
 				mockUsecase.EXPECT().
-					GetVideoById(gomock.Any(), "test123").
+					GetVideoById(gomock.Any(), model.FloorSelector{}, "test123").
 					Return(nil, errors.New("usecase error"))
 			},
 			expected:    nil,
 			expectError: true,
-			errorCode:   codes.Internal,
+			errorCode:   connect.CodeInternal,
 		},
 	}
 
@@ -621,7 +755,7 @@ func TestGetVideoById(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
-			handler := NewVideoServiceHandler(mockUsecase)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
 			tt.setupMock(mockUsecase)
 
 			req := connect.NewRequest(tt.request)
@@ -630,9 +764,7 @@ func TestGetVideoById(t *testing.T) {
 			if tt.expectError {
 				require.Error(t, err)
 				if tt.errorCode != 0 {
-					s, ok := status.FromError(err)
-					require.True(t, ok)
-					require.Equal(t, tt.errorCode, s.Code())
+					require.Equal(t, tt.errorCode, connect.CodeOf(err))
 				}
 				return
 			}
@@ -646,3 +778,253 @@ func TestGetVideoById(t *testing.T) {
 		})
 	}
 }
+
+func TestListFloors(t *testing.T) {
+	ctx := context.Background()
+	floors := []model.Floor{
+		{SiteName: "FANZA", SiteCode: "FANZA", ServiceName: "動画", ServiceCode: "digital", FloorID: "43", FloorName: "ビデオ", FloorCode: "videoa"},
+		{SiteName: "FANZA", SiteCode: "FANZA", ServiceName: "動画", ServiceCode: "digital", FloorID: "44", FloorName: "素人", FloorCode: "videoc"},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockUsecase *mockvideo.MockVideoUsecase)
+		errorCode connect.Code
+	}{
+		{
+			name: "正常系",
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().ListFloors(gomock.Any()).Return(floors, nil)
+			},
+		},
+		{
+			name: "異常系 - ユースケースエラー",
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().ListFloors(gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			errorCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(unresolvedURLs))
+			tt.setupMock(mockUsecase)
+
+			resp, err := handler.ListFloors(ctx, connect.NewRequest(&pb.ListFloorsRequest{}))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Floors, len(floors))
+			for i, f := range floors {
+				got := resp.Msg.Floors[i]
+				require.Equal(t, f.SiteCode, got.SiteCode)
+				require.Equal(t, f.ServiceCode, got.ServiceCode)
+				require.Equal(t, f.FloorID, got.FloorId)
+				require.Equal(t, f.FloorName, got.FloorName)
+				require.Equal(t, f.FloorCode, got.FloorCode)
+			}
+		})
+	}
+}
+
+func TestResolvePlaybackURLs(t *testing.T) {
+	ctx := context.Background()
+	resolver := videoURLResolverFunc(func(_ context.Context, dmmID string) (string, error) {
+		switch dmmID {
+		case "ok":
+			return "https://example.com/ok.mp4", nil
+		case "missing":
+			return "", util.ErrDirectURLNotFound
+		default:
+			return "", errors.New("connection reset")
+		}
+	})
+
+	tests := []struct {
+		name      string
+		dmmIDs    []string
+		expected  []*pb.PlaybackURL
+		errorCode connect.Code
+	}{
+		{
+			name:   "正常系 - ID ごとに解決状態を返す",
+			dmmIDs: []string{"ok", "missing", "flaky"},
+			expected: []*pb.PlaybackURL{
+				{DmmId: "ok", DirectUrl: "https://example.com/ok.mp4", Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_RESOLVED},
+				{DmmId: "missing", Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_NOT_FOUND},
+				{DmmId: "flaky", Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_FAILED},
+			},
+		},
+		{
+			name:     "正常系 - 空のリスト",
+			dmmIDs:   nil,
+			expected: []*pb.PlaybackURL{},
+		},
+		{
+			name:      "異常系 - 空の ID",
+			dmmIDs:    []string{"ok", " "},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "異常系 - パス区切りを含む ID",
+			dmmIDs:    []string{"ok", "../x"},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "異常系 - クエリ文字列を含む ID",
+			dmmIDs:    []string{"abc?x=1"},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "異常系 - 上限超過",
+			dmmIDs:    make([]string, maxPlaybackURLs+1),
+			errorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler := newVideoServiceServer(mockvideo.NewMockVideoUsecase(ctrl), newVideoPresenter(resolver))
+			resp, err := handler.ResolvePlaybackURLs(ctx, connect.NewRequest(&pb.ResolvePlaybackURLsRequest{DmmIds: tt.dmmIDs}))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Urls, len(tt.expected))
+			for i, want := range tt.expected {
+				require.Equal(t, want.DmmId, resp.Msg.Urls[i].DmmId)
+				require.Equal(t, want.DirectUrl, resp.Msg.Urls[i].DirectUrl)
+				require.Equal(t, want.Status, resp.Msg.Urls[i].Status)
+			}
+		})
+	}
+}
+
+func TestGetVideosByDmmIds(t *testing.T) {
+	ctx := context.Background()
+	resolver := videoURLResolverFunc(func(_ context.Context, dmmID string) (string, error) {
+		return "https://example.com/" + dmmID + ".mp4", nil
+	})
+	other := testVideo
+	other.DmmID = "other"
+
+	tests := []struct {
+		name      string
+		request   *pb.GetVideosByDmmIdsRequest
+		setupMock func(mockUsecase *mockvideo.MockVideoUsecase)
+		expected  []*pb.VideoLookup
+		errorCode connect.Code
+	}{
+		{
+			name:    "正常系 - 見つからない ID を含む",
+			request: &pb.GetVideosByDmmIdsRequest{DmmIds: []string{"test123", "gone", "other", "flaky"}, Floor: "videoa"},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					GetVideosByDmmIds(gomock.Any(), model.FloorSelector{Floor: "videoa"}, []string{"test123", "gone", "other", "flaky"}).
+					Return([]model.VideoLookup{
+						{DmmID: "test123", Status: model.LookupFound, Video: &testVideo},
+						{DmmID: "gone", Status: model.LookupNotFound},
+						{DmmID: "other", Status: model.LookupFound, Video: &other},
+						{DmmID: "flaky", Status: model.LookupFailed, Err: errors.New("timeout")},
+					}, nil)
+			},
+			expected: []*pb.VideoLookup{
+				{DmmId: "test123", Status: pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FOUND},
+				{DmmId: "gone", Status: pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_NOT_FOUND},
+				{DmmId: "other", Status: pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FOUND},
+				{DmmId: "flaky", Status: pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FAILED},
+			},
+		},
+		{
+			name:      "異常系 - 空の ID",
+			request:   &pb.GetVideosByDmmIdsRequest{DmmIds: []string{"test123", " "}},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "異常系 - content_id の文字種以外を含む ID",
+			request:   &pb.GetVideosByDmmIdsRequest{DmmIds: []string{"test123", "a/b"}},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "異常系 - 上限超過",
+			request:   &pb.GetVideosByDmmIdsRequest{DmmIds: make([]string, maxBatchVideoIDs+1)},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {},
+			errorCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:    "異常系 - 存在しないフロア",
+			request: &pb.GetVideosByDmmIdsRequest{DmmIds: []string{"test123"}, Floor: "unknown"},
+			setupMock: func(mockUsecase *mockvideo.MockVideoUsecase) {
+				mockUsecase.EXPECT().
+					GetVideosByDmmIds(gomock.Any(), model.FloorSelector{Floor: "unknown"}, []string{"test123"}).
+					Return(nil, &port.CatalogError{Kind: port.ErrCatalogInvalidParameter, Err: errors.New("unknown floor")})
+			},
+			errorCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
+			handler := newVideoServiceServer(mockUsecase, newVideoPresenter(resolver))
+			tt.setupMock(mockUsecase)
+
+			resp, err := handler.GetVideosByDmmIds(ctx, connect.NewRequest(tt.request))
+			if tt.errorCode != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.errorCode, connect.CodeOf(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Results, len(tt.expected))
+			for i, want := range tt.expected {
+				got := resp.Msg.Results[i]
+				require.Equal(t, want.DmmId, got.DmmId)
+				require.Equal(t, want.Status, got.Status)
+				if want.Status != pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FOUND {
+					require.Nil(t, got.Video)
+					continue
+				}
+				require.Equal(t, want.DmmId, got.Video.DmmId)
+				require.Equal(t, "https://example.com/"+want.DmmId+".mp4", got.Video.DirectUrl)
+			}
+		})
+	}
+}
+
+func TestGetVideosByDate_SkipDirectURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resolver := videoURLResolverFunc(func(_ context.Context, dmmID string) (string, error) {
+		t.Fatalf("skip_direct_url 指定時に解決してはいけない: %s", dmmID)
+		return "", nil
+	})
+	mockUsecase := mockvideo.NewMockVideoUsecase(ctrl)
+	mockUsecase.EXPECT().
+		GetVideosByDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.Video{testVideo}, &model.SearchMetadata{ResultCount: 1}, nil)
+	handler := newVideoServiceServer(mockUsecase, newVideoPresenter(resolver))
+
+	resp, err := handler.GetVideosByDate(context.Background(), connect.NewRequest(&pb.GetVideosByDateRequest{SkipDirectUrl: true}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Videos, 1)
+	require.Empty(t, resp.Msg.Videos[0].DirectUrl)
+}
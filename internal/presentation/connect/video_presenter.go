@@ -2,6 +2,8 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/tikfack/server/internal/application/model"
@@ -13,8 +15,11 @@ import (
 // videoPresenter はドメインモデルをtransport層のpbメッセージへ変換する責務を担う。
 type videoPresenter interface {
 	Video(ctx context.Context, video *model.Video) *pb.Video
-	Videos(ctx context.Context, videos []model.Video) []*pb.Video
+	Videos(ctx context.Context, videos []model.Video, skipDirectURL bool) []*pb.Video
+	VideoLookups(ctx context.Context, lookups []model.VideoLookup, skipDirectURL bool) []*pb.VideoLookup
+	PlaybackURLs(ctx context.Context, dmmIDs []string) []*pb.PlaybackURL
 	Metadata(md *model.SearchMetadata) *pb.SearchMetadata
+	Floors(floors []model.Floor) []*pb.Floor
 }
 
 // videoURLResolver は動画のDirectURLを検証・取得する動作を抽象化する。
@@ -29,16 +34,17 @@ func (f videoURLResolverFunc) Resolve(ctx context.Context, dmmID string) (string
 }
 
 // pbVideoPresenter は videoPresenter のデフォルト実装。
+// DirectURL の解決はこのプレゼンターだけが行う。
 type pbVideoPresenter struct {
 	urlResolver videoURLResolver
 }
 
-func newVideoPresenter() videoPresenter {
-	return &pbVideoPresenter{
-		urlResolver: videoURLResolverFunc(func(_ context.Context, dmmID string) (string, error) {
-			return util.GetValidVideoUrl(dmmID)
-		}),
+// newVideoPresenter は resolver で DirectURL を解決するプレゼンターを返す。
+func newVideoPresenter(resolver videoURLResolver) videoPresenter {
+	if resolver == nil {
+		panic("video url resolver must be provided")
 	}
+	return &pbVideoPresenter{urlResolver: resolver}
 }
 
 func (p *pbVideoPresenter) Video(ctx context.Context, video *model.Video) *pb.Video {
@@ -46,6 +52,7 @@ func (p *pbVideoPresenter) Video(ctx context.Context, video *model.Video) *pb.Vi
 		return nil
 	}
 	// コピーを作ってDirectURLの補完による副作用を避ける。
+	// 解決できない場合は DirectURL を空のまま返し、再生できるかどうかをクライアントが判断できるようにする。
 	copyVideo := *video
 	if copyVideo.DirectURL == "" {
 		if directURL, err := p.urlResolver.Resolve(ctx, copyVideo.DmmID); err == nil {
@@ -55,17 +62,84 @@ func (p *pbVideoPresenter) Video(ctx context.Context, video *model.Video) *pb.Vi
 	return convertToPbVideo(copyVideo)
 }
 
-func (p *pbVideoPresenter) Videos(ctx context.Context, videos []model.Video) []*pb.Video {
+// Videos は DirectURL の解決を並行して行う。同時実行数はリゾルバ側で制限する。
+// skipDirectURL が true の場合は解決せず、DirectURL は空のまま返す（クライアントが PlaybackURLs で後から取得する）。
+func (p *pbVideoPresenter) Videos(ctx context.Context, videos []model.Video, skipDirectURL bool) []*pb.Video {
 	if len(videos) == 0 {
 		return nil
 	}
-	converted := make([]*pb.Video, 0, len(videos))
+	converted := make([]*pb.Video, len(videos))
+	var wg sync.WaitGroup
 	for i := range videos {
-		converted = append(converted, p.Video(ctx, &videos[i]))
+		if skipDirectURL || videos[i].DirectURL != "" {
+			converted[i] = convertToPbVideo(videos[i])
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			converted[i] = p.Video(ctx, &videos[i])
+		}(i)
 	}
+	wg.Wait()
 	return converted
 }
 
+// VideoLookups は ID ごとの取得結果を同じ順序で変換する。取得できた動画の DirectURL は Videos と同様に並行して解決する。
+func (p *pbVideoPresenter) VideoLookups(ctx context.Context, lookups []model.VideoLookup, skipDirectURL bool) []*pb.VideoLookup {
+	found := make([]model.Video, 0, len(lookups))
+	for _, l := range lookups {
+		if l.Status == model.LookupFound && l.Video != nil {
+			found = append(found, *l.Video)
+		}
+	}
+	videos := p.Videos(ctx, found, skipDirectURL)
+
+	results := make([]*pb.VideoLookup, len(lookups))
+	for i, l := range lookups {
+		result := &pb.VideoLookup{DmmId: l.DmmID}
+		switch {
+		case l.Status == model.LookupFound && l.Video != nil:
+			result.Status = pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FOUND
+			result.Video, videos = videos[0], videos[1:]
+		case l.Status == model.LookupNotFound:
+			result.Status = pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_NOT_FOUND
+		default:
+			result.Status = pb.VideoLookupStatus_VIDEO_LOOKUP_STATUS_FAILED
+		}
+		results[i] = result
+	}
+	return results
+}
+
+// PlaybackURLs は dmmIDs の DirectURL を並行して解決し、ID ごとの結果を同じ順序で返す。
+// 解決できなかった理由を状態で区別する。
+func (p *pbVideoPresenter) PlaybackURLs(ctx context.Context, dmmIDs []string) []*pb.PlaybackURL {
+	urls := make([]*pb.PlaybackURL, len(dmmIDs))
+	var wg sync.WaitGroup
+	for i, dmmID := range dmmIDs {
+		wg.Add(1)
+		go func(i int, dmmID string) {
+			defer wg.Done()
+			urls[i] = p.playbackURL(ctx, dmmID)
+		}(i, dmmID)
+	}
+	wg.Wait()
+	return urls
+}
+
+func (p *pbVideoPresenter) playbackURL(ctx context.Context, dmmID string) *pb.PlaybackURL {
+	directURL, err := p.urlResolver.Resolve(ctx, dmmID)
+	switch {
+	case err == nil && directURL != "":
+		return &pb.PlaybackURL{DmmId: dmmID, DirectUrl: directURL, Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_RESOLVED}
+	case err == nil, errors.Is(err, util.ErrDirectURLNotFound):
+		return &pb.PlaybackURL{DmmId: dmmID, Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_NOT_FOUND}
+	default:
+		return &pb.PlaybackURL{DmmId: dmmID, Status: pb.PlaybackURLStatus_PLAYBACK_URL_STATUS_FAILED}
+	}
+}
+
 func (p *pbVideoPresenter) Metadata(md *model.SearchMetadata) *pb.SearchMetadata {
 	if md == nil {
 		return nil
@@ -77,6 +151,25 @@ func (p *pbVideoPresenter) Metadata(md *model.SearchMetadata) *pb.SearchMetadata
 	}
 }
 
+func (p *pbVideoPresenter) Floors(floors []model.Floor) []*pb.Floor {
+	if len(floors) == 0 {
+		return nil
+	}
+	out := make([]*pb.Floor, 0, len(floors))
+	for _, f := range floors {
+		out = append(out, &pb.Floor{
+			SiteName:    f.SiteName,
+			SiteCode:    f.SiteCode,
+			ServiceName: f.ServiceName,
+			ServiceCode: f.ServiceCode,
+			FloorId:     f.FloorID,
+			FloorName:   f.FloorName,
+			FloorCode:   f.FloorCode,
+		})
+	}
+	return out
+}
+
 // convertToPbVideo はモデルからpb.Videoへ変換するヘルパー。
 func convertToPbVideo(v model.Video) *pb.Video {
 	actresses := make([]*pb.Actress, 0, len(v.Actresses))
@@ -104,6 +197,25 @@ func convertToPbVideo(v model.Video) *pb.Video {
 		directors = append(directors, &pb.Director{Id: d.ID, Name: d.Name})
 	}
 
+	labels := make([]*pb.Label, 0, len(v.Labels))
+	for _, l := range v.Labels {
+		labels = append(labels, &pb.Label{Id: l.ID, Name: l.Name})
+	}
+
+	sampleImages := make([]*pb.SampleImage, 0, len(v.SampleImages))
+	for _, img := range v.SampleImages {
+		sampleImages = append(sampleImages, &pb.SampleImage{SmallUrl: img.Small, LargeUrl: img.Large})
+	}
+
+	campaigns := make([]*pb.Campaign, 0, len(v.Campaigns))
+	for _, c := range v.Campaigns {
+		campaigns = append(campaigns, &pb.Campaign{
+			Title:   c.Title,
+			BeginAt: formatTime(c.BeginAt),
+			EndAt:   formatTime(c.EndAt),
+		})
+	}
+
 	review := &pb.Review{Count: int32(v.Review.Count), Average: v.Review.Average}
 
 	return &pb.Video{
@@ -122,5 +234,47 @@ func convertToPbVideo(v model.Video) *pb.Video {
 		Series:       series,
 		Directors:    directors,
 		Review:       review,
+
+		ProductId:      v.ProductID,
+		MakerProduct:   v.MakerProduct,
+		RuntimeMinutes: int32(v.RuntimeMinutes),
+		ListPrice:      int32(v.ListPrice),
+		Pricing:        convertToPbPricing(v.Pricing),
+		SampleImages:   sampleImages,
+		SampleMovies: &pb.SampleMovies{
+			SmallUrl:  v.SampleMovies.Size476x306,
+			MediumUrl: v.SampleMovies.Size560x360,
+			LargeUrl:  v.SampleMovies.Size644x414,
+			XlargeUrl: v.SampleMovies.Size720x480,
+		},
+		Campaigns: campaigns,
+		Labels:    labels,
+	}
+}
+
+// convertToPbPricing は配信形式ごとの価格と、セール中かどうかの集計を pb.Pricing に変換する。
+func convertToPbPricing(p model.Pricing) *pb.Pricing {
+	deliveries := make([]*pb.DeliveryPrice, 0, len(p.Deliveries))
+	for _, d := range p.Deliveries {
+		deliveries = append(deliveries, &pb.DeliveryPrice{
+			Type:            string(d.Type),
+			Price:           int32(d.Price),
+			ListPrice:       int32(d.ListPrice),
+			DiscountPercent: int32(d.DiscountPercent),
+		})
+	}
+	return &pb.Pricing{
+		Currency:           p.Currency,
+		Deliveries:         deliveries,
+		OnSale:             p.OnSale(),
+		MaxDiscountPercent: int32(p.MaxDiscountPercent()),
+	}
+}
+
+// formatTime は時刻を RFC3339 で返す。ゼロ値（不明）の場合は空文字列にする。
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
@@ -0,0 +1,114 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/bufbuild/connect-go"
+	pb "github.com/tikfack/server/gen/watch_history"
+	watchhistoryconnect "github.com/tikfack/server/gen/watch_history/watch_historyconnect"
+	"github.com/tikfack/server/internal/application/model"
+	watchhistory "github.com/tikfack/server/internal/application/usecase/watch_history"
+	"github.com/tikfack/server/internal/middleware/ctxkeys"
+	"github.com/tikfack/server/internal/middleware/logger"
+)
+
+// WatchHistoryServiceServer is the Connect handler implementing WatchHistoryService.
+type WatchHistoryServiceServer struct {
+	usecase     watchhistory.WatchHistoryUsecase
+	presenter   watchHistoryPresenter
+	logger      *slog.Logger
+	handlerOpts []connect.HandlerOption
+}
+
+// NewWatchHistoryServiceHandler constructs a new handler.
+func NewWatchHistoryServiceHandler(uc watchhistory.WatchHistoryUsecase, opts ...connect.HandlerOption) *WatchHistoryServiceServer {
+	if uc == nil {
+		panic("watch history usecase must be provided")
+	}
+	return &WatchHistoryServiceServer{
+		usecase:     uc,
+		presenter:   newWatchHistoryPresenter(),
+		logger:      slog.Default().With(slog.String("component", "watch_history_handler")),
+		handlerOpts: append([]connect.HandlerOption{connect.WithCompressMinBytes(0)}, opts...),
+	}
+}
+
+// GetHandler exposes the Connect handler pair.
+func (s *WatchHistoryServiceServer) GetHandler() (string, http.Handler) {
+	pattern, handler := watchhistoryconnect.NewWatchHistoryServiceHandler(s, s.handlerOpts...)
+	return pattern, handler
+}
+
+func (s *WatchHistoryServiceServer) loggerWithCtx(ctx context.Context) *slog.Logger {
+	return s.logger.With(
+		slog.String("user_id", logger.UserIDFromContext(ctx)),
+		slog.String("trace_id", logger.TraceIDFromContext(ctx)),
+		slog.String("token_id", logger.TokenIDFromContext(ctx)),
+	)
+}
+
+func (s *WatchHistoryServiceServer) ListWatchHistory(ctx context.Context, req *connect.Request[pb.ListWatchHistoryRequest]) (*connect.Response[pb.ListWatchHistoryResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.PageSize < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page_size must not be negative"))
+	}
+
+	page, err := s.usecase.ListWatchHistory(ctx, userID, model.WatchHistoryQuery{
+		PageSize:       int(req.Msg.PageSize),
+		PageToken:      req.Msg.PageToken,
+		InProgressOnly: req.Msg.InProgressOnly,
+	})
+	if err != nil {
+		log.Error("failed to list watch history", "error", err)
+		return nil, toConnectError(err, "failed to list watch history")
+	}
+
+	return connect.NewResponse(s.presenter.ListWatchHistory(*page)), nil
+}
+
+func (s *WatchHistoryServiceServer) GetResumePosition(ctx context.Context, req *connect.Request[pb.GetResumePositionRequest]) (*connect.Response[pb.GetResumePositionResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	if req.Msg.VideoId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("video_id is required"))
+	}
+
+	position, err := s.usecase.GetResumePosition(ctx, userID, req.Msg.VideoId)
+	if err != nil {
+		log.Error("failed to get resume position", "video_id", req.Msg.VideoId, "error", err)
+		return nil, toConnectError(err, "failed to get resume position")
+	}
+
+	return connect.NewResponse(s.presenter.ResumePosition(*position)), nil
+}
+
+func (s *WatchHistoryServiceServer) ClearHistory(ctx context.Context, req *connect.Request[pb.ClearHistoryRequest]) (*connect.Response[pb.ClearHistoryResponse], error) {
+	log := s.loggerWithCtx(ctx)
+	userID := ctxkeys.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user id missing in context"))
+	}
+	for _, videoID := range req.Msg.VideoIds {
+		if videoID == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("video_ids must not contain empty ids"))
+		}
+	}
+
+	removed, err := s.usecase.ClearHistory(ctx, userID, req.Msg.VideoIds)
+	if err != nil {
+		log.Error("failed to clear watch history", "error", err)
+		return nil, toConnectError(err, "failed to clear watch history")
+	}
+
+	return connect.NewResponse(&pb.ClearHistoryResponse{RemovedCount: int32(removed)}), nil
+}
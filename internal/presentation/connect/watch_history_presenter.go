@@ -0,0 +1,45 @@
+package connect
+
+import (
+	pb "github.com/tikfack/server/gen/watch_history"
+	"github.com/tikfack/server/internal/application/model"
+)
+
+type watchHistoryPresenter struct{}
+
+func newWatchHistoryPresenter() watchHistoryPresenter {
+	return watchHistoryPresenter{}
+}
+
+func (p watchHistoryPresenter) Entry(h model.WatchHistory) *pb.WatchHistoryEntry {
+	return &pb.WatchHistoryEntry{
+		VideoId:               h.VideoID,
+		PositionSeconds:       h.PositionSeconds,
+		DurationSeconds:       h.DurationSeconds,
+		Progress:              h.Progress,
+		ResumePositionSeconds: h.ResumePositionSeconds,
+		Completed:             h.Completed,
+		LastWatchedAt:         h.LastWatchedAt,
+	}
+}
+
+func (p watchHistoryPresenter) ListWatchHistory(page model.WatchHistoryPage) *pb.ListWatchHistoryResponse {
+	resp := &pb.ListWatchHistoryResponse{NextPageToken: page.NextPageToken}
+	if len(page.Entries) > 0 {
+		resp.Entries = make([]*pb.WatchHistoryEntry, 0, len(page.Entries))
+		for _, h := range page.Entries {
+			resp.Entries = append(resp.Entries, p.Entry(h))
+		}
+	}
+	return resp
+}
+
+func (p watchHistoryPresenter) ResumePosition(pos model.ResumePosition) *pb.GetResumePositionResponse {
+	return &pb.GetResumePositionResponse{
+		VideoId:         pos.VideoID,
+		PositionSeconds: pos.PositionSeconds,
+		DurationSeconds: pos.DurationSeconds,
+		Completed:       pos.Completed,
+		HasHistory:      pos.HasHistory,
+	}
+}